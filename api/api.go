@@ -80,6 +80,12 @@ type Config struct {
 	Health        health.Checker
 	Interceptor   Interceptor
 	StatusHandler StatusHandler
+
+	// Elector is only set when CTS is configured with high availability
+	Elector Elector
+
+	// Sharder is only set when CTS is configured with sharding
+	Sharder Sharder
 }
 
 // NewAPI create a new API object
@@ -103,6 +109,7 @@ func NewAPI(ctx context.Context, conf Config) (*API, error) {
 	// add the middleware for all endpoints
 	r.Use(withCORS)
 	r.Use(withRequestID)
+	r.Use(withTracing)
 
 	// add the base path route then mount the endpoints
 	r.Route(fmt.Sprintf("/%s", defaultAPIVersion), func(r chi.Router) {
@@ -115,8 +122,10 @@ func NewAPI(ctx context.Context, conf Config) (*API, error) {
 
 		// Legacy Endpoints
 		// retrieve overall status
-		r.Mount(fmt.Sprintf("/%s", overallStatusPath),
-			newOverallStatusHandler(api.ctrl, defaultAPIVersion))
+		osh := newOverallStatusHandler(api.ctrl, defaultAPIVersion)
+		osh.elector = conf.Elector
+		osh.sharder = conf.Sharder
+		r.Mount(fmt.Sprintf("/%s", overallStatusPath), osh)
 
 		// retrieve all task statuses
 		r.Mount(fmt.Sprintf("/%s", taskStatusPath),
@@ -125,6 +134,10 @@ func NewAPI(ctx context.Context, conf Config) (*API, error) {
 		// crud task
 		r.Mount(fmt.Sprintf("/%s", taskPath),
 			newTaskHandler(api.ctrl, defaultAPIVersion))
+
+		// retrieve metrics in the Prometheus text format
+		r.Method(http.MethodGet, fmt.Sprintf("/%s", metricsPath),
+			newMetricsHandler())
 	})
 
 	r.Group(func(r chi.Router) {
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var _ Interceptor = (*FollowerInterceptor)(nil)
+
+// Elector reports the role of the CTS instance in a cluster of CTS instances
+// configured with high availability
+type Elector interface {
+	ClusterName() string
+	InstanceID() string
+	Role() string
+	IsLeader() bool
+}
+
+// HighAvailabilityStatus is the status of the CTS instance in a cluster of CTS
+// instances configured with high availability
+type HighAvailabilityStatus struct {
+	ClusterName string `json:"cluster_name"`
+	InstanceID  string `json:"instance_id"`
+	Role        string `json:"role"`
+}
+
+// newHighAvailabilityStatus returns the high availability status reported by
+// the elector. Returns nil if high availability is not configured.
+func newHighAvailabilityStatus(e Elector) *HighAvailabilityStatus {
+	if e == nil {
+		return nil
+	}
+	return &HighAvailabilityStatus{
+		ClusterName: e.ClusterName(),
+		InstanceID:  e.InstanceID(),
+		Role:        e.Role(),
+	}
+}
+
+// FollowerInterceptor intercepts requests that modify tasks when the CTS
+// instance is not the leader of its cluster. Only the leader executes tasks,
+// so these requests must be sent to the leader instead.
+type FollowerInterceptor struct {
+	elector Elector
+}
+
+// NewFollowerInterceptor returns an interceptor for requests to a follower
+// CTS instance
+func NewFollowerInterceptor(e Elector) *FollowerInterceptor {
+	return &FollowerInterceptor{elector: e}
+}
+
+// ShouldIntercept returns true for requests that modify tasks while the
+// instance is a follower
+func (i *FollowerInterceptor) ShouldIntercept(r *http.Request) bool {
+	if i.elector.IsLeader() {
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	taskPathPrefix := fmt.Sprintf("/%s/%s", defaultAPIVersion, taskPath)
+	return strings.HasPrefix(r.URL.Path, taskPathPrefix)
+}
+
+// Intercept responds that the request must be sent to the leader
+func (i *FollowerInterceptor) Intercept(w http.ResponseWriter, r *http.Request) {
+	err := fmt.Errorf("this instance of CTS is a follower in cluster '%s' "+
+		"and cannot modify tasks. send the request to the leader instead",
+		i.elector.ClusterName())
+	sendError(w, r, http.StatusServiceUnavailable, err)
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowerInterceptor_ShouldIntercept(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		leader   bool
+		method   string
+		path     string
+		expected bool
+	}{
+		{
+			"follower_create_task",
+			false,
+			http.MethodPost,
+			"/v1/tasks",
+			true,
+		},
+		{
+			"follower_update_task",
+			false,
+			http.MethodPatch,
+			"/v1/tasks/task_a",
+			true,
+		},
+		{
+			"follower_delete_task",
+			false,
+			http.MethodDelete,
+			"/v1/tasks/task_a",
+			true,
+		},
+		{
+			"follower_get_task",
+			false,
+			http.MethodGet,
+			"/v1/tasks/task_a",
+			false,
+		},
+		{
+			"follower_status",
+			false,
+			http.MethodGet,
+			"/v1/status",
+			false,
+		},
+		{
+			"leader_create_task",
+			true,
+			http.MethodPost,
+			"/v1/tasks",
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			i := NewFollowerInterceptor(&testElector{leader: tc.leader})
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			assert.Equal(t, tc.expected, i.ShouldIntercept(req))
+		})
+	}
+}
+
+func TestFollowerInterceptor_Intercept(t *testing.T) {
+	t.Parallel()
+
+	i := NewFollowerInterceptor(&testElector{})
+	req := httptest.NewRequest(http.MethodPost, "/v1/tasks", nil)
+	resp := httptest.NewRecorder()
+	i.Intercept(resp, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Contains(t, resp.Body.String(), "follower in cluster 'cts-cluster'")
+}
+
+func TestOverallStatus_ServeHTTP_HighAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+		On("Tasks", mock.Anything).Return(nil)
+
+	handler := newOverallStatusHandler(ctrl, "v1")
+	handler.elector = &testElector{leader: true}
+
+	req, err := http.NewRequest(http.MethodGet, "/v1/status", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var actual OverallStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+	assert.Equal(t, &HighAvailabilityStatus{
+		ClusterName: "cts-cluster",
+		InstanceID:  "cts-01",
+		Role:        "leader",
+	}, actual.HighAvailability)
+}
+
+type testElector struct {
+	leader bool
+}
+
+func (e *testElector) ClusterName() string { return "cts-cluster" }
+func (e *testElector) InstanceID() string  { return "cts-01" }
+func (e *testElector) IsLeader() bool      { return e.leader }
+func (e *testElector) Role() string {
+	if e.leader {
+		return "leader"
+	}
+	return "follower"
+}
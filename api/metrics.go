@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/consul-terraform-sync/metrics"
+)
+
+const metricsPath = "metrics"
+
+// newMetricsHandler returns the handler that serves the daemon and task
+// metrics in the Prometheus text format
+func newMetricsHandler() http.Handler {
+	return metrics.Handler()
+}
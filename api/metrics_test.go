@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	api, err := NewAPI(context.Background(), Config{Controller: new(mocks.Server)})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/metrics", nil)
+	resp := httptest.NewRecorder()
+	api.srv.Handler.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Header().Get("Content-Type"), "text/plain")
+	assert.Contains(t, resp.Body.String(), "go_goroutines")
+
+	t.Run("unsupported method", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/metrics", nil)
+		resp := httptest.NewRecorder()
+		api.srv.Handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	})
+}
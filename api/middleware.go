@@ -11,8 +11,12 @@ import (
 
 	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/hashicorp/go-uuid"
 	middleware "github.com/oapi-codegen/nethttp-middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 //go:generate mockery --name=Interceptor --filename=middleware.go --output=../mocks/api --tags=enterprise --with-expecter
@@ -29,11 +33,37 @@ func withRequestID(next http.Handler) http.Handler {
 			return
 		}
 
-		r = r.WithContext(requestIDWithContext(r.Context(), reqID))
+		ctx := requestIDWithContext(r.Context(), reqID)
+		r = r.WithContext(tracing.WithRequestID(ctx, reqID))
 		next.ServeHTTP(w, r)
 	})
 }
 
+// withTracing starts a span for the request, continuing the trace of the
+// caller if any. Tasks run by the request are traced as children of the span.
+func withTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(),
+			propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Start(ctx, fmt.Sprintf("%s %s", r.Method, r.URL.Path),
+			attribute.String("http.method", r.Method),
+			attribute.String("http.target", r.URL.Path))
+
+		rw := &loggerResponseWriter{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+		next.ServeHTTP(rw, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", rw.statusCode))
+		var err error
+		if CheckStatusCodeCategory(ServerErrorResponseCategory, rw.statusCode) {
+			err = fmt.Errorf("%s", http.StatusText(rw.statusCode))
+		}
+		tracing.End(span, err)
+	})
+}
+
 type loggingMiddleware struct {
 	uriExclusions map[string]bool
 	logger        logging.Logger
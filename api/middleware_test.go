@@ -4,6 +4,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -11,8 +12,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/api"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 )
 
 func TestWithSwaggerValidate(t *testing.T) {
@@ -267,3 +275,65 @@ func TestWithIntercept(t *testing.T) {
 		assert.True(t, nextCalled, "expected next handler to be served")
 	})
 }
+
+func TestWithTracing(t *testing.T) {
+	// Not parallel since the global tracer provider is configured
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+	cases := []struct {
+		name       string
+		statusCode int
+		isError    bool
+	}{
+		{
+			"ok",
+			http.StatusOK,
+			false,
+		},
+		{
+			"client_error",
+			http.StatusNotFound,
+			false,
+		},
+		{
+			"server_error",
+			http.StatusInternalServerError,
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(
+				sdktrace.WithSpanProcessor(sr)))
+
+			var requestID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// spans of tasks run by the request carry the request ID
+				requestID = tracing.RequestIDFromContext(r.Context())
+				assert.True(t, trace.SpanFromContext(r.Context()).SpanContext().IsValid())
+				w.WriteHeader(tc.statusCode)
+			})
+			handler := withRequestID(withTracing(next))
+
+			req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/task_a", nil)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := sr.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, "PATCH /v1/tasks/task_a", span.Name())
+			assert.NotEmpty(t, requestID)
+			assert.Contains(t, span.Attributes(),
+				tracing.AttrRequestID.String(requestID))
+			assert.Contains(t, span.Attributes(),
+				attribute.Int("http.status_code", tc.statusCode))
+			if tc.isError {
+				assert.Equal(t, codes.Error, span.Status().Code)
+			} else {
+				assert.NotEqual(t, codes.Error, span.Status().Code)
+			}
+		})
+	}
+}
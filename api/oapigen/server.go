@@ -38,6 +38,18 @@ type ServerInterface interface {
 	// Gets a task by name
 	// (GET /v1/tasks/{name})
 	GetTaskByName(w http.ResponseWriter, r *http.Request, name string)
+	// Gets the events of a task
+	// (GET /v1/tasks/{name}/events)
+	GetTaskEvents(w http.ResponseWriter, r *http.Request, name string, params GetTaskEventsParams)
+	// Gets the pending plans of a task
+	// (GET /v1/tasks/{name}/plans)
+	GetTaskPlans(w http.ResponseWriter, r *http.Request, name string)
+	// Approves a pending plan of a task
+	// (POST /v1/tasks/{name}/plans/{id}:approve)
+	ApproveTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string)
+	// Rejects a pending plan of a task
+	// (POST /v1/tasks/{name}/plans/{id}:reject)
+	RejectTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -80,6 +92,30 @@ func (_ Unimplemented) GetTaskByName(w http.ResponseWriter, r *http.Request, nam
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Gets the events of a task
+// (GET /v1/tasks/{name}/events)
+func (_ Unimplemented) GetTaskEvents(w http.ResponseWriter, r *http.Request, name string, params GetTaskEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Gets the pending plans of a task
+// (GET /v1/tasks/{name}/plans)
+func (_ Unimplemented) GetTaskPlans(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approves a pending plan of a task
+// (POST /v1/tasks/{name}/plans/{id}:approve)
+func (_ Unimplemented) ApproveTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rejects a pending plan of a task
+// (POST /v1/tasks/{name}/plans/{id}:reject)
+func (_ Unimplemented) RejectTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -208,6 +244,183 @@ func (siw *ServerInterfaceWrapper) GetTaskByName(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// GetTaskEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskEventsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "success" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "success", r.URL.Query(), &params.Success)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "success", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error_code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_code", r.URL.Query(), &params.ErrorCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error_code", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaskEvents(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTaskPlans operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskPlans(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaskPlans(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveTaskPlan operation middleware
+func (siw *ServerInterfaceWrapper) ApproveTaskPlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveTaskPlan(w, r, name, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectTaskPlan operation middleware
+func (siw *ServerInterfaceWrapper) RejectTaskPlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectTaskPlan(w, r, name, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -339,6 +552,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/tasks/{name}", wrapper.GetTaskByName)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tasks/{name}/events", wrapper.GetTaskEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tasks/{name}/plans", wrapper.GetTaskPlans)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tasks/{name}/plans/{id}:approve", wrapper.ApproveTaskPlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tasks/{name}/plans/{id}:reject", wrapper.RejectTaskPlan)
+	})
 
 	return r
 }
@@ -346,66 +571,89 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbe28bt5b/KlxmgU3v6ulHEgvoH6nj3RqbpIHte/tHZAgczhmJ9Qw5JTlWBEP72S/4",
-	"mJeGsiQ3SQ20LpBa4uucw/P4nXPoB0xFlgsOXCs8ecCKLiAj9tefiiQB+QkkE7H5TOKYaSY4ST9JkYPU",
-	"DBSeJCRV0MMxKCpZbsbxBN8sAEV2OcrtepQIibRk8zlIxudIE3WH4AvQwqwY4B7OG3s+YOAkSsEe2975",
-	"1wXoBUikOycwhfwqJCSKmbK/D9A7SEiRaoW0sKvmqYhIurGYCp6weSHBUXp+c21ogi8ky1PAEy0L6GG9",
-	"ygFPcCRECoTjdQ9n5EuXRMN8Rr6wrMjK7UWCNMvAkLAkTCOSaJCILgifg0JEAopBA9UQowgSIaElqwVY",
-	"eX0dVvCpwhUrSpsTLCeMb+GE8efKydEowMq6+kZEvwHVhrlzokkq5tcg7xkFdS640+SdWt1WyphoQoFr",
-	"kFZFKzpiOg6JlJMMVE4obMx2rAdXiBhmGWiynbCH7qpq6wd8Bys8wfckLcDuV6SpsYINBa4FI2EOX/I2",
-	"fUuIBv8IUVcomBE1y0RcpDBjPC+0UxnHjz+j2siLcNNo7Km/F0wa6/5cUnAburW0UBrktSa6UFegcsEV",
-	"HHhl1O0xM3fR1W+jeWbEavUCjIYhv6KlaP67PglaDmQRSBXePWVKm93NzowrTTgFhZYLRhfWWHIitTud",
-	"qdDRny23EpQyZGjVH40HfnBARYZ7eAEk1YtVKX4WVxNxD6dAYqOtbkw5/ffCwFRwVaR9DVKSRMisr1ac",
-	"4nXvod7Ty7Te9KixqR/cb9fbHmYaMium/5SQ4Al+MaxDz9DHneEHK01cWzGRkqyw1xpQesbiXXtcuZmX",
-	"7zra1lKH+upamwdVcW+P0XWgtFyLBPc3b5ye84qVSzTfuXgIA3SZ1N8viLIfYsglUGIcq5e4QgmDtOUm",
-	"iUIEOQNF1kB7iGkTGaVZrYzvitECJJiZFWGDcsNuHKbOc87KGbtEv9XTrnteM2Z39zs3sRP/71+t1WbQ",
-	"8LVr8bWf1168J/kButdhddgg8JkFkpzoRXtytuqb4BCYK4EWUkHLlXuqd/nybxQTLPW3j8j9gz3usjzt",
-	"Lyj5fSV2IaWQB8ooA6XIfINlG6CYQoQjMHuiclYIgDVJK+dtpa4Z2TeSgJL4x0zWcfiV4oM7sR0O1j38",
-	"s42H5wugd0/EIYew0kFIj4YmHzAPI6fCFCHM4gcR87CkxC3m+kuU5DBAD0GW6xUSJiVbMgVt1BSCKx0j",
-	"qLBGiBQ3iJSFgCVKo7qmaZ8kjcXhzVncxH2hHWsg1SG7BEGbG/tzkSHGSLC5tbWfEuVVIrQXFBbhNo7a",
-	"kCvEm5/RQbdhLoOQbZdhsxhvUFJfZiWfoMYe4L27cKqe3gI6L9UPSC+IroCTQrkU9yyGKse8KfkrFwre",
-	"KEF8J9DVjJSP4a5DsVJTqE8APK3lIchT+8xWWIiiV8c0fj3qv0lOTvsnyclRPzp6HfUjekReJSdnx2N4",
-	"hXvYSJ1oPMFFYdWmY05XxaEYypccZl7E2ytFQiIuNGI8kURpWVBdSKgqFktolizioq5OMa5yoGV5qmuE",
-	"eUr4RqS3QhxoULpvyxypoCSdJSyFwVwCaMZrJD1BV5BIUAtzoHFwMBgM0GcW/3gUn45OzqKT1/H4VXxG",
-	"T+LxKaWnZ2enoySOj2M4Oolen70ev7qd8n1O3H7Qq7PjkyN6So/P4JTAaTIavX5NgNLjIzpK3ozfjMdJ",
-	"9GZ8dnw75VNeW0+hIEbOyaRObN7SpDW1OXCQRIOdkog0FUtzcmVpU24kN0BXoEQhKSBiheyKR4zHzNnb",
-	"kunFxhZqlUUiVZMp7w//G8WgtBQrRLilhiMqwRwrIU8JhQy4btO9ZGmKcpD2Q3tnT8LELEDoBTroJlFW",
-	"KI2i6uTY0SdL/qa4Xj3FaIo7O0wxejAHm5//N65FA9eo9fMjmhaj0TF1//YvfrlBL1AipDm/xXG9pI9+",
-	"hjQVPURy9h/NAVQOLCHaZ+Dil5uaOhaj7s+PaIr3VdspRn3LBaCXd1wsua8hkjxPVz/Up75AL49RwZ2h",
-	"xohoLVlUaFBoweIYuJ+6Nnf2KSV8gsZG/Ugc99DI/OZW9tzXXlsGUx5yPzqhM1nwWSHTriO5MOlCLpmJ",
-	"0jxdDdA/r96bmFpr1nkqihjJgrsQRIWUFibGVeyxHkUWvF3AXGidq8lwSPJ8UEXfARPmi2G26gs5Hy6F",
-	"vLMpiDLfLNVQFtz+0ycRfQf/M/+Z/XY3Pjo+Od2vFtrNjw/0u1JsuL1/IPffB8F3gga7OgQK/mhtlmo1",
-	"KxTIWQwJ4xDvLKNuqY02cr9dxdMDc8mEpZ2p0+kUGydj/o8YR14KgxsyV1vz0dYWn/ESItzDJGe4WWPr",
-	"qvhGOe3w1PbPKRZv1ZSnFwEO1pW/deMr6kboTm+Iutt5iY0+B216iSbW9UJocW5ObHv0tygiilHrlY1D",
-	"LpuN7jKdzhr65HzoDx36L51sTJJF1N25g+0O+uDJ59sevieSmc0sMfdEjvGkpHtgEwfD7T1I5QgZD0aD",
-	"kQWTLX1zbbBZXrVeH4PwrTatK7PWstmROtQV0paAQn3ARZERjiSQ2PCHNHzRPq5SySKoe3utCEdMimU/",
-	"lMLutq+ard6Wd9je+XUAPdjwRYkUWYk2+Xy/Nq4oK8tdvg12c9X7JJhFtvkNqky3ZbThFR9tiLQTu3DK",
-	"bwgtOPu9aGf83fsw37wN1i1rPQ5Kwfexymn2GNXOuP+rzG5NhqBa534+yP1UUGhGDbCaVRBol6yqu7GA",
-	"7NdqWWvPyvo2+XxXJfs9w4GT3lZaBp0dbXEFSDxAHcRoRFjOaiFHLexR9nVES7kcpKxOQ0QpQVk7M3Kt",
-	"9BtfmTUnIXJPmI1MaGlSIpuqVfM3d48luwfZbcanxMQdZARMNDObVRwmNpdWoNtq5fxYQK1a/vCxq/uX",
-	"n/iB5C0XGVLGhiS1a7CVpRivfy21dNq4jckncrYBa8t+YmnxtQ++3RLt3kEKGr5DNfnrFMZ3FKENR37x",
-	"gaxoH/kfNWszZ5Miu3A7Lc9Vrj0si52R+aqwYenJstnjtp76oOKJTNuKrFmx1yMAx9RmYDiQyS2x4LCK",
-	"c8eTn3tn4zCBfWmjnoUX75SQyRy4nuVCpLNQB6TD2VszH5n56PKdYUmB/gMsOdLNp6ryZ9yzbYJMHXFT",
-	"PEAXzIK6FrEGzjW+sIjGltPd5Rtf/eielwmKhHYPbBTonisPto/Q5A4UMgEfYuB0A8YRM60/PjoOxbQN",
-	"0vYQ7UePyUgt4r+2fE3ondULgmlBScHMULCHkC/aJP9hAQ/QOeHOHiNAUywhExqm2EivIYwmrqgnbaiT",
-	"mRysO+5GpX9jye1lgyZoPKR8E3qvmxthVnDVQUj7Qs9lPHGzkltlOo3OUEmVIZTxRPgyhSZUl4UJ61hY",
-	"XwuRMj7vUyGhS83bT5fonaBFBly7IGPfvroWbSX1/vWK054dyoTtm7gWm5mvANBntwB9vHyL3n66vH1Z",
-	"lpqXy+XANRcHTAxjQdWQMzIkOfsB93DKKHhM4An+8Ol9/2gwQu/9SA/bGnlVup4zvSiiARXZcEHUglEh",
-	"82GwoTyMUhENM8L48P3l+cXH6wtrAUzbWz+/uTaE4mB1ROTASc7wBB975ciJXti7Hd6Ph67rbD7NIdAI",
-	"tO82XD/XvydwDzKx3dhF8ssYT/D/gnYvPewLEAeP7CFHo1F5nb7VSPI8Za4wMPxN+TqURS+7sE3oLcm6",
-	"W6KyzXqFyoa6HffFkT+FkIJXpKx7WBVZRuTKyUy1n2kYkyBzW4TzF2MrcOai3IRh+c5164XdXDdT6F8c",
-	"8KpvkRZSmkDafhbSeLxre4ISdCG5QqQqXpSj/tln2TlksukRM9AkJpqEtKP1IvlbKkn46XPgdq4rEWww",
-	"9y00pv1cK0DNPzl8yV1LGKq3TBu6UtLpL8+GFq9jjUTexpkFmy/KKMRSplcN1ap0DSpFqfWsyjaC6nUF",
-	"WjK4B9XymsaVkjR1b0dCl/82TW/82De793ZmFpCwnWBU23IQP9tbbkqyvDL3+Xbdw7lQIbO3/XtjsByW",
-	"drVt1rYvwk26cbXknEiSgXbV9045jyUJWD9h8KCyFywLzhmfD9B1kedCamWbtlws/cNsWXDVKDBnGcTG",
-	"K6SrKbcupeDlsxC/gFY0x3LlOsD2MbdBD+7pnJeUWR4zRYmMIZ5yX58CXr1Bazw3sWwzw8PvBchV3XSQ",
-	"hRmprxF4kdnyk1jaFXaHRjZcYafbqlrxk4hXX1Vdy7LPFmW1jXgrJNxM37UsYP2NDWmXHaHydOdt6gvo",
-	"uUs06NSRbu3saDT+c8jrVe2OBjXPzeq7xhuw/KZ7Hj4YpV47N5CCDqR4H4i8Mzsqxue+gWSt2M43Pjsi",
-	"JicRLg22aWyJ1l2a5PJklqYogil3x5j5FPwLPXPFpU8IOBtXpDWX8dPqoyvxPupyyjy//IMOz5g3ZvtI",
-	"u7JlXzJum0TLuHc1bZxVtwzoaA99aLRRm8W8/Z7yrXsHaPhGjXubnmdE3vk/8Stv9jlqeKmNHTUMhrhD",
-	"kUdLybfrdQiYPF0/SxzxHTX0u7v4Z4+U/JWvkJd3x2n657zhKzVuLlgcsO9F7B8TuoT9IZdCCyrS9WQ4",
-	"fFgIpdeTB4OB1nijTbeo0JkXl3u/aL+24E1uDL85PX3je8j2hPboQuvc4nSHVfxHWz+w3N2u/x0AAP//",
-	"eK9HJYQ+AAA=",
+	"H4sIAAAAAAAC/+w8a3MjuXF/BZlzlc8O33qsVlX3Qafd5FS53VNJsl3JUmGBM00SpxlgDsCIy6iU355q",
+	"APMABxRJ7WpPjr2u8okzAPqB7ka/MA9RLLJccOBaRacPkYoXkFHz54/FbAbyEiQTCf6mScI0E5yml1Lk",
+	"IDUDFZ3OaKqgEyWgYslyfB+dRjcLIFMzneRmPpkJSbRk8zlIxudEU3VH4DPEBc7oRZ0ob6z5EAGn0xQM",
+	"WH/lvy1AL0AS3YLAFHGziJAkYcr83SPvYEaLVCuihZk1T8WUpmuTY8FnbF5IsJie31wjTvCZZnkK0amW",
+	"BXQivcohOo2mQqRAefTYiTL6uY0iEp/RzywrsnJ5MSOaZYAoLCnThM40SBIvKJ+DIlQCSUBDrCEhU5gJ",
+	"CR6vFmD49XVIiY5UVJGiNEIwlDC+gRLGXyslo0GAlMfqiZj+CrFG4s6ppqmYX4O8ZzGoc8GtJG+Val8o",
+	"E6ppDFyDxF81Hkk8DLGU0wxUTmNYG21JD84QCUwy0HQzYg/tWdXSD9EdrKLT6J6mBUQhRkiYw+fcx2cJ",
+	"096fQ9gUCiZUTTKRFClMGM8LbUXE4u+UolrIsWxdSQzU3womUZs/lRjchnYpLZQGea2pLtQVqFxwBXtu",
+	"UWzXmCDv2/KMkoZvjBQvACWKuBmeYLlnXRrUFMimIFV49ZQpjavjyowrTXkMiiwXLF4Y5cip1BY6UyHQ",
+	"nwy1EpRCNLTqDoY997IXiyzqRAugqV6sSvazpBoYdaIUaAKyfKesvDtmRLHgqki7GqSkMyGzrlrxOHrs",
+	"PNRrOp7Wi44ai7qXu61624mYhsyw6Q8SZtFp9F2/Pmr67pzpfzDcbAgrlZKuIic1oPSEJdvWuLIjL961",
+	"pM0Th3rrvMWDorizhWgbzLicSwR3O69FaQUrE4jP7PkHPXIxq58vqDI/EsglxBQNqeO4IjMGqWcWqSKU",
+	"WAUlRkE7hGk8CSXOVsBx+gIk4MgKsV65YPvcja2lnJQjtrF+o2V97DjJmNzdb13EDPyPv3qz8SXStW3y",
+	"tRvnT94R/QDej2FxWEPwlR0cOdULf3C26uJhEBorIWEoWLtuy2U1wWhXXEgF3kHgaN52ErzQiWJov31i",
+	"1z4YcBcltL/XffsCzu/LscumiOzBL/itoKnyCSo9+QBFcwkUrbNeUO5NGnUiPEqoRu6JYppCPZ0X5YHx",
+	"qxJ80ubgH3oS8pTFNOjnpqBUG+BwsBPEkPv03/ej8bi3mx/6Xkoh9+RpBkrR+ZpIGfeBKUI5AVyTlKNC",
+	"aDS3vhx3uwm7pt+1trkl8k/ZDEvhVzq9LUT/sEY874Hvq8nAkwnGLT4bR4PRUXcw7A5GN8Oj08Hh6cHR",
+	"f0VNSaAaumZaQJJ24wfiWjGFJT78+CQZUhgdd4/pwbR7GI8OunRAh90BPZoO6Wh6MjuIg2YjpXwnyJep",
+	"NbxKU6l3YcBgDwaoIo6No/qwPVBG16ZyyhtyTNXdWWht+2AHCm9w4LrgsCRqgqxR9TjRqaUiqA711u0Z",
+	"g4gEdpeLcxz92Kk00zeeRrn1rEvzPF2ZKPiPhmd/PCXwmWmiTLBEhlsV3yDVeVr/fZxa8c21RktOcCWi",
+	"F1STOKVKsRkD67HOKEsLaYIrtEu4muFxkSECGrI8pRomEngCcuJGI+Jl6DBhnOngCxT44AvDl8abBeVJ",
+	"6q2eS3HPECAt9KK5BstAFIhhTHkMqT2jCn7HxZIjfxpSuhFcS25rrdsvcLgusozKVRmYlvkU9/OmRIAg",
+	"IwhiwTDBsjJvS077UkgT39gMK2wZ1zC3B5qF440bhMYloLQUq+0DRaErp65JH7KE2JcdomXBbWSjBaFV",
+	"riwFPtcLLxDHaadkaMYlSYcM8C+LtPvhMOuFNsPCm1TgPPQ3OqkSlCikCx58Ks5saF5vTDXWKgQ+Mjvk",
+	"9s+L7CPr9vaM/vZSEdN0MmMp9OYSQCPKzZh5g0Usw+M17cbNrnaz3q4mMdXeBPiy0RrcODO8FuWu8iqF",
+	"YoSvR64Kp/E2B2iyvcifghteURfzIpOsKWNajbljU4+8k2ymQwsk5oVNKWJIHVhwzKuMY6k2GU1MhpJp",
+	"RRifSaq0LGJtjFOhFUvA5Wh6Y94wUbLAXwakbwDsi9aW/GTSJucLiO+ema7ax6dqJdKezGC4vMp+6FSp",
+	"p1Bqy70kzGWvyvQW+qFlMs2mijoEslyviNALkEumwE+uhbJaLd5WKakQKvZlefiVNlPXOO2Su2dJeHGW",
+	"NNODoRXrfFsgvLC5svWFHVw0fYAcbC5tHPkyGVix0GxQmIWbKPIzcyHa3IhWEjRMZTCzt83RML6Xh0m9",
+	"mRV/ghK7R5jezrrVw7182PfqT7VpRpOhiHMIqtJDfbi6iYI3KlPfKDfXTIk8lZ7bN6XWZOoz8mLe9FBE",
+	"ewk8YXz+DI/HegQtd6d5UjBFcrs+HhpS3NO0zREJuA8Tqr9iZLMepx3Ex9NBfADd0TE96h7GJ7PuCT2E",
+	"7gBOpqPpm+RtPJx9lThtvzBpW9TT4E1I4erA2wM2nR4fxMmbQfdkdnjUPZwdjrrT0ZtpdxqP6PHs8O3B",
+	"EI6bnCwKFszuXBX7pkmdGEycemwu/gpJuNDrR3spRUtoViGToi44M65y60j0opABLferkY5zPhso3TWV",
+	"y4DfViXLT8kVzCSoBQJUmmro9XrkE0t+GCVHg8O308M3yfA4eRsfJsOjOD56+/ZoMEuSgwRGh9M3b98M",
+	"j2/HfBeImwEdvz04HMVH8cFbOKJwNBsM3ryhEMcHo3gwOxmeDIez6cnw7cHtmI95bfkKBQmxB0Rq2VaG",
+	"TcZMzoGDpBrMkJlIU7FEyJWVHHPkXI9cOW+TUMNk68oxbnOJCVkyvVhbQq2yqUjV6Zh3+/9aOvOEcoMN",
+	"J1aC0WKmNIYMuPbxXrI0JTlI88Nf2aFwihMI+Y7stZMkK5Qm0wpyYvErvWkyjurZ44iMo9YK44g8IGD8",
+	"9794LGjgmnj/fiDjYjA4iO3/d9//ckO+Q8cX4XsU11O65CdIU9EhNGf/0nxByhdLmO7y4v0vNzV2LCHt",
+	"fz+QcbSr2I4j0jVUAPnexM+uLcC4+n+qoX5Hvj8ghXP7E0K1lmxaaFBkwZIEuBv6iHv2ZOg3bIZ+46Bz",
+	"rmfxRBZ8Usi0bUjecw0yl0zhaZ+ueuQvVz/j6VNL1nkqigTDDZfqEFIaFz+p/AZjUWTB/Z6Ehda5Ou33",
+	"aZ73Ks+pxwQ+6GerrpDz/lLIO1MnUPhkqfoY1eD/dek0fgf/Nv+J/Xo3HB0cHu2WVm6XwPa0u1Ksmb0/",
+	"E/u/DyLMW5bB5H8EXzumzjKQLKb9j7Cc/KeQd9uTUgg4dDR9aadGrNWkUCAnCcwYh2RrUwUv0pROW851",
+	"K/yuUdyzNjRjaWvoeDyONCiN/yWME0d174bO1cb6krfEJ+zeiDoRzdk+2YPnlKp+n9aRjZLx/KLe3rLx",
+	"T1n4AlkIseuGqrutm9boaoqbVqAZwjgmeJQ/Pq5HGmdkShWLjcE22R7XWmiF0Moo4ifnfQe07x5a3jgH",
+	"/LyRTEagt53onkqGixlk7qkcRqcl3j0TDyK19yCVRWTYG/QGtgTflC/b9DbJq0bLp8IFrynzsePzZktE",
+	"WPdHeAwKdf0tioxyIoEmSB/R8Fm7IzeWbAp1J593+FFO3I+S2S3R8Ro7PWuwuc/T+u7B9k4ykyIrHVE+",
+	"361pU5R9JW260a3TTFSR6XpywKc3KDItktet4JPtUH68Hs7kIKIFZ78VfiKnvR8by2sNOQ5ywXWxlcMM",
+	"GOUnUv5YJi0weFAe3E97mZ+6uhKjzzWpvKNtvKr2xvhqf6umeWtW2rdO57sqh9NBCiz3NuLSa61ocmZA",
+	"kx5pOZPIwnKU51RqYUCVye11b7OCRqhSImZ+0GQQJDeu8o+QCL2nzLgtZInRUqGa49dXTyS7B9luvU2p",
+	"BoUebpZTzaZpjTubmTBbgfbFytqxgFh59vCprfurG/iB5p6JDAljg5N6Ac0Mm5M/TyytNG4i8pmUrbmt",
+	"ZTdhqfG1Db7dcNq9gxQ0fIMiwddpvNhSW0CKTNrquV26ttCDf+3UI2pgBT0X+KwncSGVCKTcz81zFAAJ",
+	"WjK4t6cWziE5nRuzaRHpkY9WGKwa4bkDY04lEC5IJiSU48KR5tfguONIZxfWY2T8TMbvkpBspnS/EnUG",
+	"7M60qS8gbnehWiPzJbqPLUI70e0W2ZNi7RzpJ09JHLOOmZm4GZfXaqY6phK7bXxRpdKfxZsdduu5EvpM",
+	"opGU3QXbErWlYWAbkRtcq/1KPC3H6Nyd3dbFNtdU1KtwitrdM3PgepILkU5CdeIWZWc4nuB4cvEOSVKg",
+	"v4Ck6iCqc+zo7ZhS8dgiN4565D0zMZKHLBHeAxMgmKKj3Xx0fZ5c82JGpkLb2yoKdMcm4n0Qmt6BIrmE",
+	"GBLg8VpURHFYdzg6CB2Wa6jtwNqPLsShNYv/sfmrUXHrCSEuVxhgCm4XJr/3Uf5iBvfIOeVWH6dYLpGQ",
+	"CY2lEiGbzGi66fWgNXGa2/a2FpE7BHn/DM02Z+GaMdg+2c/QZdccmVlFf67PS0KZQEiaNZMqcdCLWlgh",
+	"oozPhMv6aRrrMs9nDAvraiFSxufdWEhoY3N2eUHeibjIgGt7yJiLo7aRpeJ693rF4455Zbx7hCgzO14B",
+	"kE92Avl4cUbOLi9uvy+LOsvlsmdbMLCik4hY9TmjfZqzP0WdKGUxOJ/AIfzh8ufuqDcgP7s3nchUo6oi",
+	"0ZzpRTHFFqj+gqoFi4XM+8G2m/40FdN+Rhnv/3xx/v7j9Xtbh9Fm17GF5+zyIgomG0UOnOYM2xiccORU",
+	"L8ze9u+Hfdubg7/mECi5m+422/ViR7rOucgsbE/yiyQ6jf4dtO2Hs12Hxj0yQEaDQbmdZd9+bu5n4NQ+",
+	"XuKo751v821CHXeP7Ywv8oMpUrYdmfcu1/i7IFLwChXTN296fS3PlN/MFnUiTecmp22f24Q2bpQd0C8v",
+	"jW7csJvrZkbqF+t41bsYF1LiQeo3zzVuwprquwRdSK4IrXKB5Vt3h7Ks0TPZtIgZaJpQTUPS4V3vfUkh",
+	"Cd8jDuzOdcWCNeJeQmL82zUBbP7C4XNumy+g6vhck5UST7d55mhxMtbIi5lzZsHmi/IUYinTq4ZoVbIG",
+	"laDUclZFG0HxunK5FOVZTTSlNE1th11o88/S9Ma9e7F99yOzAIfNgCoblLzaXW5ystwy+xuvP+VChdRe",
+	"AtWACsthaWaPeWsj7KAbW5rJqaQZaFvMamXHGZaZ0E6gP6jMBsuCc6yxkOsiz4XUCp8QLpbulrPpza7r",
+	"NVkGCaMa0tWYG5NS8LIBy02IK5wTuTLvvd4/NxgSY2sSpmIqE2zFcele4FWnbqOxy5DNkIbfCpCruoZn",
+	"m7jrbSy7vrlYmhlmhUY0XPlOt1W24keRrL6quJZpnw3CalpeDJOiZviuZQGPL6xI2/SIlNCttak3oGM3",
+	"Eb1Ti7rRs9Fg+Pug16mqhw1sXpvWt5U3oPlN89x/QKF+tGYgBR0I8T5QeYcrKsbnrh5rtNiMR5s9pQoS",
+	"bHauvopReus2TLJxMjbYTWHMLRgcH4PrY8YtLm1CwNjYmgduxo+rj7Zi8qTJKeP88usIjjCnzOaeb6XL",
+	"nGZtlfCUe2vv7G1LgUY7yEOjK6GZzNutafaxs4eEr5WMNsl5RuWd+z5OubOvUcJLaWyJYfCI29fz8IR8",
+	"s1yHHJPny2fpR3xDCf3mJv7Ve0puy1fE8XsHo9mvq49b5Mze8NOUccTBzLJXFJrixjiRgGE3kHghBRep",
+	"mLOYpmMuZIJJnStQJqdjP/8zZ7zOHVFii5fGwYkpx1yZbQ6rbneOObo5mmVgMoUEhcT+JQodiwzKQMwy",
+	"yd7Obd7NUyHb7AT/fVl1fJbglyyZCfliOtB5flW3Q6hycWyDm42Ssbuy45iVS7hnolBmlc1epJ3qOZJb",
+	"cf7gLrjaD0nUGDoqCrkJWMoypj1YlRaOBubrc7gufr5iYL7g5n61L+a2ccK8gANeYYN+N/DEdGabNLdp",
+	"4jaX/dxNmRCSitmLY6Ft/YJbOPuiXH5tbgu2Bdcs3QHb4xfAtvzul5kbRs+92tGENj+A8BR89/mDWZGW",
+	"qNj6hywA9xnvs0PivTL1vI1K0PicwroWNJp3n8JoDaRNjDPVMGIbQJsBEzdgDzY1vrXw4gfrWm/MpuPV",
+	"Ef/6T9n6LKlv6O141lY9GTsctWZsDaE8IjPKC5q6a+NZ9fEJc5zaHo4xL68H9shZfVOTYvJCaSJ4NZLY",
+	"e0o3DhpGMY28gpF8DstNH7Qsi0Om3LP5VL10bR/PO1SbmL7s2frSauD38gRk79Ij9e9DD/zteZY69B9Y",
+	"8nhqRdZ+ZymY0TuzA9DJbQLdRUHQJcSfDNSYB74nYrQgIMAOZLl5O8twmS1oLP/tPMKLdxXcJpu0ICWL",
+	"g6iwZEdEnnf595to15PK5b4UgxxIGhLxKtVrq6zvr18STEl5o3pdmffP0C57fDiNGvOGzBPbMqpLTodU",
+	"zIL9/6Jhjsn/uApmGfA6lWqbhId0yn0jIiyNKPfBXgpzWw1k1d/wkEuhRSzSx9N+/2EhlH48fciF1I/R",
+	"2iWBRaWbjnf2YrV5bGpdcu31ydHRiXnjIPhvF1rnjQ/6uJ/4H0vd7eP/DQC9e2A38F4AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
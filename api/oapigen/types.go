@@ -4,9 +4,30 @@
 package oapigen
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for EventErrorCode.
+const (
+	Cancelled             EventErrorCode = "cancelled"
+	HandlerFailure        EventErrorCode = "handler_failure"
+	ProviderAuthFailure   EventErrorCode = "provider_auth_failure"
+	TemplateRenderFailure EventErrorCode = "template_render_failure"
+	TerraformApplyFailure EventErrorCode = "terraform_apply_failure"
+	TerraformInitFailure  EventErrorCode = "terraform_init_failure"
+	TerraformPlanFailure  EventErrorCode = "terraform_plan_failure"
+	Timeout               EventErrorCode = "timeout"
+	Unknown               EventErrorCode = "unknown"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeDrift EventType = "drift"
+	EventTypeRun   EventType = "run"
+)
+
 // Defines values for CreateTaskParamsRun.
 const (
 	Inspect CreateTaskParamsRun = "inspect"
@@ -54,11 +75,12 @@ type Condition struct {
 
 // ConsulKVCondition defines model for ConsulKVCondition.
 type ConsulKVCondition struct {
-	Datacenter       *string `json:"datacenter,omitempty"`
-	Namespace        *string `json:"namespace,omitempty"`
-	Path             string  `json:"path"`
-	Recurse          *bool   `json:"recurse,omitempty"`
-	UseAsModuleInput *bool   `json:"use_as_module_input,omitempty"`
+	Datacenter       *string            `json:"datacenter,omitempty"`
+	Namespace        *string            `json:"namespace,omitempty"`
+	Path             string             `json:"path"`
+	Predicate        *ConsulKVPredicate `json:"predicate,omitempty"`
+	Recurse          *bool              `json:"recurse,omitempty"`
+	UseAsModuleInput *bool              `json:"use_as_module_input,omitempty"`
 }
 
 // ConsulKVModuleInput defines model for ConsulKVModuleInput.
@@ -69,6 +91,15 @@ type ConsulKVModuleInput struct {
 	Recurse    *bool   `json:"recurse,omitempty"`
 }
 
+// ConsulKVPredicate defines model for ConsulKVPredicate.
+type ConsulKVPredicate struct {
+	Equals      *string  `json:"equals,omitempty"`
+	GreaterThan *float64 `json:"greater_than,omitempty"`
+	JsonPath    *string  `json:"json_path,omitempty"`
+	LessThan    *float64 `json:"less_than,omitempty"`
+	Regexp      *string  `json:"regexp,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
@@ -80,6 +111,53 @@ type ErrorResponse struct {
 	RequestId RequestID `json:"request_id"`
 }
 
+// Event defines model for Event.
+type Event struct {
+	EndTime time.Time   `json:"end_time"`
+	Error   *EventError `json:"error,omitempty"`
+	Id      string      `json:"id"`
+
+	// Plan Summary of the changes of the Terraform plan applied by the event
+	Plan      *EventPlan `json:"plan,omitempty"`
+	StartTime time.Time  `json:"start_time"`
+	Success   bool       `json:"success"`
+	TaskName  string     `json:"task_name"`
+
+	// Type Type of the event. Run events are for the runs of a task that apply its
+	// changes. Drift events are for the drift detection runs of a task that
+	// detected changes made to its infrastructure outside of CTS.
+	Type *EventType `json:"type,omitempty"`
+}
+
+// EventError defines model for EventError.
+type EventError struct {
+	// Code Stable code that classifies the failure of an event
+	Code    EventErrorCode `json:"code"`
+	Message string         `json:"message"`
+}
+
+// EventErrorCode Stable code that classifies the failure of an event
+type EventErrorCode string
+
+// EventPlan Summary of the changes of the Terraform plan applied by the event
+type EventPlan struct {
+	Add     int `json:"add"`
+	Change  int `json:"change"`
+	Destroy int `json:"destroy"`
+
+	// Output Plan output, truncated to a maximum length
+	Output          string `json:"output"`
+	OutputTruncated bool   `json:"output_truncated"`
+
+	// Resources Addresses of the resources that the plan changes
+	Resources []string `json:"resources"`
+}
+
+// EventType Type of the event. Run events are for the runs of a task that apply its
+// changes. Drift events are for the drift detection runs of a task that
+// detected changes made to its infrastructure outside of CTS.
+type EventType string
+
 // HealthCheckResponse defines model for HealthCheckResponse.
 type HealthCheckResponse struct {
 	Error *Error `json:"error,omitempty"`
@@ -109,6 +187,16 @@ type ModuleInput struct {
 	Services *ServicesModuleInput `json:"services,omitempty"`
 }
 
+// PendingPlan Plan of the changes of a task that is pending approval
+type PendingPlan struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+
+	// Plan Summary of the changes of the Terraform plan applied by the event
+	Plan     *EventPlan `json:"plan,omitempty"`
+	TaskName string     `json:"task_name"`
+}
+
 // RequestID defines model for RequestID.
 type RequestID = openapi_types.UUID
 
@@ -124,7 +212,8 @@ type Run struct {
 
 // ScheduleCondition defines model for ScheduleCondition.
 type ScheduleCondition struct {
-	Cron string `json:"cron"`
+	Cron     string  `json:"cron"`
+	TimeZone *string `json:"time_zone,omitempty"`
 }
 
 // ServicesCondition defines model for ServicesCondition.
@@ -193,6 +282,29 @@ type TaskDeleteResponse struct {
 	RequestId RequestID `json:"request_id"`
 }
 
+// TaskEventsResponse defines model for TaskEventsResponse.
+type TaskEventsResponse struct {
+	Events []Event `json:"events"`
+
+	// NextCursor Cursor to retrieve the next page of events. Not set when there
+	// are no more events.
+	NextCursor *string   `json:"next_cursor,omitempty"`
+	RequestId  RequestID `json:"request_id"`
+}
+
+// TaskPlanResponse defines model for TaskPlanResponse.
+type TaskPlanResponse struct {
+	// Plan Plan of the changes of a task that is pending approval
+	Plan      PendingPlan `json:"plan"`
+	RequestId RequestID   `json:"request_id"`
+}
+
+// TaskPlansResponse defines model for TaskPlansResponse.
+type TaskPlansResponse struct {
+	Plans     []PendingPlan `json:"plans"`
+	RequestId RequestID     `json:"request_id"`
+}
+
 // TaskRequest defines model for TaskRequest.
 type TaskRequest struct {
 	Task Task `json:"task"`
@@ -241,5 +353,30 @@ type CreateTaskParams struct {
 // CreateTaskParamsRun defines parameters for CreateTask.
 type CreateTaskParamsRun string
 
+// GetTaskEventsParams defines parameters for GetTaskEvents.
+type GetTaskEventsParams struct {
+	// Cursor Cursor to retrieve the next page of events, as returned by the
+	// next_cursor field of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Since Only return events that ended at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return events that ended before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Type Only return events of this type
+	Type *EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Success Only return successful events when true or failed events when false
+	Success *bool `form:"success,omitempty" json:"success,omitempty"`
+
+	// ErrorCode Only return failed events with this error code
+	ErrorCode *EventErrorCode `form:"error_code,omitempty" json:"error_code,omitempty"`
+}
+
 // CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
 type CreateTaskJSONRequestBody = TaskRequest
@@ -18,6 +18,13 @@ const (
 // OverallStatus is the overall status information for cts and across all the tasks
 type OverallStatus struct {
 	TaskSummary TaskSummary `json:"task_summary"`
+
+	// HighAvailability is only set when CTS is configured with high
+	// availability
+	HighAvailability *HighAvailabilityStatus `json:"high_availability,omitempty"`
+
+	// Sharding is only set when CTS is configured with sharding
+	Sharding *ShardingStatus `json:"sharding,omitempty"`
 }
 
 // TaskSummary holds data that summarizes the tasks configured with CTS
@@ -43,6 +50,8 @@ type EnabledSummary struct {
 // overallStatusHandler handles the overall status endpoint
 type overallStatusHandler struct {
 	ctrl    Server
+	elector Elector
+	sharder Sharder
 	version string
 }
 
@@ -87,7 +96,10 @@ func (h *overallStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 
 		tasks := h.ctrl.Tasks(ctx)
+		taskNames := make([]string, 0, len(tasks))
 		for _, task := range tasks {
+			taskNames = append(taskNames, *task.Name)
+
 			// look for any tasks that have a driver but no events
 			if _, ok := data[*task.Name]; !ok {
 				taskSummary.Status.Unknown++
@@ -101,7 +113,9 @@ func (h *overallStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 
 		err = jsonResponse(w, http.StatusOK, OverallStatus{
-			TaskSummary: taskSummary,
+			TaskSummary:      taskSummary,
+			HighAvailability: newHighAvailabilityStatus(h.elector),
+			Sharding:         newShardingStatus(h.sharder, taskNames),
 		})
 		if err != nil {
 			logger.Error("error, could not generate json error response", "error", err)
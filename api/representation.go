@@ -90,7 +90,7 @@ func (tr TaskRequest) ToTaskConfig() (config.TaskConfig, error) {
 		}
 		tc.Condition = cond
 	} else if tr.Task.Condition.ConsulKv != nil {
-		tc.Condition = &config.ConsulKVConditionConfig{
+		cond := &config.ConsulKVConditionConfig{
 			ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
 				Datacenter: tr.Task.Condition.ConsulKv.Datacenter,
 				Recurse:    tr.Task.Condition.ConsulKv.Recurse,
@@ -99,6 +99,16 @@ func (tr TaskRequest) ToTaskConfig() (config.TaskConfig, error) {
 			},
 			UseAsModuleInput: tr.Task.Condition.ConsulKv.UseAsModuleInput,
 		}
+		if p := tr.Task.Condition.ConsulKv.Predicate; p != nil {
+			cond.Predicate = &config.ConsulKVPredicateConfig{
+				Equals:      p.Equals,
+				Regexp:      p.Regexp,
+				JSONPath:    p.JsonPath,
+				GreaterThan: p.GreaterThan,
+				LessThan:    p.LessThan,
+			}
+		}
+		tc.Condition = cond
 	} else if tr.Task.Condition.CatalogServices != nil {
 		cond := &config.CatalogServicesConditionConfig{
 			CatalogServicesMonitorConfig: config.CatalogServicesMonitorConfig{
@@ -115,7 +125,8 @@ func (tr TaskRequest) ToTaskConfig() (config.TaskConfig, error) {
 	} else if tr.Task.Condition.Schedule != nil {
 		tc.Condition = &config.ScheduleConditionConfig{
 			ScheduleMonitorConfig: config.ScheduleMonitorConfig{
-				Cron: &tr.Task.Condition.Schedule.Cron,
+				Cron:     &tr.Task.Condition.Schedule.Cron,
+				TimeZone: tr.Task.Condition.Schedule.TimeZone,
 			},
 		}
 	}
@@ -296,9 +307,19 @@ func oapigenTaskFromConfigTask(tc config.TaskConfig) oapigen.Task {
 			Namespace:        cond.Namespace,
 			UseAsModuleInput: cond.UseAsModuleInput,
 		}
+		if p := cond.Predicate; p != nil {
+			task.Condition.ConsulKv.Predicate = &oapigen.ConsulKVPredicate{
+				Equals:      p.Equals,
+				Regexp:      p.Regexp,
+				JsonPath:    p.JSONPath,
+				GreaterThan: p.GreaterThan,
+				LessThan:    p.LessThan,
+			}
+		}
 	case *config.ScheduleConditionConfig:
 		task.Condition.Schedule = &oapigen.ScheduleCondition{
-			Cron: *cond.Cron,
+			Cron:     *cond.Cron,
+			TimeZone: cond.TimeZone,
 		}
 	}
 
@@ -251,6 +251,31 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with_consul_kv_condition_predicate",
+			taskConfig: config.TaskConfig{
+				Condition: &config.ConsulKVConditionConfig{
+					ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+						Path: config.String("config/feature-x"),
+					},
+					Predicate: &config.ConsulKVPredicateConfig{
+						JSONPath:    config.String("$.replicas"),
+						GreaterThan: config.Float64(2),
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					ConsulKv: &oapigen.ConsulKVCondition{
+						Path: "config/feature-x",
+						Predicate: &oapigen.ConsulKVPredicate{
+							JsonPath:    config.String("$.replicas"),
+							GreaterThan: config.Float64(2),
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "with_schedule_condition",
 			taskConfig: config.TaskConfig{
@@ -266,6 +291,25 @@ func TestRequest_oapigenTaskFromConfigTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with_schedule_condition_time_zone",
+			taskConfig: config.TaskConfig{
+				Condition: &config.ScheduleConditionConfig{
+					ScheduleMonitorConfig: config.ScheduleMonitorConfig{
+						Cron:     config.String("0 0 18 * * * *"),
+						TimeZone: config.String("America/New_York"),
+					},
+				},
+			},
+			expected: oapigen.Task{
+				Condition: oapigen.Condition{
+					Schedule: &oapigen.ScheduleCondition{
+						Cron:     "0 0 18 * * * *",
+						TimeZone: config.String("America/New_York"),
+					},
+				},
+			},
+		},
 		{
 			name: "with_module_inputs",
 			taskConfig: config.TaskConfig{
@@ -675,6 +719,35 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with_consul_kv_condition_predicate",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						ConsulKv: &oapigen.ConsulKVCondition{
+							Path: "config/feature-x",
+							Predicate: &oapigen.ConsulKVPredicate{
+								Equals: config.String("enabled"),
+							},
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.ConsulKVConditionConfig{
+					ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+						Path: config.String("config/feature-x"),
+					},
+					Predicate: &config.ConsulKVPredicateConfig{
+						Equals: config.String("enabled"),
+					},
+				},
+			},
+		},
 		{
 			name: "with_schedule_condition",
 			request: &TaskRequest{
@@ -708,6 +781,31 @@ func TestTaskRequest_ToTaskConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with_schedule_condition_time_zone",
+			request: &TaskRequest{
+				Task: oapigen.Task{
+					Name:   "task",
+					Module: "path",
+					Condition: oapigen.Condition{
+						Schedule: &oapigen.ScheduleCondition{
+							Cron:     "0 0 18 * * * *",
+							TimeZone: config.String("America/New_York"),
+						},
+					},
+				},
+			},
+			taskConfigExpected: config.TaskConfig{
+				Name:   config.String("task"),
+				Module: config.String("path"),
+				Condition: &config.ScheduleConditionConfig{
+					ScheduleMonitorConfig: config.ScheduleMonitorConfig{
+						Cron:     config.String("0 0 18 * * * *"),
+						TimeZone: config.String("America/New_York"),
+					},
+				},
+			},
+		},
 		{
 			name: "with_module_inputs",
 			request: &TaskRequest{
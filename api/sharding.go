@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var _ Interceptor = (*ShardInterceptor)(nil)
+
+// Sharder reports how tasks are partitioned amongst the CTS instances of a
+// cluster configured with sharding
+type Sharder interface {
+	InstanceID() string
+	Members() []string
+	TaskOwner(taskName string) string
+}
+
+// ShardingStatus is the status of the CTS instance in a cluster of CTS
+// instances configured with sharding
+type ShardingStatus struct {
+	InstanceID string            `json:"instance_id"`
+	Members    []string          `json:"members"`
+	TaskOwners map[string]string `json:"task_owners"`
+}
+
+// newShardingStatus returns the sharding status reported by the sharder for
+// the given tasks. Returns nil if sharding is not configured.
+func newShardingStatus(s Sharder, taskNames []string) *ShardingStatus {
+	if s == nil {
+		return nil
+	}
+
+	owners := make(map[string]string, len(taskNames))
+	for _, name := range taskNames {
+		owners[name] = s.TaskOwner(name)
+	}
+	return &ShardingStatus{
+		InstanceID: s.InstanceID(),
+		Members:    s.Members(),
+		TaskOwners: owners,
+	}
+}
+
+// ShardInterceptor intercepts requests that modify tasks which are owned by
+// another CTS instance of the sharded cluster. Only the owner executes a task,
+// so these requests must be sent to the owner instead.
+type ShardInterceptor struct {
+	sharder Sharder
+}
+
+// NewShardInterceptor returns an interceptor for requests to a CTS instance
+// configured with sharding
+func NewShardInterceptor(s Sharder) *ShardInterceptor {
+	return &ShardInterceptor{sharder: s}
+}
+
+// ShouldIntercept returns true for requests that create tasks or that modify
+// tasks owned by another instance
+func (i *ShardInterceptor) ShouldIntercept(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	taskName, ok := shardTaskName(r)
+	if !ok {
+		return false
+	}
+	if taskName == "" {
+		return true
+	}
+	return i.sharder.TaskOwner(taskName) != i.sharder.InstanceID()
+}
+
+// Intercept responds that the request must be sent to the owner of the task
+func (i *ShardInterceptor) Intercept(w http.ResponseWriter, r *http.Request) {
+	taskName, _ := shardTaskName(r)
+	if taskName == "" {
+		err := errors.New("tasks cannot be created at runtime when sharding " +
+			"is enabled. add the task to the configuration of all CTS instances " +
+			"instead")
+		sendError(w, r, http.StatusMethodNotAllowed, err)
+		return
+	}
+
+	owner := i.sharder.TaskOwner(taskName)
+	if owner == "" {
+		err := fmt.Errorf("task '%s' is not currently assigned to any CTS "+
+			"instance. retry the request once the instance has joined the "+
+			"cluster", taskName)
+		sendError(w, r, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	err := fmt.Errorf("task '%s' is executed by CTS instance '%s'. send the "+
+		"request to that instance instead", taskName, owner)
+	sendError(w, r, http.StatusMisdirectedRequest, err)
+}
+
+// shardTaskName returns the name of the task from the path of a request for
+// the tasks endpoint. The name is empty for requests to the endpoint itself.
+// Returns false if the request is not for the tasks endpoint.
+func shardTaskName(r *http.Request) (string, bool) {
+	taskPathPrefix := fmt.Sprintf("/%s/%s", defaultAPIVersion, taskPath)
+	if r.URL.Path == taskPathPrefix {
+		return "", true
+	}
+
+	rest, ok := strings.CutPrefix(r.URL.Path, taskPathPrefix+"/")
+	if !ok {
+		return "", false
+	}
+	taskName, _, _ := strings.Cut(rest, "/")
+	return taskName, true
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardInterceptor_ShouldIntercept(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		method   string
+		path     string
+		expected bool
+	}{
+		{
+			"create_task",
+			http.MethodPost,
+			"/v1/tasks",
+			true,
+		},
+		{
+			"update_owned_task",
+			http.MethodPatch,
+			"/v1/tasks/task_a",
+			false,
+		},
+		{
+			"update_task_owned_by_other",
+			http.MethodPatch,
+			"/v1/tasks/task_b",
+			true,
+		},
+		{
+			"delete_task_owned_by_other",
+			http.MethodDelete,
+			"/v1/tasks/task_b",
+			true,
+		},
+		{
+			"update_unassigned_task",
+			http.MethodPatch,
+			"/v1/tasks/task_c",
+			true,
+		},
+		{
+			"get_task_owned_by_other",
+			http.MethodGet,
+			"/v1/tasks/task_b",
+			false,
+		},
+		{
+			"status",
+			http.MethodGet,
+			"/v1/status",
+			false,
+		},
+		{
+			"other_path",
+			http.MethodPost,
+			"/v1/tasksx",
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			i := NewShardInterceptor(newTestSharder())
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			assert.Equal(t, tc.expected, i.ShouldIntercept(req))
+		})
+	}
+}
+
+func TestShardInterceptor_Intercept(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		method   string
+		path     string
+		code     int
+		contains string
+	}{
+		{
+			"create_task",
+			http.MethodPost,
+			"/v1/tasks",
+			http.StatusMethodNotAllowed,
+			"cannot be created at runtime",
+		},
+		{
+			"task_owned_by_other",
+			http.MethodPatch,
+			"/v1/tasks/task_b",
+			http.StatusMisdirectedRequest,
+			"executed by CTS instance 'cts-02'",
+		},
+		{
+			"unassigned_task",
+			http.MethodPatch,
+			"/v1/tasks/task_c",
+			http.StatusServiceUnavailable,
+			"not currently assigned",
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			i := NewShardInterceptor(newTestSharder())
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			resp := httptest.NewRecorder()
+			i.Intercept(resp, req)
+
+			assert.Equal(t, tc.code, resp.Code)
+			assert.Contains(t, resp.Body.String(), tc.contains)
+		})
+	}
+}
+
+func TestOverallStatus_ServeHTTP_Sharding(t *testing.T) {
+	t.Parallel()
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Events", mock.Anything, "").Return(map[string][]event.Event{}, nil).
+		On("Tasks", mock.Anything).Return(config.TaskConfigs{
+		{Name: config.String("task_a"), Enabled: config.Bool(true)},
+		{Name: config.String("task_b"), Enabled: config.Bool(true)},
+	})
+
+	handler := newOverallStatusHandler(ctrl, "v1")
+	handler.sharder = newTestSharder()
+
+	req, err := http.NewRequest(http.MethodGet, "/v1/status", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var actual OverallStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+	assert.Nil(t, actual.HighAvailability)
+	assert.Equal(t, &ShardingStatus{
+		InstanceID: "cts-01",
+		Members:    []string{"cts-01", "cts-02"},
+		TaskOwners: map[string]string{
+			"task_a": "cts-01",
+			"task_b": "cts-02",
+		},
+	}, actual.Sharding)
+}
+
+type testSharder struct {
+	owners map[string]string
+}
+
+func newTestSharder() *testSharder {
+	return &testSharder{owners: map[string]string{
+		"task_a": "cts-01",
+		"task_b": "cts-02",
+	}}
+}
+
+func (s *testSharder) InstanceID() string { return "cts-01" }
+func (s *testSharder) Members() []string  { return []string{"cts-01", "cts-02"} }
+func (s *testSharder) TaskOwner(taskName string) string {
+	return s.owners[taskName]
+}
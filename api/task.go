@@ -22,6 +22,8 @@ const (
 	deleteTaskSubsystemName = "deletetask"
 	getTaskSubsystemName    = "gettask"
 
+	getTaskEventsSubsystemName = "gettaskevents"
+
 	taskPath = "tasks"
 
 	RunOptionInspect = "inspect"
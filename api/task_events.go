@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+)
+
+const defaultTaskEventsLimit = 20
+
+// GetTaskEvents retrieves the events of a task in reverse chronological order,
+// filtered and paginated by the request parameters
+func (h *TaskLifeCycleHandler) GetTaskEvents(w http.ResponseWriter, r *http.Request,
+	name string, params oapigen.GetTaskEventsParams) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(ctx).Named(getTaskEventsSubsystemName).With("task_name", name)
+	logger.Trace("get task events request", "params", params)
+
+	if _, err := h.ctrl.Task(ctx, name); err != nil {
+		logger.Trace("task not found", "error", err)
+		sendError(w, r, http.StatusNotFound, err)
+		return
+	}
+
+	data, err := h.ctrl.Events(ctx, name)
+	if err != nil {
+		logger.Trace("error retrieving task events", "error", err)
+		sendError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	events := filterEvents(data[name], params)
+	limit := defaultTaskEventsLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	page, next, err := paginateEvents(events, params.Cursor, limit)
+	if err != nil {
+		logger.Trace("invalid cursor", "error", err)
+		sendError(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	resp := oapigen.TaskEventsResponse{
+		Events:    make([]oapigen.Event, 0, len(page)),
+		RequestId: requestID,
+	}
+	for _, e := range page {
+		resp.Events = append(resp.Events, eventFromStateEvent(e))
+	}
+	if next != "" {
+		resp.NextCursor = &next
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task events retrieved", "count", len(resp.Events))
+}
+
+// filterEvents returns the events that match the time range, type, success
+// and error code filters of the request parameters
+func filterEvents(events []event.Event, params oapigen.GetTaskEventsParams) []event.Event {
+	filtered := make([]event.Event, 0, len(events))
+	for _, e := range events {
+		if params.Since != nil && e.EndTime.Before(*params.Since) {
+			continue
+		}
+		if params.Until != nil && !e.EndTime.Before(*params.Until) {
+			continue
+		}
+		if params.Type != nil && eventType(e) != *params.Type {
+			continue
+		}
+		if params.Success != nil && e.Success != *params.Success {
+			continue
+		}
+		if params.ErrorCode != nil && (e.EventError == nil ||
+			e.EventError.Code != string(*params.ErrorCode)) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered
+}
+
+// paginateEvents returns a page of at most limit events that follow the event
+// referenced by the cursor, and the cursor for the next page. The next cursor
+// is empty if there are no more events. Events are expected to be sorted in
+// reverse chronological order.
+func paginateEvents(events []event.Event, cursor *string, limit int) ([]event.Event, string, error) {
+	start := 0
+	if cursor != nil && *cursor != "" {
+		endTime, id, err := decodeEventCursor(*cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// resume after the event of the cursor. if the event is no longer
+		// retained, resume from the first event that ended before it.
+		start = len(events)
+		for i, e := range events {
+			if e.ID == id {
+				start = i + 1
+				break
+			}
+		}
+		if start == len(events) {
+			for i, e := range events {
+				if e.EndTime.Before(endTime) {
+					start = i
+					break
+				}
+			}
+		}
+	}
+
+	end := start + limit
+	if end >= len(events) {
+		return events[start:], "", nil
+	}
+	return events[start:end], encodeEventCursor(events[end-1]), nil
+}
+
+// encodeEventCursor returns an opaque cursor that references the event
+func encodeEventCursor(e event.Event) string {
+	raw := fmt.Sprintf("%d:%s", e.EndTime.UnixNano(), e.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeEventCursor returns the end time and ID of the event referenced by the
+// cursor
+func decodeEventCursor(cursor string) (time.Time, string, error) {
+	invalidErr := fmt.Errorf("invalid cursor '%s'. use the next_cursor value "+
+		"returned by the previous request", cursor)
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", invalidErr
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return time.Time{}, "", invalidErr
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", invalidErr
+	}
+	return time.Unix(0, n), id, nil
+}
+
+// eventFromStateEvent converts a task event from the state to the API
+// representation
+func eventFromStateEvent(e event.Event) oapigen.Event {
+	ev := oapigen.Event{
+		Id:        e.ID,
+		TaskName:  e.TaskName,
+		Type:      eventTypePtr(eventType(e)),
+		Success:   e.Success,
+		StartTime: e.StartTime,
+		EndTime:   e.EndTime,
+	}
+	if e.EventError != nil {
+		ev.Error = &oapigen.EventError{
+			Code:    oapigen.EventErrorCode(e.EventError.Code),
+			Message: e.EventError.Message,
+		}
+	}
+	if e.Plan != nil {
+		ev.Plan = &oapigen.EventPlan{
+			Add:             e.Plan.Add,
+			Change:          e.Plan.Change,
+			Destroy:         e.Plan.Destroy,
+			Resources:       e.Plan.Resources,
+			Output:          e.Plan.Output,
+			OutputTruncated: e.Plan.Truncated,
+		}
+	}
+	return ev
+}
+
+// eventType returns the API type of a task event. Events stored before event
+// types were introduced are runs of the task.
+func eventType(e event.Event) oapigen.EventType {
+	if e.IsDrift() {
+		return oapigen.EventTypeDrift
+	}
+	return oapigen.EventTypeRun
+}
+
+func eventTypePtr(t oapigen.EventType) *oapigen.EventType {
+	return &t
+}
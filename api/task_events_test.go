@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLifeCycleHandler_GetTaskEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	events := testTaskEvents(now, 5)
+
+	cases := []struct {
+		name       string
+		params     oapigen.GetTaskEventsParams
+		statusCode int
+		expectIDs  []string
+		expectNext bool
+	}{
+		{
+			name:       "all_events",
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e0", "e1", "e2", "e3", "e4"},
+		},
+		{
+			name:       "limit",
+			params:     oapigen.GetTaskEventsParams{Limit: intPtr(2)},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e0", "e1"},
+			expectNext: true,
+		},
+		{
+			name: "success_filter",
+			params: oapigen.GetTaskEventsParams{
+				Success: boolPtr(false),
+			},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e1", "e3"},
+		},
+		{
+			name: "time_range_filter",
+			params: oapigen.GetTaskEventsParams{
+				Since: timePtr(now.Add(-3 * time.Minute)),
+				Until: timePtr(now.Add(-time.Minute)),
+			},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e2", "e3"},
+		},
+		{
+			name: "drift_type_filter",
+			params: oapigen.GetTaskEventsParams{
+				Type: eventTypePtr(oapigen.EventTypeDrift),
+			},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e2"},
+		},
+		{
+			name: "run_type_filter",
+			params: oapigen.GetTaskEventsParams{
+				Type: eventTypePtr(oapigen.EventTypeRun),
+			},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e0", "e1", "e3", "e4"},
+		},
+		{
+			name: "error_code_filter",
+			params: oapigen.GetTaskEventsParams{
+				ErrorCode: errorCodePtr(oapigen.TemplateRenderFailure),
+			},
+			statusCode: http.StatusOK,
+			expectIDs:  []string{"e3"},
+		},
+		{
+			name: "invalid_cursor",
+			params: oapigen.GetTaskEventsParams{
+				Cursor: stringPtr("not a cursor"),
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			ctrl := new(mocks.Server)
+			ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+			ctrl.On("Events", mock.Anything, testTaskName).
+				Return(map[string][]event.Event{testTaskName: events}, nil)
+			handler := NewTaskLifeCycleHandler(ctrl)
+
+			path := fmt.Sprintf("/v1/tasks/%s/events", testTaskName)
+			req, err := http.NewRequest(http.MethodGet, path, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.GetTaskEvents(resp, req, testTaskName, tc.params)
+			require.Equal(t, tc.statusCode, resp.Code)
+			if tc.statusCode != http.StatusOK {
+				return
+			}
+
+			var actual oapigen.TaskEventsResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+			ids := make([]string, 0, len(actual.Events))
+			for _, e := range actual.Events {
+				ids = append(ids, e.Id)
+			}
+			assert.Equal(t, tc.expectIDs, ids)
+			assert.Equal(t, tc.expectNext, actual.NextCursor != nil)
+			for _, e := range actual.Events {
+				if e.Success {
+					assert.Nil(t, e.Error)
+				} else {
+					require.NotNil(t, e.Error)
+					assert.NotEmpty(t, e.Error.Code)
+				}
+			}
+		})
+	}
+
+	t.Run("not_found", func(t *testing.T) {
+		ctrl := new(mocks.Server)
+		ctrl.On("Task", mock.Anything, testTaskName).
+			Return(config.TaskConfig{}, fmt.Errorf("DNE"))
+		handler := NewTaskLifeCycleHandler(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/tasks/task/events", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+
+		handler.GetTaskEvents(resp, req, testTaskName, oapigen.GetTaskEventsParams{})
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestGetTaskEvents_Pagination(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	events := testTaskEvents(now, 5)
+
+	ctrl := new(mocks.Server)
+	ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+	ctrl.On("Events", mock.Anything, testTaskName).
+		Return(map[string][]event.Event{testTaskName: events}, nil)
+
+	api, err := NewAPI(context.Background(), Config{Controller: ctrl})
+	require.NoError(t, err)
+
+	// page through the events with the full router to also validate the
+	// request against the OpenAPI specification
+	var ids []string
+	query := url.Values{"limit": {"2"}}
+	for page := 0; page < 5; page++ {
+		path := fmt.Sprintf("/v1/tasks/%s/events?%s", testTaskName, query.Encode())
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		api.srv.Handler.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var actual oapigen.TaskEventsResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+		for _, e := range actual.Events {
+			ids = append(ids, e.Id)
+		}
+		if actual.NextCursor == nil {
+			break
+		}
+		query.Set("cursor", *actual.NextCursor)
+	}
+	assert.Equal(t, []string{"e0", "e1", "e2", "e3", "e4"}, ids)
+
+	t.Run("limit_out_of_range", func(t *testing.T) {
+		path := fmt.Sprintf("/v1/tasks/%s/events?limit=0", testTaskName)
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		api.srv.Handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		path := fmt.Sprintf("/v1/tasks/%s/events?type=bad", testTaskName)
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		api.srv.Handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("invalid_error_code", func(t *testing.T) {
+		path := fmt.Sprintf("/v1/tasks/%s/events?error_code=bad", testTaskName)
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		api.srv.Handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func Test_paginateEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	events := testTaskEvents(now, 4)
+
+	t.Run("cursor_event_removed", func(t *testing.T) {
+		// the event of the cursor is no longer retained, resume from the
+		// events that ended before it
+		cursor := encodeEventCursor(event.Event{
+			ID:      "removed",
+			EndTime: now.Add(-90 * time.Second),
+		})
+		page, next, err := paginateEvents(events, &cursor, 10)
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		require.Len(t, page, 2)
+		assert.Equal(t, "e2", page[0].ID)
+	})
+
+	t.Run("cursor_last_event", func(t *testing.T) {
+		cursor := encodeEventCursor(events[3])
+		page, next, err := paginateEvents(events, &cursor, 10)
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		assert.Empty(t, page)
+	})
+
+	t.Run("exact_page", func(t *testing.T) {
+		page, next, err := paginateEvents(events, nil, 4)
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		assert.Len(t, page, 4)
+	})
+}
+
+// testTaskEvents returns events in reverse chronological order that ended a
+// minute apart. Events with an odd index failed, alternating between apply and
+// template render failures. The event with index 2 is a drift event, and the
+// other events have no type as stored before event types were introduced.
+func testTaskEvents(now time.Time, count int) []event.Event {
+	events := make([]event.Event, count)
+	for i := range events {
+		end := now.Add(-time.Duration(i) * time.Minute)
+		events[i] = event.Event{
+			ID:        fmt.Sprintf("e%d", i),
+			TaskName:  testTaskName,
+			Success:   i%2 == 0,
+			StartTime: end.Add(-10 * time.Second),
+			EndTime:   end,
+		}
+		if i == 2 {
+			events[i].Type = event.TypeDrift
+		}
+		if !events[i].Success {
+			events[i].EventError = &event.Error{
+				Code:    event.ErrCodeTerraformApply,
+				Message: "error",
+			}
+			if i%4 == 3 {
+				events[i].EventError.Code = event.ErrCodeTemplateRender
+			}
+		}
+	}
+	return events
+}
+
+func Test_eventFromStateEvent(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	e := event.Event{
+		ID:        "e0",
+		TaskName:  testTaskName,
+		Type:      event.TypeDrift,
+		Success:   true,
+		StartTime: now.Add(-time.Second),
+		EndTime:   now,
+		Plan: &event.Plan{
+			Add:       1,
+			Destroy:   1,
+			Resources: []string{"local_file.a"},
+			Output:    "Plan: 1 to add, 0 to change, 1 to destroy.",
+			Truncated: true,
+		},
+	}
+
+	expected := oapigen.Event{
+		Id:        "e0",
+		TaskName:  testTaskName,
+		Type:      eventTypePtr(oapigen.EventTypeDrift),
+		Success:   true,
+		StartTime: now.Add(-time.Second),
+		EndTime:   now,
+		Plan: &oapigen.EventPlan{
+			Add:             1,
+			Destroy:         1,
+			Resources:       []string{"local_file.a"},
+			Output:          "Plan: 1 to add, 0 to change, 1 to destroy.",
+			OutputTruncated: true,
+		},
+	}
+	assert.Equal(t, expected, eventFromStateEvent(e))
+}
+
+func intPtr(i int) *int              { return &i }
+func boolPtr(b bool) *bool           { return &b }
+func stringPtr(s string) *string     { return &s }
+func timePtr(t time.Time) *time.Time { return &t }
+
+func errorCodePtr(c oapigen.EventErrorCode) *oapigen.EventErrorCode { return &c }
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+)
+
+const (
+	getTaskPlansSubsystemName    = "gettaskplans"
+	approveTaskPlanSubsystemName = "approvetaskplan"
+	rejectTaskPlanSubsystemName  = "rejecttaskplan"
+)
+
+// PendingPlan is a plan of the changes of a task with a manual apply mode that
+// is pending approval
+type PendingPlan struct {
+	ID        string
+	TaskName  string
+	CreatedAt time.Time
+	Plan      *event.Plan
+}
+
+// PlanApprover manages the plans of tasks with a manual apply mode that are
+// pending approval
+type PlanApprover interface {
+	// TaskPendingPlans returns the pending plans of the task
+	TaskPendingPlans(taskName string) []PendingPlan
+
+	// TaskApprovePlan applies the changes of the pending plan of the task
+	TaskApprovePlan(ctx context.Context, taskName, planID string) error
+
+	// TaskRejectPlan discards the pending plan of the task
+	TaskRejectPlan(ctx context.Context, taskName, planID string) error
+}
+
+// GetTaskPlans retrieves the plans of a task that are pending approval
+func (h *TaskLifeCycleHandler) GetTaskPlans(w http.ResponseWriter, r *http.Request, name string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ctx := r.Context()
+	requestID := requestIDFromContext(ctx)
+	logger := logging.FromContext(ctx).Named(getTaskPlansSubsystemName).With("task_name", name)
+	logger.Trace("get task plans request")
+
+	approver, ok := h.planApprover(w, r, name)
+	if !ok {
+		return
+	}
+
+	plans := approver.TaskPendingPlans(name)
+	resp := oapigen.TaskPlansResponse{
+		Plans:     make([]oapigen.PendingPlan, 0, len(plans)),
+		RequestId: requestID,
+	}
+	for _, p := range plans {
+		resp.Plans = append(resp.Plans, pendingPlanFromPlan(p))
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task plans retrieved", "count", len(resp.Plans))
+}
+
+// ApproveTaskPlan approves a pending plan of a task and applies its changes
+func (h *TaskLifeCycleHandler) ApproveTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ctx := r.Context()
+	logger := logging.FromContext(ctx).Named(approveTaskPlanSubsystemName).With(
+		"task_name", name, "plan_id", id)
+	logger.Trace("approve task plan request")
+
+	approver, ok := h.planApprover(w, r, name)
+	if !ok {
+		return
+	}
+	plan, ok := findPendingPlan(w, r, approver, name, id)
+	if !ok {
+		return
+	}
+
+	if err := approver.TaskApprovePlan(ctx, name, id); err != nil {
+		logger.Trace("error applying task plan", "error", err)
+		sendError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := oapigen.TaskPlanResponse{
+		Plan:      pendingPlanFromPlan(plan),
+		RequestId: requestIDFromContext(ctx),
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task plan approved")
+}
+
+// RejectTaskPlan rejects a pending plan of a task without applying its changes
+func (h *TaskLifeCycleHandler) RejectTaskPlan(w http.ResponseWriter, r *http.Request, name string, id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ctx := r.Context()
+	logger := logging.FromContext(ctx).Named(rejectTaskPlanSubsystemName).With(
+		"task_name", name, "plan_id", id)
+	logger.Trace("reject task plan request")
+
+	approver, ok := h.planApprover(w, r, name)
+	if !ok {
+		return
+	}
+	plan, ok := findPendingPlan(w, r, approver, name, id)
+	if !ok {
+		return
+	}
+
+	if err := approver.TaskRejectPlan(ctx, name, id); err != nil {
+		logger.Trace("error rejecting task plan", "error", err)
+		sendError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := oapigen.TaskPlanResponse{
+		Plan:      pendingPlanFromPlan(plan),
+		RequestId: requestIDFromContext(ctx),
+	}
+	writeResponse(w, r, http.StatusOK, resp)
+
+	logger.Trace("task plan rejected")
+}
+
+// planApprover checks that the task exists and returns the controller's plan
+// approver. Sends an error response and returns false otherwise.
+func (h *TaskLifeCycleHandler) planApprover(w http.ResponseWriter, r *http.Request,
+	name string) (PlanApprover, bool) {
+	if _, err := h.ctrl.Task(r.Context(), name); err != nil {
+		sendError(w, r, http.StatusNotFound, err)
+		return nil, false
+	}
+
+	approver, ok := h.ctrl.(PlanApprover)
+	if !ok {
+		sendError(w, r, http.StatusNotImplemented,
+			fmt.Errorf("approving task plans is not supported"))
+		return nil, false
+	}
+	return approver, true
+}
+
+// findPendingPlan returns the pending plan of the task by its ID. Sends an
+// error response and returns false if the plan is not pending.
+func findPendingPlan(w http.ResponseWriter, r *http.Request, approver PlanApprover,
+	name, id string) (PendingPlan, bool) {
+	for _, p := range approver.TaskPendingPlans(name) {
+		if p.ID == id {
+			return p, true
+		}
+	}
+
+	sendError(w, r, http.StatusNotFound, fmt.Errorf("plan '%s' is not pending "+
+		"for task '%s'. the plan may have been superseded by newer changes", id, name))
+	return PendingPlan{}, false
+}
+
+// pendingPlanFromPlan converts a pending plan to the API representation
+func pendingPlanFromPlan(p PendingPlan) oapigen.PendingPlan {
+	pp := oapigen.PendingPlan{
+		Id:        p.ID,
+		TaskName:  p.TaskName,
+		CreatedAt: p.CreatedAt,
+	}
+	if p.Plan != nil {
+		pp.Plan = &oapigen.EventPlan{
+			Add:             p.Plan.Add,
+			Change:          p.Plan.Change,
+			Destroy:         p.Plan.Destroy,
+			Resources:       p.Plan.Resources,
+			Output:          p.Plan.Output,
+			OutputTruncated: p.Plan.Truncated,
+		}
+	}
+	return pp
+}
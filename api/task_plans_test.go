@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testPlanServer is a mock controller that manages the pending plans of tasks
+type testPlanServer struct {
+	*mocks.Server
+	pending  map[string]PendingPlan
+	applyErr error
+	approved []string
+	rejected []string
+}
+
+func newTestPlanServer(plans ...PendingPlan) *testPlanServer {
+	s := &testPlanServer{
+		Server:  new(mocks.Server),
+		pending: make(map[string]PendingPlan),
+	}
+	for _, p := range plans {
+		s.pending[p.TaskName] = p
+	}
+	return s
+}
+
+func (s *testPlanServer) TaskPendingPlans(taskName string) []PendingPlan {
+	if p, ok := s.pending[taskName]; ok {
+		return []PendingPlan{p}
+	}
+	return nil
+}
+
+func (s *testPlanServer) TaskApprovePlan(_ context.Context, taskName, planID string) error {
+	if s.applyErr != nil {
+		return s.applyErr
+	}
+	delete(s.pending, taskName)
+	s.approved = append(s.approved, planID)
+	return nil
+}
+
+func (s *testPlanServer) TaskRejectPlan(_ context.Context, taskName, planID string) error {
+	delete(s.pending, taskName)
+	s.rejected = append(s.rejected, planID)
+	return nil
+}
+
+func testPendingPlan() PendingPlan {
+	return PendingPlan{
+		ID:        "plan-1",
+		TaskName:  testTaskName,
+		CreatedAt: time.Date(2025, time.June, 16, 8, 0, 0, 0, time.UTC),
+		Plan: &event.Plan{
+			Add:       1,
+			Resources: []string{"module.api-task.local_file.greeting"},
+			Output:    "Plan: 1 to add, 0 to change, 0 to destroy.",
+		},
+	}
+}
+
+func TestTaskLifeCycleHandler_GetTaskPlans(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pending_plan", func(t *testing.T) {
+		ctrl := newTestPlanServer(testPendingPlan())
+		ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+		handler := NewTaskLifeCycleHandler(ctrl)
+
+		path := fmt.Sprintf("/v1/tasks/%s/plans", testTaskName)
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+
+		handler.GetTaskPlans(resp, req, testTaskName)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var actual oapigen.TaskPlansResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+		require.Len(t, actual.Plans, 1)
+		assert.Equal(t, "plan-1", actual.Plans[0].Id)
+		assert.Equal(t, testTaskName, actual.Plans[0].TaskName)
+		require.NotNil(t, actual.Plans[0].Plan)
+		assert.Equal(t, 1, actual.Plans[0].Plan.Add)
+	})
+
+	t.Run("no_pending_plans", func(t *testing.T) {
+		ctrl := newTestPlanServer()
+		ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+		handler := NewTaskLifeCycleHandler(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/tasks/api-task/plans", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+
+		handler.GetTaskPlans(resp, req, testTaskName)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var actual oapigen.TaskPlansResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+		assert.NotNil(t, actual.Plans)
+		assert.Empty(t, actual.Plans)
+	})
+
+	t.Run("task_not_found", func(t *testing.T) {
+		ctrl := newTestPlanServer()
+		ctrl.On("Task", mock.Anything, testTaskName).
+			Return(config.TaskConfig{}, fmt.Errorf("DNE"))
+		handler := NewTaskLifeCycleHandler(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/tasks/api-task/plans", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+
+		handler.GetTaskPlans(resp, req, testTaskName)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("not_supported", func(t *testing.T) {
+		ctrl := new(mocks.Server)
+		ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+		handler := NewTaskLifeCycleHandler(ctrl)
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/tasks/api-task/plans", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+
+		handler.GetTaskPlans(resp, req, testTaskName)
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+	})
+}
+
+func TestTaskLifeCycleHandler_ApproveTaskPlan(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		planID     string
+		applyErr   error
+		statusCode int
+		approved   []string
+	}{
+		{
+			"approved",
+			"plan-1",
+			nil,
+			http.StatusOK,
+			[]string{"plan-1"},
+		},
+		{
+			"plan_not_pending",
+			"plan-0",
+			nil,
+			http.StatusNotFound,
+			nil,
+		},
+		{
+			"apply_error",
+			"plan-1",
+			fmt.Errorf("error tf-apply"),
+			http.StatusInternalServerError,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := newTestPlanServer(testPendingPlan())
+			ctrl.applyErr = tc.applyErr
+			ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+			handler := NewTaskLifeCycleHandler(ctrl)
+
+			path := fmt.Sprintf("/v1/tasks/%s/plans/%s:approve", testTaskName, tc.planID)
+			req, err := http.NewRequest(http.MethodPost, path, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.ApproveTaskPlan(resp, req, testTaskName, tc.planID)
+			require.Equal(t, tc.statusCode, resp.Code)
+			assert.Equal(t, tc.approved, ctrl.approved)
+			if tc.statusCode != http.StatusOK {
+				return
+			}
+
+			var actual oapigen.TaskPlanResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+			assert.Equal(t, tc.planID, actual.Plan.Id)
+		})
+	}
+}
+
+func TestTaskLifeCycleHandler_RejectTaskPlan(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		planID     string
+		statusCode int
+		rejected   []string
+	}{
+		{
+			"rejected",
+			"plan-1",
+			http.StatusOK,
+			[]string{"plan-1"},
+		},
+		{
+			"plan_not_pending",
+			"plan-0",
+			http.StatusNotFound,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := newTestPlanServer(testPendingPlan())
+			ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+			handler := NewTaskLifeCycleHandler(ctrl)
+
+			path := fmt.Sprintf("/v1/tasks/%s/plans/%s:reject", testTaskName, tc.planID)
+			req, err := http.NewRequest(http.MethodPost, path, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+
+			handler.RejectTaskPlan(resp, req, testTaskName, tc.planID)
+			require.Equal(t, tc.statusCode, resp.Code)
+			assert.Equal(t, tc.rejected, ctrl.rejected)
+		})
+	}
+}
+
+func TestTaskPlans_Routes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := newTestPlanServer(testPendingPlan())
+	ctrl.On("Task", mock.Anything, testTaskName).Return(testTaskConfig, nil)
+
+	api, err := NewAPI(context.Background(), Config{Controller: ctrl})
+	require.NoError(t, err)
+
+	// serve the requests with the full router to also validate the requests
+	// against the OpenAPI specification
+	path := fmt.Sprintf("/v1/tasks/%s/plans", testTaskName)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	api.srv.Handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, path+"/plan-1:approve", nil)
+	resp = httptest.NewRecorder()
+	api.srv.Handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	assert.Equal(t, []string{"plan-1"}, ctrl.approved)
+
+	req = httptest.NewRequest(http.MethodPost, path+"/plan-1:reject", nil)
+	resp = httptest.NewRecorder()
+	api.srv.Handler.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code, resp.Body.String())
+}
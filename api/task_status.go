@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
@@ -35,6 +36,66 @@ type TaskStatus struct {
 	//  with the removal of CTS config task.services
 	Providers []string `json:"providers"`
 	Services  []string `json:"services"`
+
+	// Blackout is only set when the task has changes that are pending the end
+	// of a blackout window
+	Blackout *BlackoutStatus `json:"blackout,omitempty"`
+
+	// NextRunTime is only set for scheduled tasks and is the next time that
+	// the task is scheduled to run
+	NextRunTime *time.Time `json:"next_run_time,omitempty"`
+}
+
+// BlackoutStatus is the status of the changes of a task that are deferred
+// until the end of a blackout window
+type BlackoutStatus struct {
+	Pending bool      `json:"pending"`
+	EndsAt  time.Time `json:"ends_at"`
+}
+
+// BlackoutReporter reports the tasks with changes that are deferred until the
+// end of a blackout window
+type BlackoutReporter interface {
+	TaskBlackout(taskName string) (time.Time, bool)
+}
+
+// newBlackoutStatus returns the blackout status of the task. Returns nil if the
+// task has no pending changes or the controller does not report blackouts.
+func newBlackoutStatus(ctrl Server, taskName string) *BlackoutStatus {
+	r, ok := ctrl.(BlackoutReporter)
+	if !ok {
+		return nil
+	}
+
+	end, pending := r.TaskBlackout(taskName)
+	if !pending {
+		return nil
+	}
+	return &BlackoutStatus{
+		Pending: true,
+		EndsAt:  end,
+	}
+}
+
+// ScheduleReporter reports the next time that scheduled tasks run
+type ScheduleReporter interface {
+	TaskNextRun(taskName string) (time.Time, bool)
+}
+
+// newNextRunTime returns the next time that the task is scheduled to run.
+// Returns nil if the task is not scheduled or the controller does not report
+// schedules.
+func newNextRunTime(ctrl Server, taskName string) *time.Time {
+	r, ok := ctrl.(ScheduleReporter)
+	if !ok {
+		return nil
+	}
+
+	next, ok := r.TaskNextRun(taskName)
+	if !ok {
+		return nil
+	}
+	return &next
 }
 
 // taskStatusHandler handles the task status endpoint
@@ -111,6 +172,8 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 		if include {
 			status.Events = events
 		}
+		status.Blackout = newBlackoutStatus(h.ctrl, taskName)
+		status.NextRunTime = newNextRunTime(h.ctrl, taskName)
 		statuses[taskName] = status
 	}
 
@@ -124,7 +187,10 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 				jsonErrorResponse(ctx, w, http.StatusNotFound, err)
 				return
 			}
-			statuses[taskName] = makeTaskStatusUnknown(task)
+			status := makeTaskStatusUnknown(task)
+			status.Blackout = newBlackoutStatus(h.ctrl, taskName)
+			status.NextRunTime = newNextRunTime(h.ctrl, taskName)
+			statuses[taskName] = status
 		}
 	}
 
@@ -134,7 +200,10 @@ func (h *taskStatusHandler) getTaskStatus(w http.ResponseWriter, r *http.Request
 		tasks := h.ctrl.Tasks(ctx)
 		for _, task := range tasks {
 			if _, ok := data[*task.Name]; !ok {
-				statuses[*task.Name] = makeTaskStatusUnknown(*task)
+				status := makeTaskStatusUnknown(*task)
+				status.Blackout = newBlackoutStatus(h.ctrl, *task.Name)
+				status.NextRunTime = newNextRunTime(h.ctrl, *task.Name)
+				statuses[*task.Name] = status
 			}
 		}
 	}
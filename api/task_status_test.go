@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	serverMocks "github.com/hashicorp/consul-terraform-sync/mocks/server"
@@ -315,6 +316,103 @@ func TestTaskStatus_ServeHTTP(t *testing.T) {
 
 }
 
+// testBlackoutServer is a mock controller that reports the tasks with changes
+// pending the end of a blackout window
+type testBlackoutServer struct {
+	*serverMocks.Server
+	pending map[string]time.Time
+}
+
+func (s *testBlackoutServer) TaskBlackout(taskName string) (time.Time, bool) {
+	end, ok := s.pending[taskName]
+	return end, ok
+}
+
+func TestTaskStatus_ServeHTTP_Blackout(t *testing.T) {
+	t.Parallel()
+
+	end := time.Date(2025, time.June, 16, 8, 0, 0, 0, time.UTC)
+	taskA := createTaskConf("task_a", true)
+	taskB := createTaskConf("task_b", true)
+
+	ctrl := &testBlackoutServer{
+		Server:  new(serverMocks.Server),
+		pending: map[string]time.Time{"task_a": end},
+	}
+	ctrl.On("Events", mock.Anything, "").Return(map[string][]event.Event{
+		"task_a": {{Success: true}},
+	}, nil)
+	ctrl.On("Task", mock.Anything, "task_a").Return(taskA, nil)
+	ctrl.On("Tasks", mock.Anything).Return(config.TaskConfigs{&taskA, &taskB})
+
+	handler := newTaskStatusHandler(ctrl, "v1")
+
+	req, err := http.NewRequest(http.MethodGet, "/v1/status/tasks", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var actual map[string]TaskStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+
+	require.Contains(t, actual, "task_a")
+	require.NotNil(t, actual["task_a"].Blackout)
+	assert.True(t, actual["task_a"].Blackout.Pending)
+	assert.True(t, end.Equal(actual["task_a"].Blackout.EndsAt))
+
+	require.Contains(t, actual, "task_b")
+	assert.Nil(t, actual["task_b"].Blackout)
+}
+
+// testScheduleServer is a mock controller that reports the next time that
+// scheduled tasks run
+type testScheduleServer struct {
+	*serverMocks.Server
+	nextRuns map[string]time.Time
+}
+
+func (s *testScheduleServer) TaskNextRun(taskName string) (time.Time, bool) {
+	next, ok := s.nextRuns[taskName]
+	return next, ok
+}
+
+func TestTaskStatus_ServeHTTP_NextRunTime(t *testing.T) {
+	t.Parallel()
+
+	next := time.Date(2025, time.June, 13, 22, 0, 0, 0, time.UTC)
+	taskA := createTaskConf("task_a", true)
+	taskB := createTaskConf("task_b", true)
+
+	ctrl := &testScheduleServer{
+		Server:   new(serverMocks.Server),
+		nextRuns: map[string]time.Time{"task_a": next},
+	}
+	ctrl.On("Events", mock.Anything, "").Return(map[string][]event.Event{
+		"task_b": {{Success: true}},
+	}, nil)
+	ctrl.On("Task", mock.Anything, "task_b").Return(taskB, nil)
+	ctrl.On("Tasks", mock.Anything).Return(config.TaskConfigs{&taskA, &taskB})
+
+	handler := newTaskStatusHandler(ctrl, "v1")
+
+	req, err := http.NewRequest(http.MethodGet, "/v1/status/tasks", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var actual map[string]TaskStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+
+	require.Contains(t, actual, "task_a")
+	require.NotNil(t, actual["task_a"].NextRunTime)
+	assert.True(t, next.Equal(*actual["task_a"].NextRunTime))
+
+	require.Contains(t, actual, "task_b")
+	assert.Nil(t, actual["task_b"].NextRunTime)
+}
+
 func TestTaskStatus_MakeStatus(t *testing.T) {
 	enabledTask := createTaskConf("test_task", true)
 	disabledTask := createTaskConf("test_task", false)
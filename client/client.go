@@ -6,6 +6,8 @@ package client
 import (
 	"context"
 	"io"
+
+	"github.com/hashicorp/terraform-json"
 )
 
 //go:generate mockery --name=Client --filename=client.go  --output=../mocks/client
@@ -28,9 +30,41 @@ type Client interface {
 	// Plan makes a request to generate a plan of proposed changes
 	Plan(ctx context.Context) (bool, error)
 
+	// SavePlan makes a request to generate a plan of proposed changes and
+	// saves it to be applied by ApplyPlan. Returns the saved plan.
+	SavePlan(ctx context.Context) (*tfjson.Plan, error)
+
+	// ApplyPlan makes a request to apply the changes of the plan saved by
+	// SavePlan
+	ApplyPlan(ctx context.Context) error
+
+	// InspectPlan makes a request to generate a plan of proposed changes
+	// without saving it to be applied. Returns the generated plan.
+	InspectPlan(ctx context.Context) (*tfjson.Plan, error)
+
+	// InspectDrift makes a request to generate a refresh-only plan that
+	// detects changes made to the infrastructure outside of the client.
+	// Returns the generated plan.
+	InspectDrift(ctx context.Context) (*tfjson.Plan, error)
+
 	// Validate verifies that the generated configurations are valid
 	Validate(ctx context.Context) error
 
 	// GoString defines the printable version of the client
 	GoString() string
 }
+
+// Destroyer is implemented by clients that release the resources of a task,
+// e.g. processes, when the task is destroyed
+type Destroyer interface {
+	// Destroy releases the resources of the task of the client
+	Destroy(ctx context.Context) error
+}
+
+// Closer is implemented by clients that hold resources, e.g. processes, that
+// can be released without destroying the task
+type Closer interface {
+	// Close releases the resources of the client. The resources are acquired
+	// again on next use of the client.
+	Close()
+}
@@ -81,6 +81,10 @@ type ConsulClientInterface interface {
 	Lock(l *consulapi.Lock, stopCh <-chan struct{}) (<-chan struct{}, error)
 	Unlock(l *consulapi.Lock) error
 	KVGet(ctx context.Context, key string, q *consulapi.QueryOptions) (*consulapi.KVPair, *consulapi.QueryMeta, error)
+	KVList(ctx context.Context, prefix string, q *consulapi.QueryOptions) (consulapi.KVPairs, *consulapi.QueryMeta, error)
+	KVPut(ctx context.Context, p *consulapi.KVPair, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
+	KVDelete(ctx context.Context, key string, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
+	KVDeleteTree(ctx context.Context, prefix string, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
 	QueryServices(ctx context.Context, filter string, q *consulapi.QueryOptions) ([]*consulapi.AgentService, error)
 	GetHealthChecks(ctx context.Context, serviceName string, q *consulapi.QueryOptions) (consulapi.HealthChecks, error)
 }
@@ -353,6 +357,87 @@ func (c *ConsulClient) KVGet(ctx context.Context, key string, q *consulapi.Query
 	return kv, meta, nil
 }
 
+// KVList fetches all Consul KV pairs under a prefix, retrying the request on
+// server errors and rate limit errors.
+func (c *ConsulClient) KVList(ctx context.Context, prefix string, q *consulapi.QueryOptions) (consulapi.KVPairs, *consulapi.QueryMeta, error) {
+	c.logger.Debug("listing KV pairs", "prefix", prefix)
+	desc := "KVList"
+	var kvs consulapi.KVPairs
+	var meta *consulapi.QueryMeta
+	f := func(context.Context) error {
+		var err error
+		kvs, meta, err = c.KV().List(prefix, q)
+		return wrapError(ctx, err)
+	}
+
+	err := c.retry.Do(ctx, f, desc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return kvs, meta, nil
+}
+
+// KVPut writes a Consul KV pair, retrying the request on server errors and
+// rate limit errors.
+func (c *ConsulClient) KVPut(ctx context.Context, p *consulapi.KVPair, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	c.logger.Debug("putting KV pair", "key", p.Key)
+	desc := "KVPut"
+	var meta *consulapi.WriteMeta
+	f := func(context.Context) error {
+		var err error
+		meta, err = c.KV().Put(p, q)
+		return wrapError(ctx, err)
+	}
+
+	err := c.retry.Do(ctx, f, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// KVDelete deletes a Consul KV pair, retrying the request on server errors and
+// rate limit errors.
+func (c *ConsulClient) KVDelete(ctx context.Context, key string, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	c.logger.Debug("deleting KV pair", "key", key)
+	desc := "KVDelete"
+	var meta *consulapi.WriteMeta
+	f := func(context.Context) error {
+		var err error
+		meta, err = c.KV().Delete(key, q)
+		return wrapError(ctx, err)
+	}
+
+	err := c.retry.Do(ctx, f, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// KVDeleteTree deletes all Consul KV pairs under a prefix, retrying the
+// request on server errors and rate limit errors.
+func (c *ConsulClient) KVDeleteTree(ctx context.Context, prefix string, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	c.logger.Debug("deleting KV tree", "prefix", prefix)
+	desc := "KVDeleteTree"
+	var meta *consulapi.WriteMeta
+	f := func(context.Context) error {
+		var err error
+		meta, err = c.KV().DeleteTree(prefix, q)
+		return wrapError(ctx, err)
+	}
+
+	err := c.retry.Do(ctx, f, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
 // QueryServices returns a subset of the locally registered services that match the given filter
 // expression and QueryOptions.
 func (c *ConsulClient) QueryServices(ctx context.Context, filter string, opts *consulapi.QueryOptions) ([]*consulapi.AgentService, error) {
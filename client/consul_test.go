@@ -426,6 +426,152 @@ func TestKVGet(t *testing.T) {
 	}
 }
 
+func TestConsulClient_KVWrite(t *testing.T) {
+	t.Parallel()
+
+	var nonRetryableError *retry.NonRetryableError
+	var missingConsulACLError *MissingConsulACLError
+	cases := []struct {
+		name                string
+		responseCode        int
+		expectErr           bool
+		isNonRetryableError bool
+		isMissingAClError   bool
+	}{
+		{
+			name:         "success",
+			responseCode: http.StatusOK,
+		},
+		{
+			name:                "non_retryable_error",
+			responseCode:        http.StatusBadRequest,
+			expectErr:           true,
+			isNonRetryableError: true,
+		},
+		{
+			name:         "retryable_error",
+			responseCode: http.StatusInternalServerError,
+			expectErr:    true,
+		},
+		{
+			name:                "acl_error",
+			responseCode:        http.StatusForbidden,
+			expectErr:           true,
+			isNonRetryableError: true,
+			isMissingAClError:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		key := "test"
+		intercepts := []*testutils.HttpIntercept{
+			{
+				Path:               "/v1/kv/" + key,
+				ResponseStatusCode: tc.responseCode,
+				ResponseData:       []byte("true"),
+			},
+			{
+				Path:               "/v1/kv/" + key + "?recurse=",
+				ResponseStatusCode: tc.responseCode,
+				ResponseData:       []byte("true"),
+			},
+		}
+
+		assertErr := func(t *testing.T, err error) {
+			if !tc.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			// Verify the error types
+			assert.Equal(t, tc.isNonRetryableError, errors.As(err, &nonRetryableError))
+			assert.Equal(t, tc.isMissingAClError, errors.As(err, &missingConsulACLError))
+		}
+
+		t.Run("put_"+tc.name, func(t *testing.T) {
+			c := newTestConsulClient(t, testutils.NewHttpClient(t, intercepts), 1)
+			_, err := c.KVPut(context.Background(), &consulapi.KVPair{
+				Key:   key,
+				Value: []byte("value"),
+			}, nil)
+			assertErr(t, err)
+		})
+
+		t.Run("delete_"+tc.name, func(t *testing.T) {
+			c := newTestConsulClient(t, testutils.NewHttpClient(t, intercepts), 1)
+			_, err := c.KVDelete(context.Background(), key, nil)
+			assertErr(t, err)
+		})
+
+		t.Run("delete_tree_"+tc.name, func(t *testing.T) {
+			c := newTestConsulClient(t, testutils.NewHttpClient(t, intercepts), 1)
+			_, err := c.KVDeleteTree(context.Background(), key, nil)
+			assertErr(t, err)
+		})
+	}
+}
+
+func TestConsulClient_KVList(t *testing.T) {
+	t.Parallel()
+
+	var nonRetryableError *retry.NonRetryableError
+	cases := []struct {
+		name                string
+		responseCode        int
+		responseBody        string
+		expectedKeys        []string
+		expectErr           bool
+		isNonRetryableError bool
+	}{
+		{
+			name:         "success",
+			responseCode: http.StatusOK,
+			responseBody: `[
+  {"Key": "prefix/a", "Value": "dGVzdA=="},
+  {"Key": "prefix/b", "Value": "dGVzdA=="}
+]`,
+			expectedKeys: []string{"prefix/a", "prefix/b"},
+		},
+		{
+			name:         "prefix_does_not_exist",
+			responseCode: http.StatusNotFound,
+		},
+		{
+			name:                "non_retryable_error",
+			responseCode:        http.StatusBadRequest,
+			expectErr:           true,
+			isNonRetryableError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			intercepts := []*testutils.HttpIntercept{
+				{
+					Path:               "/v1/kv/prefix/?recurse=",
+					ResponseStatusCode: tc.responseCode,
+					ResponseData:       []byte(tc.responseBody),
+				},
+			}
+			c := newTestConsulClient(t, testutils.NewHttpClient(t, intercepts), 1)
+
+			pairs, _, err := c.KVList(context.Background(), "prefix/", nil)
+			if tc.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tc.isNonRetryableError, errors.As(err, &nonRetryableError))
+				return
+			}
+
+			require.NoError(t, err)
+			var keys []string
+			for _, p := range pairs {
+				keys = append(keys, p.Key)
+			}
+			assert.Equal(t, tc.expectedKeys, keys)
+		})
+	}
+}
+
 func TestConsulClient_QueryServices(t *testing.T) {
 	t.Parallel()
 	path := "/v1/agent/services"
@@ -0,0 +1,381 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/plugin"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
+	"github.com/hashicorp/terraform-json"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const pluginSubsystemName = "plugin"
+
+var (
+	_ Client    = (*Plugin)(nil)
+	_ Destroyer = (*Plugin)(nil)
+	_ Closer    = (*Plugin)(nil)
+)
+
+// PluginConfig configures the plugin client
+type PluginConfig struct {
+	Path         string
+	Args         []string
+	StartTimeout time.Duration
+	WorkingDir   string
+	Task         plugin.Task
+}
+
+// pluginProcess is the process of a launched plugin
+type pluginProcess interface {
+	Driver() plugin.Driver
+	Exited() bool
+	Kill()
+}
+
+// Plugin is the client that executes tasks with an external plugin driver.
+// The plugin is launched on first use and receives the module inputs that are
+// rendered to the working directory of the task.
+type Plugin struct {
+	mu sync.Mutex
+
+	config  *PluginConfig
+	env     map[string]string
+	stdout  io.Writer
+	process pluginProcess
+
+	// savedRequest is the request of the plan saved by SavePlan to be applied
+	// by ApplyPlan
+	savedRequest *plugin.TaskRequest
+
+	start  func(context.Context, *plugin.ClientConfig) (pluginProcess, error)
+	logger logging.Logger
+}
+
+// NewPlugin creates a client for a plugin driver
+func NewPlugin(config *PluginConfig) (*Plugin, error) {
+	if config == nil {
+		return nil, errors.New("PluginConfig cannot be nil - no meaningful default values")
+	}
+
+	if config.Path == "" {
+		return nil, errors.New("plugin path is required")
+	}
+
+	logger := logging.Global().Named(loggingSystemName).Named(pluginSubsystemName)
+	return &Plugin{
+		config: config,
+		stdout: io.Discard,
+		start: func(ctx context.Context, conf *plugin.ClientConfig) (pluginProcess, error) {
+			return plugin.Start(ctx, conf)
+		},
+		logger: logger,
+	}, nil
+}
+
+// SetEnv sets the environment of the plugin. A running plugin is restarted
+// with the new environment on next use.
+func (p *Plugin) SetEnv(env map[string]string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.env = env
+	p.kill()
+	return nil
+}
+
+// SetStdout sets the writer of the plan output of the plugin
+func (p *Plugin) SetStdout(w io.Writer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stdout = w
+}
+
+// Init launches the plugin
+func (p *Plugin) Init(ctx context.Context) error {
+	_, err := p.driver(ctx)
+	return err
+}
+
+// Apply applies the changes of the task with the currently rendered module
+// inputs
+func (p *Plugin) Apply(ctx context.Context) error {
+	d, err := p.driver(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := p.request()
+	if err != nil {
+		return err
+	}
+	return d.ApplyTask(ctx, req)
+}
+
+// Plan inspects the changes of the task. Returns whether there are changes.
+func (p *Plugin) Plan(ctx context.Context) (bool, error) {
+	plan, err := p.InspectPlan(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(plan.ResourceChanges) > 0, nil
+}
+
+// SavePlan inspects the changes of the task and saves the rendered module
+// inputs of the inspection to be applied by ApplyPlan
+func (p *Plugin) SavePlan(ctx context.Context) (*tfjson.Plan, error) {
+	req, plan, err := p.inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.savedRequest = req
+	p.mu.Unlock()
+	return plan, nil
+}
+
+// ApplyPlan applies the changes of the task with the module inputs saved by
+// SavePlan
+func (p *Plugin) ApplyPlan(ctx context.Context) error {
+	p.mu.Lock()
+	req := p.savedRequest
+	p.savedRequest = nil
+	p.mu.Unlock()
+
+	if req == nil {
+		return errors.New("no saved plan to apply")
+	}
+
+	d, err := p.driver(ctx)
+	if err != nil {
+		return err
+	}
+	return d.ApplyTask(ctx, req)
+}
+
+// InspectPlan inspects the changes of the task without saving them
+func (p *Plugin) InspectPlan(ctx context.Context) (*tfjson.Plan, error) {
+	_, plan, err := p.inspect(ctx)
+	return plan, err
+}
+
+// InspectDrift is not supported by plugins. The plugin protocol has no
+// refresh-only inspection to detect changes made outside of the plugin.
+func (p *Plugin) InspectDrift(context.Context) (*tfjson.Plan, error) {
+	return nil, fmt.Errorf("plugin %s does not support drift detection",
+		p.config.Path)
+}
+
+// Validate is a no-op since plugins validate the module inputs of a task
+// when they are inspected or applied
+func (p *Plugin) Validate(context.Context) error {
+	return nil
+}
+
+// Destroy notifies the running plugin that the task is destroyed and stops
+// the plugin. The plugin is not launched only to be notified, so the plugin
+// is not notified if it was never launched for the task or has exited.
+func (p *Plugin) Destroy(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process == nil {
+		return nil
+	}
+	defer p.kill()
+
+	if p.process.Exited() {
+		return nil
+	}
+	return p.process.Driver().DestroyTask(ctx,
+		&plugin.DestroyRequest{Task: p.config.Task})
+}
+
+// Close stops the plugin without notifying it, e.g. after inspecting a task
+// that is not run. The plugin is launched again on next use.
+func (p *Plugin) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.kill()
+}
+
+// GoString defines the printable version of this struct.
+func (p *Plugin) GoString() string {
+	if p == nil {
+		return "(*Plugin)(nil)"
+	}
+
+	return fmt.Sprintf("&Plugin{"+
+		"Path:%s, "+
+		"Args:%v, "+
+		"WorkingDir:%s, "+
+		"Task:%s"+
+		"}",
+		p.config.Path,
+		p.config.Args,
+		p.config.WorkingDir,
+		p.config.Task.Name,
+	)
+}
+
+// inspect calls the plugin to inspect the task with the currently rendered
+// module inputs and writes the plan output to stdout
+func (p *Plugin) inspect(ctx context.Context) (*plugin.TaskRequest, *tfjson.Plan, error) {
+	d, err := p.driver(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := p.request()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := d.InspectTask(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.mu.Lock()
+	fmt.Fprint(p.stdout, resp.Plan)
+	p.mu.Unlock()
+
+	plan, err := newPluginPlan(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return req, plan, nil
+}
+
+// driver returns the driver of the plugin, and launches the plugin if it is
+// not running
+func (p *Plugin) driver(ctx context.Context) (plugin.Driver, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process != nil && !p.process.Exited() {
+		return p.process.Driver(), nil
+	}
+	if p.process != nil {
+		p.logger.Warn("plugin exited unexpectedly, restarting plugin",
+			"path", p.config.Path)
+	}
+
+	process, err := p.start(ctx, &plugin.ClientConfig{
+		Path:         p.config.Path,
+		Args:         p.config.Args,
+		Env:          p.env,
+		StartTimeout: p.config.StartTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.process = process
+	return process.Driver(), nil
+}
+
+// kill stops the plugin if it is running. The caller must hold the lock.
+func (p *Plugin) kill() {
+	if p.process != nil {
+		p.process.Kill()
+		p.process = nil
+	}
+}
+
+// request creates the request to inspect or apply the task with the module
+// inputs, variables and providers rendered to the working directory
+func (p *Plugin) request() (*plugin.TaskRequest, error) {
+	req := &plugin.TaskRequest{Task: p.config.Task}
+
+	var err error
+	files := []struct {
+		filename string
+		dst      *map[string]interface{}
+	}{
+		{tftmpl.TFVarsFilename, &req.Inputs},
+		{tftmpl.VarsTFVarsFileName, &req.Variables},
+		{tftmpl.ProvidersTFVarsFilename, &req.Providers},
+	}
+	for _, f := range files {
+		*f.dst, err = loadTFVars(filepath.Join(p.config.WorkingDir, f.filename))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// loadTFVars loads the variables of a tfvars file as JSON values. Returns no
+// variables if the file does not exist.
+func loadTFVars(path string) (map[string]interface{}, error) {
+	vars := make(map[string]interface{})
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return vars, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values, err := tftmpl.LoadModuleVariables(path, f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load variables from %s: %s", path, err)
+	}
+
+	for name, val := range values {
+		b, err := ctyjson.SimpleJSONValue{Value: val}.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert variable %q: %s", name, err)
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+		vars[name] = v
+	}
+
+	return vars, nil
+}
+
+// newPluginPlan converts the changes inspected by a plugin to a plan
+func newPluginPlan(resp *plugin.InspectResponse) (*tfjson.Plan, error) {
+	plan := &tfjson.Plan{}
+	for _, rc := range resp.ResourceChanges {
+		var actions tfjson.Actions
+		switch rc.Action {
+		case plugin.ActionCreate:
+			actions = tfjson.Actions{tfjson.ActionCreate}
+		case plugin.ActionUpdate:
+			actions = tfjson.Actions{tfjson.ActionUpdate}
+		case plugin.ActionDelete:
+			actions = tfjson.Actions{tfjson.ActionDelete}
+		case plugin.ActionReplace:
+			actions = tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}
+		default:
+			return nil, fmt.Errorf("unsupported action %q of resource change %q "+
+				"from plugin", rc.Action, rc.Address)
+		}
+
+		plan.ResourceChanges = append(plan.ResourceChanges, &tfjson.ResourceChange{
+			Address: rc.Address,
+			Change:  &tfjson.Change{Actions: actions},
+		})
+	}
+	return plan, nil
+}
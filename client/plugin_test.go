@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/plugin"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
+	"github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePluginDriver records the requests of the plugin client
+type fakePluginDriver struct {
+	inspected []*plugin.TaskRequest
+	applied   []*plugin.TaskRequest
+	destroyed []*plugin.DestroyRequest
+
+	resp     *plugin.InspectResponse
+	applyErr error
+}
+
+func (d *fakePluginDriver) InspectTask(_ context.Context, req *plugin.TaskRequest) (*plugin.InspectResponse, error) {
+	d.inspected = append(d.inspected, req)
+	return d.resp, nil
+}
+
+func (d *fakePluginDriver) ApplyTask(_ context.Context, req *plugin.TaskRequest) error {
+	d.applied = append(d.applied, req)
+	return d.applyErr
+}
+
+func (d *fakePluginDriver) DestroyTask(_ context.Context, req *plugin.DestroyRequest) error {
+	d.destroyed = append(d.destroyed, req)
+	return nil
+}
+
+// fakePluginProcess is a launched plugin that serves the fake driver
+type fakePluginProcess struct {
+	driver *fakePluginDriver
+	exited bool
+	killed bool
+}
+
+func (p *fakePluginProcess) Driver() plugin.Driver { return p.driver }
+func (p *fakePluginProcess) Exited() bool          { return p.exited || p.killed }
+func (p *fakePluginProcess) Kill()                 { p.killed = true }
+
+// newTestPlugin returns a plugin client for a working directory with rendered
+// module inputs, and the launched plugin processes of the client
+func newTestPlugin(t *testing.T, d *fakePluginDriver) (*Plugin, *[]*fakePluginProcess) {
+	wd := t.TempDir()
+	files := map[string]string{
+		tftmpl.TFVarsFilename: `services = {
+  "api.node.dc1" = {
+    id   = "api"
+    port = 8080
+    tags = ["v1"]
+  }
+}`,
+		tftmpl.VarsTFVarsFileName:      `count = 2`,
+		tftmpl.ProvidersTFVarsFilename: `local = { alias = "one" }`,
+	}
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(wd, name), []byte(content), 0640)
+		require.NoError(t, err)
+	}
+
+	p, err := NewPlugin(&PluginConfig{
+		Path:       "/opt/cts/plugin",
+		WorkingDir: wd,
+		Task:       plugin.Task{Name: "task", Module: "module"},
+	})
+	require.NoError(t, err)
+
+	var processes []*fakePluginProcess
+	p.start = func(_ context.Context, conf *plugin.ClientConfig) (pluginProcess, error) {
+		assert.Equal(t, "/opt/cts/plugin", conf.Path)
+		process := &fakePluginProcess{driver: d}
+		processes = append(processes, process)
+		return process, nil
+	}
+	return p, &processes
+}
+
+func TestNewPlugin(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPlugin(nil)
+	assert.Error(t, err)
+
+	_, err = NewPlugin(&PluginConfig{})
+	assert.Error(t, err)
+
+	p, err := NewPlugin(&PluginConfig{Path: "/opt/cts/plugin"})
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+}
+
+func TestPlugin_InspectPlan(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{
+		resp: &plugin.InspectResponse{
+			Plan: "plan output",
+			ResourceChanges: []plugin.ResourceChange{
+				{Address: "a", Action: plugin.ActionCreate},
+				{Address: "b", Action: plugin.ActionReplace},
+			},
+		},
+	}
+	p, processes := newTestPlugin(t, d)
+
+	var buf bytes.Buffer
+	p.SetStdout(&buf)
+
+	plan, err := p.InspectPlan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "plan output", buf.String())
+	require.Len(t, plan.ResourceChanges, 2)
+	assert.True(t, plan.ResourceChanges[0].Change.Actions.Create())
+	assert.True(t, plan.ResourceChanges[1].Change.Actions.Replace())
+
+	require.Len(t, d.inspected, 1)
+	assert.Equal(t, &plugin.TaskRequest{
+		Task: plugin.Task{Name: "task", Module: "module"},
+		Inputs: map[string]interface{}{
+			"services": map[string]interface{}{
+				"api.node.dc1": map[string]interface{}{
+					"id":   "api",
+					"port": float64(8080),
+					"tags": []interface{}{"v1"},
+				},
+			},
+		},
+		Variables: map[string]interface{}{"count": float64(2)},
+		Providers: map[string]interface{}{
+			"local": map[string]interface{}{"alias": "one"},
+		},
+	}, d.inspected[0])
+
+	// The plugin is launched once
+	changes, err := p.Plan(context.Background())
+	require.NoError(t, err)
+	assert.True(t, changes)
+	assert.Len(t, *processes, 1)
+}
+
+func TestPlugin_InspectPlan_UnsupportedAction(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{
+		resp: &plugin.InspectResponse{
+			ResourceChanges: []plugin.ResourceChange{
+				{Address: "a", Action: "move"},
+			},
+		},
+	}
+	p, _ := newTestPlugin(t, d)
+
+	_, err := p.InspectPlan(context.Background())
+	assert.Error(t, err)
+}
+
+func TestPlugin_InspectDrift(t *testing.T) {
+	t.Parallel()
+
+	p, processes := newTestPlugin(t, &fakePluginDriver{})
+
+	_, err := p.InspectDrift(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, *processes, "plugin is not launched")
+}
+
+func TestPlugin_SavePlan_ApplyPlan(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{resp: &plugin.InspectResponse{}}
+	p, _ := newTestPlugin(t, d)
+	ctx := context.Background()
+
+	err := p.ApplyPlan(ctx)
+	assert.Error(t, err, "no saved plan")
+
+	plan, err := p.SavePlan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &tfjson.Plan{}, plan)
+
+	// The inputs change after the plan is saved
+	err = os.WriteFile(filepath.Join(p.config.WorkingDir, tftmpl.TFVarsFilename),
+		[]byte(`services = {}`), 0640)
+	require.NoError(t, err)
+
+	require.NoError(t, p.ApplyPlan(ctx))
+	require.Len(t, d.applied, 1)
+	assert.Equal(t, d.inspected[0], d.applied[0], "saved inputs are applied")
+
+	err = p.ApplyPlan(ctx)
+	assert.Error(t, err, "saved plan is only applied once")
+}
+
+func TestPlugin_Apply(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{applyErr: errors.New("apply error")}
+	p, _ := newTestPlugin(t, d)
+
+	err := p.Apply(context.Background())
+	assert.Error(t, err)
+	require.Len(t, d.applied, 1)
+	assert.Equal(t, "task", d.applied[0].Task.Name)
+}
+
+func TestPlugin_Restart(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{resp: &plugin.InspectResponse{}}
+	p, processes := newTestPlugin(t, d)
+	ctx := context.Background()
+
+	require.NoError(t, p.Init(ctx))
+	require.Len(t, *processes, 1)
+
+	// An exited plugin is restarted
+	(*processes)[0].exited = true
+	_, err := p.InspectPlan(ctx)
+	require.NoError(t, err)
+	require.Len(t, *processes, 2)
+
+	// A plugin is restarted with a new environment
+	require.NoError(t, p.SetEnv(map[string]string{"KEY": "value"}))
+	assert.True(t, (*processes)[1].killed)
+	require.NoError(t, p.Init(ctx))
+	assert.Len(t, *processes, 3)
+}
+
+func TestPlugin_Destroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("running", func(t *testing.T) {
+		d := &fakePluginDriver{resp: &plugin.InspectResponse{}}
+		p, processes := newTestPlugin(t, d)
+
+		require.NoError(t, p.Init(context.Background()))
+		require.NoError(t, p.Destroy(context.Background()))
+		assert.Equal(t, []*plugin.DestroyRequest{
+			{Task: plugin.Task{Name: "task", Module: "module"}},
+		}, d.destroyed)
+		require.Len(t, *processes, 1)
+		assert.True(t, (*processes)[0].killed)
+	})
+
+	t.Run("never launched", func(t *testing.T) {
+		d := &fakePluginDriver{}
+		p, processes := newTestPlugin(t, d)
+
+		require.NoError(t, p.Destroy(context.Background()))
+		assert.Empty(t, d.destroyed)
+		assert.Empty(t, *processes)
+	})
+
+	t.Run("exited", func(t *testing.T) {
+		d := &fakePluginDriver{}
+		p, processes := newTestPlugin(t, d)
+
+		require.NoError(t, p.Init(context.Background()))
+		(*processes)[0].exited = true
+		require.NoError(t, p.Destroy(context.Background()))
+		assert.Empty(t, d.destroyed)
+		assert.Len(t, *processes, 1)
+	})
+}
+
+func TestPlugin_Close(t *testing.T) {
+	t.Parallel()
+
+	d := &fakePluginDriver{resp: &plugin.InspectResponse{}}
+	p, processes := newTestPlugin(t, d)
+	ctx := context.Background()
+
+	// Closing a plugin that is not running is a no-op
+	p.Close()
+	assert.Empty(t, *processes)
+
+	_, err := p.InspectPlan(ctx)
+	require.NoError(t, err)
+	p.Close()
+	require.Len(t, *processes, 1)
+	assert.True(t, (*processes)[0].killed)
+	assert.Empty(t, d.destroyed)
+
+	// The plugin is launched again on next use
+	require.NoError(t, p.Init(ctx))
+	assert.Len(t, *processes, 2)
+}
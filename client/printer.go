@@ -10,6 +10,7 @@ import (
 	"io"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/terraform-json"
 )
 
 var _ Client = (*Printer)(nil)
@@ -85,6 +86,30 @@ func (p *Printer) Plan(context.Context) (bool, error) {
 	return true, nil
 }
 
+// SavePlan logs out 'save plan'
+func (p *Printer) SavePlan(context.Context) (*tfjson.Plan, error) {
+	p.logger.Info("saving plan for workspace")
+	return &tfjson.Plan{}, nil
+}
+
+// ApplyPlan logs out 'apply plan'
+func (p *Printer) ApplyPlan(context.Context) error {
+	p.logger.Info("applying saved plan for workspace")
+	return nil
+}
+
+// InspectPlan logs out 'inspect plan'
+func (p *Printer) InspectPlan(context.Context) (*tfjson.Plan, error) {
+	p.logger.Info("inspecting plan for workspace")
+	return &tfjson.Plan{}, nil
+}
+
+// InspectDrift logs out 'inspect drift'
+func (p *Printer) InspectDrift(context.Context) (*tfjson.Plan, error) {
+	p.logger.Info("inspecting drift for workspace")
+	return &tfjson.Plan{}, nil
+}
+
 // Validate logs out 'validate'
 func (p *Printer) Validate(context.Context) error {
 	p.logger.Info("validating workspace")
@@ -9,12 +9,16 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/hashicorp/terraform-json"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -22,10 +26,24 @@ var (
 
 	wsFailedToSelectRegexp = regexp.MustCompile(`Failed to select workspace`)
 	wsDoesNotExistRegexp   = regexp.MustCompile(`workspace ".*" does not exist`)
+
+	// The messages of the Terraform CLI that terraform-exec parsed into typed
+	// workspace errors before v0.18
+	wsNotExistRegexp      = regexp.MustCompile(`Workspace "(.+)" doesn't exist.`)
+	wsAlreadyExistsRegexp = regexp.MustCompile(`Workspace "(.+)" already exists`)
 )
 
 const (
 	tcliSubsystemName = "terraformcli"
+
+	// planFilename is the name of the file that a plan is saved to within the
+	// working directory before it is applied
+	planFilename = "cts.tfplan"
+
+	// inspectPlanFilename is the name of the file that a plan is temporarily
+	// written to within the working directory when it is only inspected. It
+	// is separate from planFilename to not replace a plan saved to be applied.
+	inspectPlanFilename = "cts-inspect.tfplan"
 )
 
 // TerraformCLI is the client that wraps around terraform-exec
@@ -45,6 +63,10 @@ type TerraformCLIConfig struct {
 	ExecPath   string
 	WorkingDir string
 	Workspace  string
+
+	// Binary is the name of the CLI binary within ExecPath, e.g. "tofu" for
+	// OpenTofu. Defaults to "terraform".
+	Binary string
 }
 
 // NewTerraformCLI creates a terraform-exec client and configures and
@@ -54,7 +76,12 @@ func NewTerraformCLI(config *TerraformCLIConfig) (*TerraformCLI, error) {
 		return nil, errors.New("TerraformCLIConfig cannot be nil - no meaningful default values")
 	}
 
-	tfPath := filepath.Join(config.ExecPath, "terraform")
+	binary := config.Binary
+	if binary == "" {
+		binary = "terraform"
+	}
+
+	tfPath := filepath.Join(config.ExecPath, binary)
 	tf, err := tfexec.NewTerraform(config.WorkingDir, tfPath)
 	if err != nil {
 		return nil, err
@@ -111,7 +138,10 @@ func (t *TerraformCLI) SetStdout(w io.Writer) {
 
 // Init initializes by executing the cli command `terraform init` and
 // `terraform workspace new <name>`
-func (t *TerraformCLI) Init(ctx context.Context) error {
+func (t *TerraformCLI) Init(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.init")
+	defer func() { tracing.End(span, err) }()
+
 	var wsCreated bool
 
 	// This is special handling for when the workspace has been detected in
@@ -120,10 +150,10 @@ func (t *TerraformCLI) Init(ctx context.Context) error {
 	// https://github.com/hashicorp/terraform/issues/21393
 TF_INIT_AGAIN:
 	if err := t.tf.Init(ctx); err != nil {
-		var wsErr *tfexec.ErrNoWorkspace
+		var wsErr *noWorkspaceError
 		matchedFailedToSelect := wsFailedToSelectRegexp.MatchString(err.Error())
 		matchedDoesNotExist := wsDoesNotExistRegexp.MatchString(err.Error())
-		if matchedFailedToSelect || matchedDoesNotExist || errors.As(err, &wsErr) {
+		if matchedFailedToSelect || matchedDoesNotExist || errors.As(workspaceError(err), &wsErr) {
 			t.logger.Info("workspace was detected without state, " +
 				"creating new workspace and attempting Terraform init again")
 			if err := t.tf.WorkspaceNew(ctx, t.workspace); err != nil {
@@ -142,8 +172,8 @@ TF_INIT_AGAIN:
 	if !wsCreated {
 		err := t.tf.WorkspaceNew(ctx, t.workspace)
 		if err != nil {
-			var wsErr *tfexec.ErrWorkspaceExists
-			if !errors.As(err, &wsErr) {
+			var wsErr *workspaceExistsError
+			if !errors.As(workspaceError(err), &wsErr) {
 				logws.Error("unable to create workspace", "error", err)
 				return err
 			}
@@ -162,15 +192,99 @@ TF_INIT_AGAIN:
 }
 
 // Apply executes the cli command `terraform apply` for a given workspace
-func (t *TerraformCLI) Apply(ctx context.Context) error {
+func (t *TerraformCLI) Apply(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.apply")
+	defer func() { tracing.End(span, err) }()
+
 	return t.tf.Apply(ctx)
 }
 
 // Plan executes the cli command `terraform plan` for a given workspace
-func (t *TerraformCLI) Plan(ctx context.Context) (bool, error) {
+func (t *TerraformCLI) Plan(ctx context.Context) (_ bool, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
 	return t.tf.Plan(ctx)
 }
 
+// SavePlan executes the cli commands `terraform plan -out` to save a plan for
+// a given workspace and `terraform show -json` to return the saved plan
+func (t *TerraformCLI) SavePlan(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	planPath := t.planPath()
+	if _, err := t.tf.Plan(ctx, tfexec.Out(planPath)); err != nil {
+		return nil, err
+	}
+	return t.tf.ShowPlanFile(ctx, planPath)
+}
+
+// ApplyPlan executes the cli command `terraform apply` with the plan saved by
+// SavePlan. The saved plan is removed once applied since it may contain
+// sensitive values.
+func (t *TerraformCLI) ApplyPlan(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.apply")
+	defer func() { tracing.End(span, err) }()
+
+	planPath := t.planPath()
+	defer func() {
+		if err := os.Remove(planPath); err != nil && !os.IsNotExist(err) {
+			t.logger.Warn("unable to remove saved plan", "path", planPath,
+				"error", err)
+		}
+	}()
+	return t.tf.Apply(ctx, tfexec.DirOrPlan(planPath))
+}
+
+// InspectPlan executes the cli commands `terraform plan -out` and `terraform
+// show` to generate a plan for the workspace without saving it to be applied
+func (t *TerraformCLI) InspectPlan(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	return t.inspectPlan(ctx)
+}
+
+// InspectDrift executes the cli commands `terraform plan -refresh-only -out`
+// and `terraform show` to generate a plan of the changes made to the resources
+// of the workspace outside of Terraform. The drifted resources are the
+// plan's ResourceDrift.
+func (t *TerraformCLI) InspectDrift(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	return t.inspectPlan(ctx, tfexec.RefreshOnly(true))
+}
+
+// inspectPlan generates a plan with the options to a temporary file and shows
+// the plan. The temporary file is removed once the plan is shown.
+func (t *TerraformCLI) inspectPlan(ctx context.Context, opts ...tfexec.PlanOption) (*tfjson.Plan, error) {
+	planPath := filepath.Join(t.workingDir, inspectPlanFilename)
+	defer func() {
+		if err := os.Remove(planPath); err != nil && !os.IsNotExist(err) {
+			t.logger.Warn("unable to remove inspected plan", "path", planPath,
+				"error", err)
+		}
+	}()
+	opts = append(opts, tfexec.Out(planPath))
+	if _, err := t.tf.Plan(ctx, opts...); err != nil {
+		return nil, err
+	}
+	return t.tf.ShowPlanFile(ctx, planPath)
+}
+
+// startSpan starts a span of a Terraform command for the workspace, which is
+// named after the task
+func (t *TerraformCLI) startSpan(ctx context.Context, spanName string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, spanName, tracing.TaskName(t.workspace))
+}
+
+// planPath returns the path of the plan saved by SavePlan
+func (t *TerraformCLI) planPath() string {
+	return filepath.Join(t.workingDir, planFilename)
+}
+
 // Validate verifies the generated configuration files
 func (t *TerraformCLI) Validate(ctx context.Context) error {
 	output, err := t.tf.Validate(ctx)
@@ -244,3 +358,52 @@ func (t *TerraformCLI) GoString() string {
 		t.workspace,
 	)
 }
+
+// noWorkspaceError is returned when a Terraform workspace does not exist
+type noWorkspaceError struct {
+	name string
+	err  error
+}
+
+func (e *noWorkspaceError) Error() string {
+	return fmt.Sprintf("workspace %q does not exist", e.name)
+}
+
+func (e *noWorkspaceError) Unwrap() error {
+	return e.err
+}
+
+// workspaceExistsError is returned when creating a Terraform workspace that
+// already exists
+type workspaceExistsError struct {
+	name string
+	err  error
+}
+
+func (e *workspaceExistsError) Error() string {
+	return fmt.Sprintf("workspace %q already exists", e.name)
+}
+
+func (e *workspaceExistsError) Unwrap() error {
+	return e.err
+}
+
+// workspaceError converts an error of a Terraform command to a typed
+// workspace error. terraform-exec removed its typed workspace errors in
+// v0.18, so the workspace is parsed from the output of the Terraform CLI as
+// terraform-exec did. Returns the error unchanged if it is not a workspace
+// error.
+func workspaceError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	if m := wsNotExistRegexp.FindStringSubmatch(msg); len(m) == 2 {
+		return &noWorkspaceError{name: m[1], err: err}
+	}
+	if m := wsAlreadyExistsRegexp.FindStringSubmatch(msg); len(m) == 2 {
+		return &workspaceExistsError{name: m[1], err: err}
+	}
+	return err
+}
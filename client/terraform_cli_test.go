@@ -7,12 +7,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
 	"github.com/hashicorp/terraform-exec/tfexec"
-	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -57,11 +59,13 @@ func TestNewTerraformCLI(t *testing.T) {
 		name        string
 		expectError bool
 		config      *TerraformCLIConfig
+		execPath    string
 	}{
 		{
 			"error nil config",
 			true,
 			nil,
+			"",
 		},
 		{
 			"terraform-exec error: no working dir",
@@ -71,6 +75,7 @@ func TestNewTerraformCLI(t *testing.T) {
 				WorkingDir: "",
 				Workspace:  "default",
 			},
+			"",
 		},
 		{
 			"happy path",
@@ -80,6 +85,18 @@ func TestNewTerraformCLI(t *testing.T) {
 				WorkingDir: "./",
 				Workspace:  "my-workspace",
 			},
+			"path/to/tf/terraform",
+		},
+		{
+			"opentofu binary",
+			false,
+			&TerraformCLIConfig{
+				ExecPath:   "path/to/tf",
+				WorkingDir: "./",
+				Workspace:  "my-workspace",
+				Binary:     "tofu",
+			},
+			"path/to/tf/tofu",
 		},
 	}
 
@@ -94,6 +111,7 @@ func TestNewTerraformCLI(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.NotNil(t, actual)
+			assert.Equal(t, tc.execPath, actual.execPath)
 		})
 	}
 }
@@ -134,7 +152,10 @@ func TestTerraformCLIInit(t *testing.T) {
 			false,
 			&TerraformCLIConfig{},
 			nil,
-			&tfexec.ErrWorkspaceExists{Name: "workspace-name"},
+			errors.New(`exit status 1
+
+Workspace "workspace-name" already exists
+`),
 		},
 	}
 
@@ -189,6 +210,15 @@ Error: Failed to select workspace: input not a valid number`),
 Error: Currently selected workspace "some-task" does not exist
 
 
+`),
+		},
+		{
+			"workspace doesn't exist",
+			errors.New(`exit status 1
+
+Workspace "some-task" doesn't exist.
+
+You can create this workspace with the "new" subcommand.
 `),
 		},
 	}
@@ -216,6 +246,55 @@ Error: Currently selected workspace "some-task" does not exist
 	}
 }
 
+func TestWorkspaceError(t *testing.T) {
+	t.Parallel()
+
+	// The messages of the oldest and newest supported versions of Terraform
+	noWorkspaceMessages := map[string]string{
+		"0.13": `exit status 1
+
+Workspace "task" doesn't exist.
+
+You can create this workspace with the "new" subcommand.
+`,
+		"1.14": `exit status 1
+
+Workspace "task" doesn't exist.
+
+You can create this workspace with the "new" subcommand 
+or include the "-or-create" flag with the "select" subcommand.
+`,
+	}
+	for version, msg := range noWorkspaceMessages {
+		t.Run("no workspace "+version, func(t *testing.T) {
+			cause := errors.New(msg)
+			err := workspaceError(cause)
+			var wsErr *noWorkspaceError
+			require.True(t, errors.As(err, &wsErr))
+			assert.Equal(t, "task", wsErr.name)
+			assert.ErrorIs(t, err, cause)
+		})
+	}
+
+	t.Run("workspace exists", func(t *testing.T) {
+		cause := errors.New(`exit status 1
+
+Workspace "task" already exists
+`)
+		err := workspaceError(cause)
+		var wsErr *workspaceExistsError
+		require.True(t, errors.As(err, &wsErr))
+		assert.Equal(t, "task", wsErr.name)
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		cause := errors.New("exit status 1")
+		assert.Equal(t, cause, workspaceError(cause))
+		assert.NoError(t, workspaceError(nil))
+	})
+}
+
 func TestTerraformCLIApply(t *testing.T) {
 	t.Parallel()
 
@@ -278,6 +357,159 @@ func TestTerraformCLIPlan(t *testing.T) {
 	}
 }
 
+func TestTerraformCLISavePlan(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		planErr     error
+		showErr     error
+		expectError bool
+	}{
+		{
+			"happy path",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"plan error",
+			errors.New("plan error"),
+			nil,
+			true,
+		},
+		{
+			"show error",
+			nil,
+			errors.New("show error"),
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := &tfjson.Plan{FormatVersion: "1.0"}
+			planPath := filepath.Join("test/working/dir", planFilename)
+
+			m := new(mocks.TerraformExec)
+			m.On("Plan", mock.Anything, tfexec.Out(planPath)).
+				Return(true, tc.planErr).Once()
+			m.On("ShowPlanFile", mock.Anything, planPath).
+				Return(plan, tc.showErr).Once()
+
+			client := NewTestTerraformCLI(nil, m)
+			actual, err := client.SavePlan(context.Background())
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, plan, actual)
+		})
+	}
+}
+
+func TestTerraformCLIApplyPlan(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	planPath := filepath.Join(dir, planFilename)
+	require.NoError(t, os.WriteFile(planPath, []byte("plan"), 0600))
+
+	m := new(mocks.TerraformExec)
+	m.On("Apply", mock.Anything, tfexec.DirOrPlan(planPath)).Return(nil).Once()
+
+	client := NewTestTerraformCLI(&TerraformCLIConfig{WorkingDir: dir}, m)
+	assert.NoError(t, client.ApplyPlan(context.Background()))
+	m.AssertExpectations(t)
+
+	// saved plan is removed once applied
+	_, err := os.Stat(planPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTerraformCLIInspectPlan(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		planErr     error
+		expectError bool
+	}{
+		{
+			"happy path",
+			nil,
+			false,
+		},
+		{
+			"plan error",
+			errors.New("plan error"),
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			plan := &tfjson.Plan{FormatVersion: "1.0"}
+			planPath := filepath.Join(dir, inspectPlanFilename)
+
+			// a plan saved to be applied is not replaced by the inspection
+			savedPath := filepath.Join(dir, planFilename)
+			require.NoError(t, os.WriteFile(savedPath, []byte("plan"), 0600))
+
+			m := new(mocks.TerraformExec)
+			m.On("Plan", mock.Anything, tfexec.Out(planPath)).
+				Run(func(mock.Arguments) {
+					os.WriteFile(planPath, []byte("inspect"), 0600)
+				}).Return(true, tc.planErr).Once()
+			m.On("ShowPlanFile", mock.Anything, planPath).
+				Return(plan, nil).Once()
+
+			client := NewTestTerraformCLI(&TerraformCLIConfig{WorkingDir: dir}, m)
+			actual, err := client.InspectPlan(context.Background())
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, plan, actual)
+			}
+
+			// inspected plan is removed
+			_, err = os.Stat(planPath)
+			assert.True(t, os.IsNotExist(err))
+			_, err = os.Stat(savedPath)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTerraformCLIInspectDrift(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	plan := &tfjson.Plan{FormatVersion: "1.0"}
+	planPath := filepath.Join(dir, inspectPlanFilename)
+
+	m := new(mocks.TerraformExec)
+	m.On("Plan", mock.Anything, tfexec.RefreshOnly(true), tfexec.Out(planPath)).
+		Run(func(mock.Arguments) {
+			os.WriteFile(planPath, []byte("inspect"), 0600)
+		}).Return(false, nil).Once()
+	m.On("ShowPlanFile", mock.Anything, planPath).Return(plan, nil).Once()
+
+	client := NewTestTerraformCLI(&TerraformCLIConfig{WorkingDir: dir}, m)
+	actual, err := client.InspectDrift(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, plan, actual)
+	m.AssertExpectations(t)
+
+	// inspected plan is removed
+	_, err = os.Stat(planPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestTerraformCLIValidate(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,647 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
+	"github.com/hashicorp/terraform-json"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ Client = (*TerraformCloud)(nil)
+
+const (
+	tfcSubsystemName = "terraformcloud"
+
+	// defaultTFCPollInterval is the interval between reads of the status of
+	// configuration uploads and runs
+	defaultTFCPollInterval = 5 * time.Second
+
+	// defaultTFCExecutionMode is the execution mode of workspaces when the
+	// task does not configure one
+	defaultTFCExecutionMode = "remote"
+
+	// tfcRunMessage is the message of runs triggered by CTS
+	tfcRunMessage = "Triggered by Consul-Terraform-Sync"
+)
+
+// TerraformCloud is the client that executes Terraform remotely as runs of a
+// Terraform Cloud workspace through the Terraform Cloud API. The root module
+// in the working directory is uploaded as a new configuration version of the
+// workspace for each run.
+type TerraformCloud struct {
+	mu sync.Mutex
+
+	api          *tfcAPI
+	organization string
+	workspace    string
+	workingDir   string
+	tags         []string
+
+	executionMode    string
+	agentPoolID      string
+	agentPoolName    string
+	terraformVersion string
+
+	workspaceID string
+	env         map[string]string
+	stdout      io.Writer
+
+	// savedRun is the run waiting for confirmation that was planned by
+	// SavePlan to be applied by ApplyPlan
+	savedRun *tfcRun
+
+	pollInterval time.Duration
+	logger       logging.Logger
+}
+
+// TerraformCloudConfig configures the Terraform Cloud client
+type TerraformCloudConfig struct {
+	// Address is the address of the Terraform Cloud instance. The https
+	// scheme is used if the address does not include a scheme.
+	Address      string
+	Organization string
+	Token        string
+	Workspace    string
+	WorkingDir   string
+	Tags         []string
+
+	// Workspace attributes specific to the task
+	ExecutionMode    string
+	AgentPoolID      string
+	AgentPoolName    string
+	TerraformVersion string
+}
+
+// NewTerraformCloud creates and configures a new Terraform Cloud client
+func NewTerraformCloud(config *TerraformCloudConfig) (*TerraformCloud, error) {
+	if config == nil {
+		return nil, errors.New("TerraformCloudConfig cannot be nil - no meaningful default values")
+	}
+
+	if config.Organization == "" || config.Token == "" || config.Workspace == "" {
+		return nil, errors.New("organization, token, and workspace are required " +
+			"for the Terraform Cloud client")
+	}
+
+	address := strings.TrimSuffix(config.Address, "/")
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+
+	executionMode := config.ExecutionMode
+	if executionMode == "" {
+		executionMode = defaultTFCExecutionMode
+	}
+
+	logger := logging.Global().Named(loggingSystemName).Named(tfcSubsystemName)
+	client := &TerraformCloud{
+		api: &tfcAPI{
+			address: address,
+			token:   config.Token,
+			client:  &http.Client{Timeout: tfcRequestTimeout},
+		},
+		organization:     config.Organization,
+		workspace:        config.Workspace,
+		workingDir:       config.WorkingDir,
+		tags:             config.Tags,
+		executionMode:    executionMode,
+		agentPoolID:      config.AgentPoolID,
+		agentPoolName:    config.AgentPoolName,
+		terraformVersion: config.TerraformVersion,
+		stdout:           io.Discard,
+		pollInterval:     defaultTFCPollInterval,
+		logger:           logger.With("workspace", config.Workspace),
+	}
+	logger.Trace("created Terraform Cloud client", "client", client.GoString())
+
+	return client, nil
+}
+
+// SetEnv sets the environment for the runs of the workspace. The environment
+// is stored as sensitive environment variables of the workspace when the
+// client is initialized.
+func (t *TerraformCloud) SetEnv(env map[string]string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.env = make(map[string]string, len(env))
+	for k, v := range env {
+		t.env[k] = v
+	}
+	return nil
+}
+
+// SetStdout sets the writer that the progress of runs is written to
+func (t *TerraformCloud) SetStdout(w io.Writer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stdout = w
+}
+
+// Init creates the workspace if it does not exist, or updates the workspace
+// attributes configured for the task otherwise, and sets the environment
+// variables of the workspace
+func (t *TerraformCloud) Init(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.init")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	attrs := tfcWorkspaceAttributes{
+		Name:             t.workspace,
+		ExecutionMode:    t.executionMode,
+		AgentPoolID:      t.agentPoolID,
+		TerraformVersion: t.terraformVersion,
+		AutoApply:        new(bool),
+	}
+	if attrs.AgentPoolID == "" && t.agentPoolName != "" {
+		attrs.AgentPoolID, err = t.api.readAgentPoolID(ctx, t.organization,
+			t.agentPoolName)
+		if err != nil {
+			return err
+		}
+	}
+
+	id, err := t.api.readWorkspace(ctx, t.organization, t.workspace)
+	switch {
+	case isNotFound(err):
+		attrs.TagNames = t.tags
+		id, err = t.api.createWorkspace(ctx, t.organization, attrs)
+		if err != nil {
+			t.logger.Error("unable to create workspace", "error", err)
+			return err
+		}
+		t.logger.Info("created workspace", "workspace_id", id)
+
+	case err != nil:
+		t.logger.Error("unable to read workspace", "error", err)
+		return err
+
+	default:
+		if err = t.api.updateWorkspace(ctx, id, attrs); err != nil {
+			t.logger.Error("unable to update workspace", "workspace_id", id,
+				"error", err)
+			return err
+		}
+		if len(t.tags) > 0 {
+			if err = t.api.addWorkspaceTags(ctx, id, t.tags); err != nil {
+				return err
+			}
+		}
+		t.logger.Debug("updated workspace", "workspace_id", id)
+	}
+	t.workspaceID = id
+
+	return t.setVariables(ctx)
+}
+
+// setVariables creates or updates the environment variables of the workspace
+// for the environment of the client. Other variables of the workspace are
+// left as is.
+func (t *TerraformCloud) setVariables(ctx context.Context) error {
+	if len(t.env) == 0 {
+		return nil
+	}
+
+	vars, err := t.api.listVariables(ctx, t.workspaceID)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]string)
+	for _, v := range vars {
+		if v.Category == "env" {
+			existing[v.Key] = v.ID
+		}
+	}
+
+	keys := make([]string, 0, len(t.env))
+	for k := range t.env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		attrs := tfcVariableAttributes{
+			Key:       k,
+			Value:     t.env[k],
+			Category:  "env",
+			Sensitive: true,
+		}
+		if id, ok := existing[k]; ok {
+			err = t.api.updateVariable(ctx, t.workspaceID, id, attrs)
+		} else {
+			err = t.api.createVariable(ctx, t.workspaceID, attrs)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to set environment variable %s of "+
+				"workspace %s: %s", k, t.workspace, err)
+		}
+	}
+
+	return nil
+}
+
+// Apply uploads the configuration and triggers a run of the workspace that
+// is applied automatically
+func (t *TerraformCloud) Apply(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.apply")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.discardSavedRun(ctx)
+
+	autoApply := true
+	run, err := t.run(ctx, false, tfcRunAttributes{AutoApply: &autoApply})
+	if err != nil {
+		return err
+	}
+
+	_, err = t.waitForRun(ctx, run.ID, func(r *tfcRun) bool {
+		return r.Status == tfcRunApplied ||
+			r.Status == tfcRunPlannedAndFinished
+	})
+	return err
+}
+
+// Plan uploads the configuration and triggers a speculative plan of the
+// workspace. Returns whether the plan has changes.
+func (t *TerraformCloud) Plan(ctx context.Context) (_ bool, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	run, err := t.speculativePlan(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	return run.HasChanges, nil
+}
+
+// SavePlan uploads the configuration and triggers a run of the workspace
+// that waits for confirmation once planned. The run is applied by ApplyPlan.
+// A previously saved run that was not applied is discarded.
+func (t *TerraformCloud) SavePlan(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.discardSavedRun(ctx)
+
+	autoApply := false
+	run, err := t.run(ctx, false, tfcRunAttributes{AutoApply: &autoApply})
+	if err != nil {
+		return nil, err
+	}
+
+	run, err = t.waitForRun(ctx, run.ID, func(r *tfcRun) bool {
+		return r.Status == tfcRunPlannedAndFinished || r.Actions.IsConfirmable
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.savedRun = run
+
+	return t.readPlan(ctx, run.ID)
+}
+
+// ApplyPlan confirms the run saved by SavePlan to be applied
+func (t *TerraformCloud) ApplyPlan(ctx context.Context) (err error) {
+	ctx, span := t.startSpan(ctx, "terraform.apply")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	run := t.savedRun
+	if run == nil {
+		return fmt.Errorf("no saved plan to apply for workspace %s", t.workspace)
+	}
+	t.savedRun = nil
+
+	if run.Status == tfcRunPlannedAndFinished {
+		// the plan has no changes to apply
+		return nil
+	}
+
+	if err = t.api.applyRun(ctx, run.ID); err != nil {
+		return err
+	}
+	_, err = t.waitForRun(ctx, run.ID, func(r *tfcRun) bool {
+		return r.Status == tfcRunApplied
+	})
+	return err
+}
+
+// InspectPlan uploads the configuration and triggers a speculative plan of
+// the workspace. Returns the plan of the run.
+func (t *TerraformCloud) InspectPlan(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	run, err := t.speculativePlan(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return t.readPlan(ctx, run.ID)
+}
+
+// InspectDrift uploads the configuration and triggers a speculative
+// refresh-only plan of the workspace. Returns the plan of the run, of which
+// the drifted resources are the plan's ResourceDrift.
+func (t *TerraformCloud) InspectDrift(ctx context.Context) (_ *tfjson.Plan, err error) {
+	ctx, span := t.startSpan(ctx, "terraform.plan")
+	defer func() { tracing.End(span, err) }()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	run, err := t.speculativePlan(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return t.readPlan(ctx, run.ID)
+}
+
+// Validate is a no-op. The configuration is validated by Terraform Cloud as
+// part of each run.
+func (t *TerraformCloud) Validate(_ context.Context) error {
+	t.logger.Trace("configuration is validated by Terraform Cloud runs, skipping")
+	return nil
+}
+
+// speculativePlan triggers a plan-only run that cannot be applied and waits
+// for the plan to finish. A refresh-only run only plans the changes made to
+// the resources outside of Terraform.
+func (t *TerraformCloud) speculativePlan(ctx context.Context, refreshOnly bool) (*tfcRun, error) {
+	run, err := t.run(ctx, true, tfcRunAttributes{
+		PlanOnly:    true,
+		RefreshOnly: refreshOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.waitForRun(ctx, run.ID, func(r *tfcRun) bool {
+		return r.Status == tfcRunPlannedAndFinished
+	})
+}
+
+// run uploads the configuration and creates a run of the workspace for it
+func (t *TerraformCloud) run(ctx context.Context, speculative bool,
+	attrs tfcRunAttributes) (*tfcRun, error) {
+
+	if t.workspaceID == "" {
+		return nil, fmt.Errorf("workspace %s is not initialized", t.workspace)
+	}
+
+	cvID, err := t.uploadConfiguration(ctx, speculative)
+	if err != nil {
+		t.logger.Error("unable to upload configuration", "error", err)
+		return nil, err
+	}
+
+	attrs.Message = tfcRunMessage
+	run, err := t.api.createRun(ctx, t.workspaceID, cvID, attrs)
+	if err != nil {
+		t.logger.Error("unable to create run", "error", err)
+		return nil, err
+	}
+	t.logger.Debug("created run", "run_id", run.ID)
+	return run, nil
+}
+
+// uploadConfiguration archives the working directory and uploads it as a new
+// configuration version of the workspace. Returns the ID of the configuration
+// version once it is uploaded.
+func (t *TerraformCloud) uploadConfiguration(ctx context.Context, speculative bool) (string, error) {
+	archive, err := archiveDir(t.workingDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to archive working directory %s: %s",
+			t.workingDir, err)
+	}
+
+	cv, err := t.api.createConfigurationVersion(ctx, t.workspaceID, speculative)
+	if err != nil {
+		return "", err
+	}
+
+	if err = t.api.uploadConfiguration(ctx, cv.UploadURL, archive); err != nil {
+		return "", err
+	}
+
+	for {
+		cv, err = t.api.readConfigurationVersion(ctx, cv.ID)
+		if err != nil {
+			return "", err
+		}
+
+		switch cv.Status {
+		case "uploaded":
+			return cv.ID, nil
+		case "errored":
+			return "", fmt.Errorf("configuration version %s errored: %s",
+				cv.ID, cv.Error)
+		}
+
+		if err = t.wait(ctx); err != nil {
+			return "", err
+		}
+	}
+}
+
+// waitForRun polls the run until done returns true or the run failed
+func (t *TerraformCloud) waitForRun(ctx context.Context, runID string,
+	done func(*tfcRun) bool) (*tfcRun, error) {
+
+	var status string
+	for {
+		run, err := t.api.readRun(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+
+		if run.Status != status {
+			status = run.Status
+			t.logger.Trace("run status changed", "run_id", runID,
+				"status", status)
+			fmt.Fprintf(t.stdout, "Terraform Cloud run %s: %s\n", runID, status)
+		}
+
+		if done(run) {
+			return run, nil
+		}
+
+		if run.isFailed() {
+			return nil, fmt.Errorf("run %s of workspace %s did not complete: %s",
+				runID, t.workspace, run.Status)
+		}
+
+		if err = t.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait waits for the poll interval or until the context is canceled
+func (t *TerraformCloud) wait(ctx context.Context) error {
+	select {
+	case <-time.After(t.pollInterval):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readPlan reads the JSON plan of a run
+func (t *TerraformCloud) readPlan(ctx context.Context, runID string) (*tfjson.Plan, error) {
+	raw, err := t.api.readPlanJSON(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan tfjson.Plan
+	if err := json.Unmarshal(raw, &plan); err != nil {
+		return nil, fmt.Errorf("unable to decode plan of run %s: %s", runID, err)
+	}
+	return &plan, nil
+}
+
+// discardSavedRun discards the run saved by SavePlan if it was not applied.
+// Runs waiting for confirmation block the queue of the workspace.
+func (t *TerraformCloud) discardSavedRun(ctx context.Context) {
+	run := t.savedRun
+	t.savedRun = nil
+	if run == nil || run.Status == tfcRunPlannedAndFinished {
+		return
+	}
+
+	if err := t.api.discardRun(ctx, run.ID); err != nil {
+		t.logger.Warn("unable to discard saved run", "run_id", run.ID,
+			"error", err)
+	}
+}
+
+// startSpan starts a span of a Terraform command for the workspace
+func (t *TerraformCloud) startSpan(ctx context.Context, spanName string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, spanName, tracing.TaskName(t.workspace))
+}
+
+// GoString defines the printable version of this struct.
+// Sensitive information is redacted.
+func (t *TerraformCloud) GoString() string {
+	if t == nil {
+		return "(*TerraformCloud)(nil)"
+	}
+
+	return fmt.Sprintf("&TerraformCloud{"+
+		"Address:%s, "+
+		"Organization:%s, "+
+		"Workspace:%s, "+
+		"WorkingDir:%s"+
+		"}",
+		t.api.address,
+		t.organization,
+		t.workspace,
+		t.workingDir,
+	)
+}
+
+// archiveDir archives the files of a directory as a gzipped tarball for a
+// configuration version. Local Terraform data, plans, state, and logs are
+// excluded.
+func archiveDir(dir string) (io.Reader, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		name := info.Name()
+		if info.IsDir() {
+			if name == ".terraform" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() || excludeFromArchive(name) {
+			return nil
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// excludeFromArchive returns whether a file of the working directory is
+// excluded from the uploaded configuration
+func excludeFromArchive(name string) bool {
+	return strings.HasSuffix(name, ".tfplan") ||
+		strings.HasPrefix(name, "terraform.tfstate") ||
+		name == "terraform.log"
+}
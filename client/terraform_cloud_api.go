@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// tfcAPIPath is the path of the Terraform Cloud API relative to the
+	// address of the Terraform Cloud instance
+	tfcAPIPath = "/api/v2"
+
+	// tfcContentType is the JSON:API media type used by the Terraform Cloud
+	// API for request and response documents
+	tfcContentType = "application/vnd.api+json"
+
+	// tfcRequestTimeout is the timeout of a single request to the Terraform
+	// Cloud API
+	tfcRequestTimeout = 30 * time.Second
+)
+
+// Run statuses of Terraform Cloud runs that are relevant to CTS.
+// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-states
+const (
+	tfcRunApplied            = "applied"
+	tfcRunPlannedAndFinished = "planned_and_finished"
+	tfcRunErrored            = "errored"
+	tfcRunDiscarded          = "discarded"
+	tfcRunCanceled           = "canceled"
+	tfcRunForceCanceled      = "force_canceled"
+	tfcRunPolicySoftFailed   = "policy_soft_failed"
+)
+
+// tfcAPI is a minimal client of the Terraform Cloud API for the resources
+// that are needed to execute tasks as Terraform Cloud runs.
+// https://developer.hashicorp.com/terraform/cloud-docs/api-docs
+type tfcAPI struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+// tfcError is an error response of the Terraform Cloud API
+type tfcError struct {
+	method     string
+	path       string
+	statusCode int
+	details    []string
+}
+
+func (e *tfcError) Error() string {
+	msg := fmt.Sprintf("terraform cloud: %s %s: unexpected response code %d",
+		e.method, e.path, e.statusCode)
+	if len(e.details) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, strings.Join(e.details, ", "))
+	}
+	return msg
+}
+
+// isNotFound returns whether the error is a not found response
+func isNotFound(err error) bool {
+	tfcErr, ok := err.(*tfcError)
+	return ok && tfcErr.statusCode == http.StatusNotFound
+}
+
+// jsonAPIResource is a resource object of a JSON:API document
+type jsonAPIResource struct {
+	ID            string                         `json:"id,omitempty"`
+	Type          string                         `json:"type"`
+	Attributes    interface{}                    `json:"attributes,omitempty"`
+	Relationships map[string]jsonAPIRelationship `json:"relationships,omitempty"`
+}
+
+// jsonAPIRelationship is a relationship of a JSON:API resource object
+type jsonAPIRelationship struct {
+	Data *jsonAPIResource `json:"data"`
+}
+
+// jsonAPIResponse is a JSON:API document received from the API. The
+// attributes of its resources are decoded separately by resource type.
+type jsonAPIResponse struct {
+	Data struct {
+		ID         string          `json:"id"`
+		Attributes json.RawMessage `json:"attributes"`
+	} `json:"data"`
+}
+
+// jsonAPIListResponse is a JSON:API document of a list of resources
+type jsonAPIListResponse struct {
+	Data []struct {
+		ID         string          `json:"id"`
+		Attributes json.RawMessage `json:"attributes"`
+	} `json:"data"`
+}
+
+// tfcWorkspaceAttributes are the workspace attributes managed by CTS
+type tfcWorkspaceAttributes struct {
+	Name             string   `json:"name,omitempty"`
+	ExecutionMode    string   `json:"execution-mode,omitempty"`
+	AgentPoolID      string   `json:"agent-pool-id,omitempty"`
+	TerraformVersion string   `json:"terraform-version,omitempty"`
+	AutoApply        *bool    `json:"auto-apply,omitempty"`
+	TagNames         []string `json:"tag-names,omitempty"`
+}
+
+// tfcVariableAttributes are the attributes of a workspace variable
+type tfcVariableAttributes struct {
+	Key       string `json:"key"`
+	Value     string `json:"value,omitempty"`
+	Category  string `json:"category"`
+	Sensitive bool   `json:"sensitive"`
+}
+
+// tfcVariable is a workspace variable
+type tfcVariable struct {
+	ID string
+	tfcVariableAttributes
+}
+
+// tfcConfigurationVersion is an uploaded configuration of a workspace
+type tfcConfigurationVersion struct {
+	ID        string
+	Status    string `json:"status"`
+	Error     string `json:"error-message"`
+	UploadURL string `json:"upload-url"`
+}
+
+// tfcRunAttributes are the attributes to create a run with
+type tfcRunAttributes struct {
+	Message     string `json:"message"`
+	AutoApply   *bool  `json:"auto-apply,omitempty"`
+	PlanOnly    bool   `json:"plan-only,omitempty"`
+	RefreshOnly bool   `json:"refresh-only,omitempty"`
+}
+
+// tfcRun is a run of a workspace
+type tfcRun struct {
+	ID         string
+	Status     string `json:"status"`
+	HasChanges bool   `json:"has-changes"`
+	Actions    struct {
+		IsConfirmable bool `json:"is-confirmable"`
+		IsDiscardable bool `json:"is-discardable"`
+	} `json:"actions"`
+}
+
+// isFailed returns whether the run ended without completing
+func (r *tfcRun) isFailed() bool {
+	switch r.Status {
+	case tfcRunErrored, tfcRunDiscarded, tfcRunCanceled, tfcRunForceCanceled,
+		tfcRunPolicySoftFailed:
+		return true
+	}
+	return false
+}
+
+// readWorkspace reads a workspace of the organization by name
+func (a *tfcAPI) readWorkspace(ctx context.Context, org, name string) (string, error) {
+	var resp jsonAPIResponse
+	path := fmt.Sprintf("/organizations/%s/workspaces/%s",
+		url.PathEscape(org), url.PathEscape(name))
+	if err := a.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
+
+// createWorkspace creates a workspace in the organization
+func (a *tfcAPI) createWorkspace(ctx context.Context, org string, attrs tfcWorkspaceAttributes) (string, error) {
+	var resp jsonAPIResponse
+	path := fmt.Sprintf("/organizations/%s/workspaces", url.PathEscape(org))
+	body := jsonAPIResource{Type: "workspaces", Attributes: attrs}
+	if err := a.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
+
+// updateWorkspace updates the attributes of a workspace
+func (a *tfcAPI) updateWorkspace(ctx context.Context, id string, attrs tfcWorkspaceAttributes) error {
+	path := fmt.Sprintf("/workspaces/%s", url.PathEscape(id))
+	body := jsonAPIResource{Type: "workspaces", Attributes: attrs}
+	return a.do(ctx, http.MethodPatch, path, body, nil)
+}
+
+// addWorkspaceTags adds tags to a workspace. Existing tags are kept.
+func (a *tfcAPI) addWorkspaceTags(ctx context.Context, id string, tags []string) error {
+	path := fmt.Sprintf("/workspaces/%s/relationships/tags", url.PathEscape(id))
+	body := make([]jsonAPIResource, len(tags))
+	for i, tag := range tags {
+		body[i] = jsonAPIResource{
+			Type:       "tags",
+			Attributes: map[string]string{"name": tag},
+		}
+	}
+	return a.do(ctx, http.MethodPost, path, body, nil)
+}
+
+// readAgentPoolID looks up the ID of an agent pool of the organization by name
+func (a *tfcAPI) readAgentPoolID(ctx context.Context, org, name string) (string, error) {
+	var resp jsonAPIListResponse
+	path := fmt.Sprintf("/organizations/%s/agent-pools?q=%s",
+		url.PathEscape(org), url.QueryEscape(name))
+	if err := a.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return "", err
+	}
+
+	for _, pool := range resp.Data {
+		var attrs struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(pool.Attributes, &attrs); err != nil {
+			return "", err
+		}
+		if attrs.Name == name {
+			return pool.ID, nil
+		}
+	}
+	return "", fmt.Errorf("agent pool %q not found in organization %q",
+		name, org)
+}
+
+// listVariables lists the variables of a workspace
+func (a *tfcAPI) listVariables(ctx context.Context, workspaceID string) ([]tfcVariable, error) {
+	var resp jsonAPIListResponse
+	path := fmt.Sprintf("/workspaces/%s/vars", url.PathEscape(workspaceID))
+	if err := a.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	vars := make([]tfcVariable, len(resp.Data))
+	for i, v := range resp.Data {
+		vars[i].ID = v.ID
+		if err := json.Unmarshal(v.Attributes, &vars[i].tfcVariableAttributes); err != nil {
+			return nil, err
+		}
+	}
+	return vars, nil
+}
+
+// createVariable creates a workspace variable
+func (a *tfcAPI) createVariable(ctx context.Context, workspaceID string, attrs tfcVariableAttributes) error {
+	path := fmt.Sprintf("/workspaces/%s/vars", url.PathEscape(workspaceID))
+	body := jsonAPIResource{Type: "vars", Attributes: attrs}
+	return a.do(ctx, http.MethodPost, path, body, nil)
+}
+
+// updateVariable updates a workspace variable
+func (a *tfcAPI) updateVariable(ctx context.Context, workspaceID, id string, attrs tfcVariableAttributes) error {
+	path := fmt.Sprintf("/workspaces/%s/vars/%s",
+		url.PathEscape(workspaceID), url.PathEscape(id))
+	body := jsonAPIResource{ID: id, Type: "vars", Attributes: attrs}
+	return a.do(ctx, http.MethodPatch, path, body, nil)
+}
+
+// createConfigurationVersion creates a configuration version of a workspace
+// to upload a configuration to. Runs are not queued automatically once the
+// configuration is uploaded.
+func (a *tfcAPI) createConfigurationVersion(ctx context.Context, workspaceID string,
+	speculative bool) (*tfcConfigurationVersion, error) {
+
+	var resp jsonAPIResponse
+	path := fmt.Sprintf("/workspaces/%s/configuration-versions",
+		url.PathEscape(workspaceID))
+	body := jsonAPIResource{
+		Type: "configuration-versions",
+		Attributes: map[string]bool{
+			"auto-queue-runs": false,
+			"speculative":     speculative,
+		},
+	}
+	if err := a.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return decodeConfigurationVersion(resp)
+}
+
+// readConfigurationVersion reads a configuration version
+func (a *tfcAPI) readConfigurationVersion(ctx context.Context, id string) (*tfcConfigurationVersion, error) {
+	var resp jsonAPIResponse
+	path := fmt.Sprintf("/configuration-versions/%s", url.PathEscape(id))
+	if err := a.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return decodeConfigurationVersion(resp)
+}
+
+func decodeConfigurationVersion(resp jsonAPIResponse) (*tfcConfigurationVersion, error) {
+	cv := tfcConfigurationVersion{ID: resp.Data.ID}
+	if err := json.Unmarshal(resp.Data.Attributes, &cv); err != nil {
+		return nil, err
+	}
+	return &cv, nil
+}
+
+// uploadConfiguration uploads the configuration archive to the upload URL of
+// a configuration version. The upload URL is pre-signed and does not require
+// authentication.
+func (a *tfcAPI) uploadConfiguration(ctx context.Context, uploadURL string, archive io.Reader) error {
+	ctx, cancel := context.WithTimeout(ctx, tfcRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, archive)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &tfcError{
+			method:     http.MethodPut,
+			path:       "configuration upload",
+			statusCode: resp.StatusCode,
+		}
+	}
+	return nil
+}
+
+// createRun creates a run of a workspace for a configuration version
+func (a *tfcAPI) createRun(ctx context.Context, workspaceID, cvID string,
+	attrs tfcRunAttributes) (*tfcRun, error) {
+
+	var resp jsonAPIResponse
+	body := jsonAPIResource{
+		Type:       "runs",
+		Attributes: attrs,
+		Relationships: map[string]jsonAPIRelationship{
+			"workspace": {Data: &jsonAPIResource{
+				ID:   workspaceID,
+				Type: "workspaces",
+			}},
+			"configuration-version": {Data: &jsonAPIResource{
+				ID:   cvID,
+				Type: "configuration-versions",
+			}},
+		},
+	}
+	if err := a.do(ctx, http.MethodPost, "/runs", body, &resp); err != nil {
+		return nil, err
+	}
+	return decodeRun(resp)
+}
+
+// readRun reads a run
+func (a *tfcAPI) readRun(ctx context.Context, id string) (*tfcRun, error) {
+	var resp jsonAPIResponse
+	path := fmt.Sprintf("/runs/%s", url.PathEscape(id))
+	if err := a.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return decodeRun(resp)
+}
+
+func decodeRun(resp jsonAPIResponse) (*tfcRun, error) {
+	run := tfcRun{ID: resp.Data.ID}
+	if err := json.Unmarshal(resp.Data.Attributes, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// applyRun confirms a run that is waiting for confirmation to be applied
+func (a *tfcAPI) applyRun(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/runs/%s/actions/apply", url.PathEscape(id))
+	return a.do(ctx, http.MethodPost, path, nil, nil)
+}
+
+// discardRun discards a run that is waiting for confirmation
+func (a *tfcAPI) discardRun(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/runs/%s/actions/discard", url.PathEscape(id))
+	return a.do(ctx, http.MethodPost, path, nil, nil)
+}
+
+// readPlanJSON reads the JSON representation of the plan of a run
+func (a *tfcAPI) readPlanJSON(ctx context.Context, runID string) ([]byte, error) {
+	var raw json.RawMessage
+	path := fmt.Sprintf("/runs/%s/plan/json-output", url.PathEscape(runID))
+	if err := a.do(ctx, http.MethodGet, path, nil, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// do sends a request to the API. The body is wrapped in a JSON:API document
+// and the response document is decoded into out if not nil.
+func (a *tfcAPI) do(ctx context.Context, method, path string, body, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, tfcRequestTimeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(map[string]interface{}{"data": body})
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method,
+		a.address+tfcAPIPath+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	req.Header.Set("Accept", tfcContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", tfcContentType)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newTFCError(method, path, resp)
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// newTFCError creates an error from an error response, including the details
+// of the JSON:API errors if present
+func newTFCError(method, path string, resp *http.Response) error {
+	err := &tfcError{
+		method:     method,
+		path:       path,
+		statusCode: resp.StatusCode,
+	}
+
+	var errResp struct {
+		Errors []struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if json.NewDecoder(resp.Body).Decode(&errResp) != nil {
+		return err
+	}
+	for _, e := range errResp.Errors {
+		detail := e.Title
+		if e.Detail != "" {
+			detail = fmt.Sprintf("%s: %s", e.Title, e.Detail)
+		}
+		err.details = append(err.details, detail)
+	}
+	return err
+}
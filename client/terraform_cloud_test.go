@@ -0,0 +1,674 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTFCRun is a run of the fake Terraform Cloud API. The status of the run
+// progresses through the statuses with each read of the run.
+type fakeTFCRun struct {
+	id          string
+	statuses    []string
+	idx         int
+	hasChanges  bool
+	confirmable bool
+}
+
+func (r *fakeTFCRun) status() string {
+	return r.statuses[r.idx]
+}
+
+// fakeTFC is a stand-in of the Terraform Cloud API for the resources used by
+// the Terraform Cloud client
+type fakeTFC struct {
+	mu     sync.Mutex
+	server *httptest.Server
+
+	workspaces    map[string]string // name to ID
+	workspaceAttr map[string]interface{}
+	tags          []string
+	vars          map[string]tfcVariableAttributes // ID to attributes
+	uploads       map[string][]string              // configuration version ID to files
+	runs          map[string]*fakeTFCRun
+	runAttrs      []map[string]interface{}
+	discarded     []string
+
+	// hasChanges sets whether the plans of runs have changes
+	hasChanges bool
+	// failRuns sets runs to error
+	failRuns bool
+}
+
+func newFakeTFC(t *testing.T) *fakeTFC {
+	f := &fakeTFC{
+		workspaces: make(map[string]string),
+		vars:       make(map[string]tfcVariableAttributes),
+		uploads:    make(map[string][]string),
+		runs:       make(map[string]*fakeTFCRun),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/organizations/{org}/workspaces/{name}", f.readWorkspace)
+	mux.HandleFunc("POST /api/v2/organizations/{org}/workspaces", f.createWorkspace)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{id}", f.updateWorkspace)
+	mux.HandleFunc("POST /api/v2/workspaces/{id}/relationships/tags", f.addTags)
+	mux.HandleFunc("GET /api/v2/organizations/{org}/agent-pools", f.listAgentPools)
+	mux.HandleFunc("GET /api/v2/workspaces/{id}/vars", f.listVars)
+	mux.HandleFunc("POST /api/v2/workspaces/{id}/vars", f.createVar)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{id}/vars/{var}", f.updateVar)
+	mux.HandleFunc("POST /api/v2/workspaces/{id}/configuration-versions", f.createCV)
+	mux.HandleFunc("GET /api/v2/configuration-versions/{id}", f.readCV)
+	mux.HandleFunc("PUT /upload/{id}", f.upload)
+	mux.HandleFunc("POST /api/v2/runs", f.createRun)
+	mux.HandleFunc("GET /api/v2/runs/{id}", f.readRun)
+	mux.HandleFunc("POST /api/v2/runs/{id}/actions/apply", f.applyRun)
+	mux.HandleFunc("POST /api/v2/runs/{id}/actions/discard", f.discardRun)
+	mux.HandleFunc("GET /api/v2/runs/{id}/plan/json-output", f.planJSON)
+
+	f.server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/upload/") &&
+				r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			mux.ServeHTTP(w, r)
+		}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeTFC) respond(w http.ResponseWriter, id string, attrs interface{}) {
+	w.Header().Set("Content-Type", tfcContentType)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{"id": id, "attributes": attrs},
+	})
+}
+
+func decodeFakeTFCRequest(r *http.Request) map[string]interface{} {
+	var doc struct {
+		Data struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	json.NewDecoder(r.Body).Decode(&doc)
+	return doc.Data.Attributes
+}
+
+func (f *fakeTFC) readWorkspace(w http.ResponseWriter, r *http.Request) {
+	id, ok := f.workspaces[r.PathValue("name")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"status":"404","title":"not found"}]}`))
+		return
+	}
+	f.respond(w, id, map[string]string{"name": r.PathValue("name")})
+}
+
+func (f *fakeTFC) createWorkspace(w http.ResponseWriter, r *http.Request) {
+	attrs := decodeFakeTFCRequest(r)
+	id := fmt.Sprintf("ws-%d", len(f.workspaces)+1)
+	f.workspaces[attrs["name"].(string)] = id
+	f.workspaceAttr = attrs
+	if tags, ok := attrs["tag-names"].([]interface{}); ok {
+		for _, tag := range tags {
+			f.tags = append(f.tags, tag.(string))
+		}
+	}
+	w.WriteHeader(http.StatusCreated)
+	f.respond(w, id, attrs)
+}
+
+func (f *fakeTFC) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	f.workspaceAttr = decodeFakeTFCRequest(r)
+	f.respond(w, r.PathValue("id"), f.workspaceAttr)
+}
+
+func (f *fakeTFC) addTags(w http.ResponseWriter, r *http.Request) {
+	var doc struct {
+		Data []struct {
+			Attributes struct {
+				Name string `json:"name"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	json.NewDecoder(r.Body).Decode(&doc)
+	for _, tag := range doc.Data {
+		f.tags = append(f.tags, tag.Attributes.Name)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeTFC) listAgentPools(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", tfcContentType)
+	w.Write([]byte(`{"data":[
+		{"id":"apool-1","attributes":{"name":"pool-a-1"}},
+		{"id":"apool-2","attributes":{"name":"pool-a"}}
+	]}`))
+}
+
+func (f *fakeTFC) listVars(w http.ResponseWriter, r *http.Request) {
+	data := make([]map[string]interface{}, 0, len(f.vars))
+	for id, v := range f.vars {
+		data = append(data, map[string]interface{}{"id": id, "attributes": v})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func (f *fakeTFC) createVar(w http.ResponseWriter, r *http.Request) {
+	attrs := decodeFakeTFCRequest(r)
+	id := fmt.Sprintf("var-%d", len(f.vars)+1)
+	f.vars[id] = tfcVariableAttributes{
+		Key:       attrs["key"].(string),
+		Value:     attrs["value"].(string),
+		Category:  attrs["category"].(string),
+		Sensitive: attrs["sensitive"].(bool),
+	}
+	w.WriteHeader(http.StatusCreated)
+	f.respond(w, id, attrs)
+}
+
+func (f *fakeTFC) updateVar(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("var")
+	v := f.vars[id]
+	v.Value = decodeFakeTFCRequest(r)["value"].(string)
+	f.vars[id] = v
+	f.respond(w, id, v)
+}
+
+func (f *fakeTFC) createCV(w http.ResponseWriter, r *http.Request) {
+	id := fmt.Sprintf("cv-%d", len(f.uploads)+1)
+	f.uploads[id] = nil
+	w.WriteHeader(http.StatusCreated)
+	f.respond(w, id, map[string]string{
+		"status":     "pending",
+		"upload-url": f.server.URL + "/upload/" + id,
+	})
+}
+
+func (f *fakeTFC) readCV(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	files, ok := f.uploads[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	status := "pending"
+	if files != nil {
+		status = "uploaded"
+	}
+	f.respond(w, id, map[string]string{"status": status})
+}
+
+func (f *fakeTFC) upload(w http.ResponseWriter, r *http.Request) {
+	gr, err := gzip.NewReader(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	files := []string{}
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		files = append(files, hdr.Name)
+	}
+	f.uploads[r.PathValue("id")] = files
+}
+
+func (f *fakeTFC) createRun(w http.ResponseWriter, r *http.Request) {
+	attrs := decodeFakeTFCRequest(r)
+	f.runAttrs = append(f.runAttrs, attrs)
+
+	run := &fakeTFCRun{
+		id:         fmt.Sprintf("run-%d", len(f.runs)+1),
+		hasChanges: f.hasChanges,
+	}
+	autoApply, _ := attrs["auto-apply"].(bool)
+	planOnly, _ := attrs["plan-only"].(bool)
+	switch {
+	case f.failRuns:
+		run.statuses = []string{"planning", tfcRunErrored}
+	case planOnly, !f.hasChanges:
+		run.statuses = []string{"planning", tfcRunPlannedAndFinished}
+	case autoApply:
+		run.statuses = []string{"planning", "applying", tfcRunApplied}
+	default:
+		run.statuses = []string{"planning", "planned"}
+		run.confirmable = true
+	}
+	f.runs[run.id] = run
+	w.WriteHeader(http.StatusCreated)
+	f.respondRun(w, run)
+}
+
+func (f *fakeTFC) respondRun(w http.ResponseWriter, run *fakeTFCRun) {
+	confirmable := run.confirmable && run.status() == "planned"
+	f.respond(w, run.id, map[string]interface{}{
+		"status":      run.status(),
+		"has-changes": run.hasChanges,
+		"actions": map[string]bool{
+			"is-confirmable": confirmable,
+			"is-discardable": confirmable,
+		},
+	})
+}
+
+func (f *fakeTFC) readRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.runs[r.PathValue("id")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if run.idx < len(run.statuses)-1 {
+		run.idx++
+	}
+	f.respondRun(w, run)
+}
+
+func (f *fakeTFC) applyRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.runs[r.PathValue("id")]
+	if !ok || run.status() != "planned" {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	run.statuses = append(run.statuses, "applying", tfcRunApplied)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (f *fakeTFC) discardRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.runs[r.PathValue("id")]
+	if !ok || run.status() != "planned" {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	run.statuses = append(run.statuses, tfcRunDiscarded)
+	run.idx = len(run.statuses) - 1
+	f.discarded = append(f.discarded, run.id)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (f *fakeTFC) planJSON(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.runs[r.PathValue("id")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	actions := `["no-op"]`
+	if run.hasChanges {
+		actions = `["create"]`
+	}
+	fmt.Fprintf(w, `{"format_version":"1.0","resource_changes":[{
+		"address":"local_file.test","mode":"managed","type":"local_file",
+		"name":"test","change":{"actions":%s}}]}`, actions)
+}
+
+// newTestTerraformCloud creates a Terraform Cloud client for the fake API
+// with a working directory containing a root module
+func newTestTerraformCloud(t *testing.T, f *fakeTFC, conf *TerraformCloudConfig) *TerraformCloud {
+	wd := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(wd, "main.tf"), []byte("module \"test\" {}"), 0640))
+	require.NoError(t, os.WriteFile(filepath.Join(wd, "terraform.tfvars"), []byte(""), 0640))
+	require.NoError(t, os.WriteFile(filepath.Join(wd, planFilename), []byte(""), 0640))
+	require.NoError(t, os.MkdirAll(filepath.Join(wd, ".terraform", "modules"), 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(wd, ".terraform", "modules", "modules.json"), []byte("{}"), 0640))
+
+	if conf == nil {
+		conf = &TerraformCloudConfig{}
+	}
+	conf.Address = f.server.URL
+	conf.Organization = "org"
+	conf.Token = "token"
+	conf.Workspace = "cts-task"
+	conf.WorkingDir = wd
+
+	client, err := NewTerraformCloud(conf)
+	require.NoError(t, err)
+	client.pollInterval = 0
+	client.logger = logging.NewNullLogger()
+	return client
+}
+
+func TestNewTerraformCloud(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		config          *TerraformCloudConfig
+		expectError     bool
+		expectedAddress string
+	}{
+		{
+			"nil config",
+			nil,
+			true,
+			"",
+		},
+		{
+			"missing organization",
+			&TerraformCloudConfig{Token: "token", Workspace: "ws"},
+			true,
+			"",
+		},
+		{
+			"hostname",
+			&TerraformCloudConfig{
+				Address:      "app.terraform.io",
+				Organization: "org",
+				Token:        "token",
+				Workspace:    "ws",
+			},
+			false,
+			"https://app.terraform.io",
+		},
+		{
+			"address",
+			&TerraformCloudConfig{
+				Address:      "http://localhost:8080/",
+				Organization: "org",
+				Token:        "token",
+				Workspace:    "ws",
+			},
+			false,
+			"http://localhost:8080",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewTerraformCloud(tc.config)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAddress, client.api.address)
+			assert.Equal(t, defaultTFCExecutionMode, client.executionMode)
+			assert.Equal(t, tfcRequestTimeout, client.api.client.Timeout)
+		})
+	}
+}
+
+func TestTerraformCloud_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create workspace", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, &TerraformCloudConfig{
+			Tags:             []string{"cts"},
+			ExecutionMode:    "agent",
+			AgentPoolName:    "pool-a",
+			TerraformVersion: "1.5.0",
+		})
+		require.NoError(t, client.SetEnv(map[string]string{"PROVIDER_TOKEN": "secret"}))
+
+		require.NoError(t, client.Init(context.Background()))
+		assert.Equal(t, "ws-1", client.workspaceID)
+		assert.Equal(t, "cts-task", f.workspaceAttr["name"])
+		assert.Equal(t, "agent", f.workspaceAttr["execution-mode"])
+		assert.Equal(t, "apool-2", f.workspaceAttr["agent-pool-id"])
+		assert.Equal(t, "1.5.0", f.workspaceAttr["terraform-version"])
+		assert.Equal(t, false, f.workspaceAttr["auto-apply"])
+		assert.Equal(t, []string{"cts"}, f.tags)
+		assert.Equal(t, map[string]tfcVariableAttributes{
+			"var-1": {
+				Key:       "PROVIDER_TOKEN",
+				Value:     "secret",
+				Category:  "env",
+				Sensitive: true,
+			},
+		}, f.vars)
+	})
+
+	t.Run("update workspace", func(t *testing.T) {
+		f := newFakeTFC(t)
+		f.workspaces["cts-task"] = "ws-existing"
+		f.vars["var-existing"] = tfcVariableAttributes{
+			Key:      "PROVIDER_TOKEN",
+			Value:    "old",
+			Category: "env",
+		}
+		client := newTestTerraformCloud(t, f, &TerraformCloudConfig{
+			Tags: []string{"cts"},
+		})
+		require.NoError(t, client.SetEnv(map[string]string{
+			"PROVIDER_TOKEN": "new",
+			"OTHER":          "value",
+		}))
+
+		require.NoError(t, client.Init(context.Background()))
+		assert.Equal(t, "ws-existing", client.workspaceID)
+		assert.Equal(t, "remote", f.workspaceAttr["execution-mode"])
+		assert.Equal(t, []string{"cts"}, f.tags)
+		require.Len(t, f.vars, 2)
+		assert.Equal(t, "new", f.vars["var-existing"].Value)
+	})
+
+	t.Run("agent pool not found", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, &TerraformCloudConfig{
+			ExecutionMode: "agent",
+			AgentPoolName: "pool-b",
+		})
+		assert.Error(t, client.Init(context.Background()))
+		assert.Empty(t, f.workspaces)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, nil)
+		client.api.token = "invalid"
+		assert.Error(t, client.Init(context.Background()))
+	})
+}
+
+func TestTerraformCloud_Apply(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		hasChanges  bool
+		failRuns    bool
+		expectError bool
+	}{
+		{
+			"changes",
+			true,
+			false,
+			false,
+		},
+		{
+			"no changes",
+			false,
+			false,
+			false,
+		},
+		{
+			"run errored",
+			true,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFakeTFC(t)
+			f.hasChanges = tc.hasChanges
+			f.failRuns = tc.failRuns
+			client := newTestTerraformCloud(t, f, nil)
+			var stdout bytes.Buffer
+			client.SetStdout(&stdout)
+			require.NoError(t, client.Init(context.Background()))
+
+			err := client.Apply(context.Background())
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, []string{"main.tf", "terraform.tfvars"}, f.uploads["cv-1"],
+				"local Terraform data and plans are not uploaded")
+			require.Len(t, f.runAttrs, 1)
+			assert.Equal(t, true, f.runAttrs[0]["auto-apply"])
+			assert.Equal(t, tfcRunMessage, f.runAttrs[0]["message"])
+			assert.Contains(t, stdout.String(), "Terraform Cloud run run-1")
+		})
+	}
+
+	t.Run("not initialized", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, nil)
+		assert.Error(t, client.Apply(context.Background()))
+		assert.Empty(t, f.runs)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		f := newFakeTFC(t)
+		f.hasChanges = true
+		client := newTestTerraformCloud(t, f, nil)
+		require.NoError(t, client.Init(context.Background()))
+		client.pollInterval = defaultTFCPollInterval
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Error(t, client.Apply(ctx))
+	})
+}
+
+func TestTerraformCloud_Plan(t *testing.T) {
+	t.Parallel()
+
+	for _, hasChanges := range []bool{true, false} {
+		t.Run(fmt.Sprintf("changes_%t", hasChanges), func(t *testing.T) {
+			f := newFakeTFC(t)
+			f.hasChanges = hasChanges
+			client := newTestTerraformCloud(t, f, nil)
+			require.NoError(t, client.Init(context.Background()))
+
+			changes, err := client.Plan(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, hasChanges, changes)
+			require.Len(t, f.runAttrs, 1)
+			assert.Equal(t, true, f.runAttrs[0]["plan-only"])
+		})
+	}
+}
+
+func TestTerraformCloud_SavePlan_ApplyPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changes", func(t *testing.T) {
+		f := newFakeTFC(t)
+		f.hasChanges = true
+		client := newTestTerraformCloud(t, f, nil)
+		require.NoError(t, client.Init(context.Background()))
+
+		plan, err := client.SavePlan(context.Background())
+		require.NoError(t, err)
+		require.Len(t, plan.ResourceChanges, 1)
+		assert.Equal(t, "local_file.test", plan.ResourceChanges[0].Address)
+		assert.Equal(t, "planned", f.runs["run-1"].status())
+		assert.Equal(t, false, f.runAttrs[0]["auto-apply"])
+
+		require.NoError(t, client.ApplyPlan(context.Background()))
+		assert.Equal(t, tfcRunApplied, f.runs["run-1"].status())
+
+		// the saved plan can only be applied once
+		assert.Error(t, client.ApplyPlan(context.Background()))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, nil)
+		require.NoError(t, client.Init(context.Background()))
+
+		_, err := client.SavePlan(context.Background())
+		require.NoError(t, err)
+		assert.NoError(t, client.ApplyPlan(context.Background()))
+	})
+
+	t.Run("superseded", func(t *testing.T) {
+		f := newFakeTFC(t)
+		f.hasChanges = true
+		client := newTestTerraformCloud(t, f, nil)
+		require.NoError(t, client.Init(context.Background()))
+
+		_, err := client.SavePlan(context.Background())
+		require.NoError(t, err)
+		_, err = client.SavePlan(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"run-1"}, f.discarded)
+
+		require.NoError(t, client.ApplyPlan(context.Background()))
+		assert.Equal(t, tfcRunApplied, f.runs["run-2"].status())
+	})
+
+	t.Run("not saved", func(t *testing.T) {
+		f := newFakeTFC(t)
+		client := newTestTerraformCloud(t, f, nil)
+		require.NoError(t, client.Init(context.Background()))
+		assert.Error(t, client.ApplyPlan(context.Background()))
+	})
+}
+
+func TestTerraformCloud_InspectPlan(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeTFC(t)
+	f.hasChanges = true
+	client := newTestTerraformCloud(t, f, nil)
+	require.NoError(t, client.Init(context.Background()))
+
+	plan, err := client.InspectPlan(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.ResourceChanges, 1)
+	assert.True(t, plan.ResourceChanges[0].Change.Actions.Create())
+	assert.Equal(t, true, f.runAttrs[0]["plan-only"])
+	assert.Nil(t, f.runAttrs[0]["refresh-only"])
+	assert.Nil(t, client.savedRun, "inspected plans cannot be applied")
+}
+
+func TestTerraformCloud_InspectDrift(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeTFC(t)
+	client := newTestTerraformCloud(t, f, nil)
+	require.NoError(t, client.Init(context.Background()))
+
+	_, err := client.InspectDrift(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, true, f.runAttrs[0]["plan-only"])
+	assert.Equal(t, true, f.runAttrs[0]["refresh-only"])
+	assert.Nil(t, client.savedRun, "inspected plans cannot be applied")
+}
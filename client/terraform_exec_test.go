@@ -8,6 +8,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"os"
 	"runtime"
 	"testing"
@@ -60,3 +61,45 @@ func Test_TerraformExec_Leak(t *testing.T) {
 	assert.Equal(t, before, after, "the number of goroutines after the terraform "+
 		"requests should be the same as before")
 }
+
+// Test_TerraformExec_WorkspaceErrors checks that the workspace errors of the
+// Terraform CLI are converted to typed errors for the oldest and newest
+// supported versions of Terraform. terraform-exec >= v0.18 no longer returns
+// typed workspace errors.
+func Test_TerraformExec_WorkspaceErrors(t *testing.T) {
+	for _, version := range []string{"0.13.7", "1.14.3"} {
+		t.Run(version, func(t *testing.T) {
+			v, err := goVersion.NewVersion(version)
+			require.NoError(t, err)
+
+			// Download Terraform binary
+			dir := t.TempDir()
+			ctx := context.Background()
+			installer := hcinstall.NewInstaller()
+			execPath, err := installer.Ensure(ctx, []src.Source{
+				&releases.ExactVersion{
+					Product:    product.Terraform,
+					Version:    v,
+					InstallDir: dir,
+				},
+			})
+			require.NoError(t, err)
+
+			tf, err := tfexec.NewTerraform(dir, execPath)
+			require.NoError(t, err)
+			require.NoError(t, tf.Init(ctx))
+
+			err = tf.WorkspaceSelect(ctx, "missing")
+			var noWsErr *noWorkspaceError
+			if assert.True(t, errors.As(workspaceError(err), &noWsErr), err) {
+				assert.Equal(t, "missing", noWsErr.name)
+			}
+
+			err = tf.WorkspaceNew(ctx, "default")
+			var wsExistsErr *workspaceExistsError
+			if assert.True(t, errors.As(workspaceError(err), &wsExistsErr), err) {
+				assert.Equal(t, "default", wsExistsErr.name)
+			}
+		})
+	}
+}
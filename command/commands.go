@@ -51,6 +51,9 @@ func Commands(writer io.Writer, errorWriter io.Writer) map[string]cli.CommandFac
 		cmdTaskCreateName: func() (cli.Command, error) {
 			return newTaskCreateCommand(m), nil
 		},
+		cmdTaskApproveName: func() (cli.Command, error) {
+			return newTaskApproveCommand(m), nil
+		},
 		cmdStartName: func() (cli.Command, error) {
 			return newStartCommand(m), nil
 		},
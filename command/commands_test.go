@@ -20,6 +20,7 @@ func Test_Commands(t *testing.T) {
 		cmdTaskEnableName:  &taskEnableCommand{},
 		cmdTaskDisableName: &taskDisableCommand{},
 		cmdTaskDeleteName:  &taskDeleteCommand{},
+		cmdTaskApproveName: &taskApproveCommand{},
 		cmdStartName:       &startCommand{},
 	}
 
@@ -54,6 +54,7 @@ const (
 	FlagSSLVerify  = "ssl-verify"
 
 	FlagAutoApprove = "auto-approve"
+	FlagReject      = "reject"
 )
 
 func (m *meta) defaultFlagSet(name string) *flag.FlagSet {
@@ -251,6 +252,28 @@ func (m *meta) requestUserApprovalCreate(taskName string) (int, bool) {
 	return m.requestUserApproval(taskName, "creating")
 }
 
+// requestUserApprovalPlan prints a prompt for user approval of applying the
+// pending plan of a task and waits for the user input. It returns an exit code
+// and boolean describing if the user approved.
+func (m *meta) requestUserApprovalPlan(taskName string) (int, bool) {
+	m.UI.Info("Approving the plan will perform the actions described above.")
+	m.UI.Output(fmt.Sprintf("Do you want to perform these actions for '%s'?", taskName))
+	m.UI.Output(" - This action cannot be undone.")
+	m.UI.Output(" - Terraform will apply the saved plan as-is, and will error if")
+	m.UI.Output("   the infrastructure changed since the plan was created.\n")
+	return m.requestUserApproval(taskName, "approving plan of")
+}
+
+// requestUserApprovalReject prints a prompt for user approval of rejecting the
+// pending plan of a task and waits for the user input. It returns an exit code
+// and boolean describing if the user approved.
+func (m *meta) requestUserApprovalReject(taskName string) (int, bool) {
+	m.UI.Info(fmt.Sprintf("Do you want to reject the plan for '%s'?", taskName))
+	m.UI.Output(" - The actions described above will not be performed.")
+	m.UI.Output(" - The task will plan the changes again when its dependencies change.\n")
+	return m.requestUserApproval(taskName, "rejecting plan of")
+}
+
 // terraformApprovalWarning prints out a standard warning for approving a terraform plan
 func (m *meta) terraformApprovalWarning(taskName string) {
 	m.UI.Output(fmt.Sprintf("Do you want to perform these actions for '%s'?", taskName))
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+const cmdTaskApproveName = "task approve"
+
+// taskApproveCommand handles the `task approve` command
+type taskApproveCommand struct {
+	meta
+	autoApprove *bool
+	reject      *bool
+	flags       *flag.FlagSet
+
+	predictorClient oapigen.ClientWithResponsesInterface
+}
+
+func newTaskApproveCommand(m meta) *taskApproveCommand {
+	logging.DisableLogging()
+	flags := m.defaultFlagSet(cmdTaskApproveName)
+	flags.SetOutput(m.writer)
+	a := flags.Bool(FlagAutoApprove, false, "Skip interactive approval of the pending plan")
+	r := flags.Bool(FlagReject, false, "Reject the pending plan instead of approving it")
+	return &taskApproveCommand{
+		meta:        m,
+		autoApprove: a,
+		reject:      r,
+		flags:       flags,
+	}
+}
+
+// Name returns the subcommand
+func (c *taskApproveCommand) Name() string {
+	return cmdTaskApproveName
+}
+
+// Help returns the command's usage, list of flags, and examples
+func (c *taskApproveCommand) Help() string {
+	c.meta.setHelpOptions()
+	helpText := fmt.Sprintf(`
+Usage: consul-terraform-sync task approve [-help] [options] <task name>
+
+  Task Approve is used to approve or reject the plan of a task with a manual
+  apply mode that is pending approval. Approving the plan applies the changes
+  of the plan. Rejecting the plan discards it without applying the changes.
+
+Options:
+%s
+
+Example:
+
+  $ consul-terraform-sync task approve my_task
+  ==> Plan '3c6b0c3e-26a5-4c8f-8a4e-0e8b2b7d9c1f' of 'my_task' is pending approval
+
+  // ... plan details
+
+  ==> Approving the plan will perform the actions described above.
+      Do you want to perform these actions for 'my_task'?
+       - This action cannot be undone.
+       - Terraform will apply the saved plan as-is, and will error if
+         the infrastructure changed since the plan was created.
+
+      Only 'yes' will be accepted to approve, enter 'no' or leave blank to reject.
+
+  Enter a value: yes
+
+  ==> Applying the plan of 'my_task'...
+
+  ==> 'my_task' plan applied!
+`, strings.Join(c.meta.helpOptions, "\n"))
+	return strings.TrimSpace(helpText)
+}
+
+// Synopsis is a short one-line synopsis of the command
+func (c *taskApproveCommand) Synopsis() string {
+	return "Approves or rejects the pending plan of a task."
+}
+
+// AutocompleteFlags returns a mapping of supported flags and autocomplete
+// options for this command. The map key for the Flags map should be the
+// complete flag such as "-foo" or "--foo".
+func (c *taskApproveCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.meta.autoCompleteFlags(),
+		complete.Flags{
+			fmt.Sprintf("-%s", FlagAutoApprove): complete.PredictNothing,
+			fmt.Sprintf("-%s", FlagReject):      complete.PredictNothing,
+		})
+}
+
+// AutocompleteArgs returns the argument predictor for this command.
+// This commands uses a client to fetch a list of existing tasks
+// to predict the correct approve argument
+func (c *taskApproveCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		var client oapigen.ClientWithResponsesInterface
+		var err error
+		if c.predictorClient == nil {
+			client, err = c.meta.taskLifecycleClient()
+			if err != nil {
+				return nil
+			}
+		} else {
+			client = c.predictorClient
+		}
+
+		tasksResp, err := getTasks(context.Background(), client)
+		if err != nil {
+			return nil
+		}
+
+		taskNames := make([]string, 0)
+
+		if tasksResp.Tasks != nil {
+			for _, tasks := range *tasksResp.Tasks {
+				taskNames = append(taskNames, tasks.Name)
+			}
+		}
+		return taskNames
+	})
+}
+
+// Run runs the command
+func (c *taskApproveCommand) Run(args []string) int {
+	c.meta.setFlagsUsage(c.flags, args, c.Help())
+
+	if err := c.flags.Parse(args); err != nil {
+		return ExitCodeParseFlagsError
+	}
+
+	args = c.flags.Args()
+	if ok := c.meta.oneArgCheck(c.Name(), args); !ok {
+		return ExitCodeRequiredFlagsError
+	}
+
+	taskName := args[0]
+
+	client, err := c.meta.taskLifecycleClient()
+	if err != nil {
+		c.UI.Error(errCreatingClient)
+		c.UI.Output(fmt.Sprintf("client could not be created for '%s'", taskName))
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	ctx := context.Background()
+	plansResp, err := client.GetTaskPlansWithResponse(ctx, taskName)
+	if err == nil && plansResp.JSON200 == nil {
+		err = fmt.Errorf("nil response returned with status %s", plansResp.Status())
+	}
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error: unable to retrieve the pending plan of '%s'", taskName))
+		err = processEOFError(client.Scheme(), err)
+
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	plans := plansResp.JSON200.Plans
+	if len(plans) == 0 {
+		c.UI.Info(fmt.Sprintf("'%s' has no plan pending approval", taskName))
+		return ExitCodeOK
+	}
+
+	plan := plans[0]
+	c.UI.Info(fmt.Sprintf("Plan '%s' of '%s' is pending approval\n", plan.Id, taskName))
+	if plan.Plan != nil {
+		c.UI.Output(plan.Plan.Output)
+	}
+
+	if *c.reject {
+		if !*c.autoApprove {
+			if exitCode, approved := c.meta.requestUserApprovalReject(taskName); !approved {
+				return exitCode
+			}
+		}
+
+		c.UI.Info(fmt.Sprintf("Rejecting the plan of '%s'...\n", taskName))
+		if _, err = client.RejectTaskPlanWithResponse(ctx, taskName, plan.Id); err != nil {
+			c.UI.Error(fmt.Sprintf("Error: unable to reject the plan of '%s'", taskName))
+			msg := wordwrap.WrapString(err.Error(), uint(78))
+			c.UI.Output(msg)
+
+			return ExitCodeError
+		}
+
+		c.UI.Info(fmt.Sprintf("'%s' plan rejected!", taskName))
+		return ExitCodeOK
+	}
+
+	if !*c.autoApprove {
+		if exitCode, approved := c.meta.requestUserApprovalPlan(taskName); !approved {
+			return exitCode
+		}
+	}
+
+	c.UI.Info(fmt.Sprintf("Applying the plan of '%s'...\n", taskName))
+	if _, err = client.ApproveTaskPlanWithResponse(ctx, taskName, plan.Id); err != nil {
+		c.UI.Error(fmt.Sprintf("Error: unable to apply the plan of '%s'", taskName))
+		msg := wordwrap.WrapString(err.Error(), uint(78))
+		c.UI.Output(msg)
+
+		return ExitCodeError
+	}
+
+	c.UI.Info(fmt.Sprintf("'%s' plan applied!", taskName))
+	return ExitCodeOK
+}
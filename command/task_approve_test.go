@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/consul-terraform-sync/api/oapigen"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskApproveCommand_AutocompleteFlags(t *testing.T) {
+	t.Parallel()
+	cmd := newTaskApproveCommand(meta{UI: cli.NewMockUi()})
+
+	predictor := cmd.AutocompleteFlags()
+
+	// Test that we get the expected number of predictions
+	args := complete.Args{Last: "-"}
+	res := predictor.Predict(args)
+
+	// Grab the list of flags from the Flag object
+	flags := make([]string, 0)
+	cmd.flags.VisitAll(func(flag *flag.Flag) {
+		flags = append(flags, fmt.Sprintf("-%s", flag.Name))
+	})
+
+	// Verify that there is a prediction for each flag associated with the command
+	assert.Equal(t, len(flags), len(res))
+	assert.ElementsMatch(t, flags, res, "flags and predictions didn't match, make sure to add "+
+		"new flags to the command AutoCompleteFlags function")
+}
+
+func TestTaskApproveCommand_AutocompleteArgs(t *testing.T) {
+	t.Parallel()
+	cmd := newTaskApproveCommand(meta{UI: cli.NewMockUi()})
+
+	p := new(mocks.ClientWithResponsesInterface)
+	cmd.predictorClient = p
+
+	tasks := []oapigen.Task{{Name: "first"}, {Name: "second"}}
+	resp := oapigen.GetAllTasksResponse{
+		JSON200: &oapigen.TasksResponse{
+			RequestId: uuid.New(),
+			Tasks:     &tasks,
+		},
+	}
+	p.On("GetAllTasksWithResponse", mock.Anything).Return(&resp, nil)
+
+	res := cmd.AutocompleteArgs().Predict(complete.Args{})
+	assert.ElementsMatch(t, []string{"first", "second"}, res)
+}
+
+func TestTaskApproveCommand_Run(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		args       []string
+		noPlans    bool
+		expectCode int
+		expectPath string
+	}{
+		{
+			"approve",
+			[]string{"-auto-approve"},
+			false,
+			ExitCodeOK,
+			"/v1/tasks/my_task/plans/plan-1:approve",
+		},
+		{
+			"reject",
+			[]string{"-auto-approve", "-reject"},
+			false,
+			ExitCodeOK,
+			"/v1/tasks/my_task/plans/plan-1:reject",
+		},
+		{
+			"no pending plans",
+			[]string{"-auto-approve"},
+			true,
+			ExitCodeOK,
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := oapigen.PendingPlan{
+				Id:        "plan-1",
+				TaskName:  "my_task",
+				CreatedAt: time.Now(),
+				Plan: &oapigen.EventPlan{
+					Add:       1,
+					Resources: []string{},
+					Output:    "Plan: 1 to add, 0 to change, 0 to destroy.",
+				},
+			}
+
+			var posted string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					resp := oapigen.TaskPlansResponse{
+						Plans:     []oapigen.PendingPlan{plan},
+						RequestId: uuid.New(),
+					}
+					if tc.noPlans {
+						resp.Plans = []oapigen.PendingPlan{}
+					}
+					json.NewEncoder(w).Encode(resp)
+					return
+				}
+				posted = r.URL.Path
+				json.NewEncoder(w).Encode(oapigen.TaskPlanResponse{
+					Plan:      plan,
+					RequestId: uuid.New(),
+				})
+			}))
+			defer srv.Close()
+
+			ui := cli.NewMockUi()
+			cmd := newTaskApproveCommand(meta{UI: ui})
+
+			args := append([]string{fmt.Sprintf("-%s=%s", FlagHTTPAddr, srv.URL)},
+				tc.args...)
+			code := cmd.Run(append(args, "my_task"))
+			assert.Equal(t, tc.expectCode, code, ui.ErrorWriter.String())
+			assert.Equal(t, tc.expectPath, posted)
+			if !tc.noPlans {
+				assert.True(t, strings.Contains(ui.OutputWriter.String(), plan.Plan.Output))
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+)
+
+// DefaultBlackoutTimeZone is the default time zone of blackout windows, which
+// is the local time zone of CTS
+const DefaultBlackoutTimeZone = "Local"
+
+// maxBlackoutExtensions is the maximum number of times that a blackout is
+// extended by the windows that overlap or adjoin it
+const maxBlackoutExtensions = 100
+
+// BlackoutConfig configures the blackout windows during which tasks do not
+// apply changes, e.g. during change freezes. Dependency changes detected during
+// a window are still tracked and applied once when the window ends. It can be
+// configured globally and for each task. Windows of a task are added to the
+// global windows.
+type BlackoutConfig struct {
+	// Enabled determines whether the blackout windows apply. Disabling it for
+	// a task exempts the task from the global blackout windows.
+	Enabled *bool `mapstructure:"enabled" json:"enabled"`
+
+	// Windows are the blackout windows.
+	Windows []*BlackoutWindowConfig `mapstructure:"window" json:"window"`
+}
+
+// BlackoutWindowConfig configures a recurring blackout window. The window
+// starts on the cron schedule and lasts for the duration.
+type BlackoutWindowConfig struct {
+	// Start is the cron expression of when the window starts.
+	Start *string `mapstructure:"start" json:"start"`
+
+	// Duration is how long the window lasts.
+	Duration *time.Duration `mapstructure:"duration" json:"duration"`
+
+	// TimeZone is the IANA time zone that the cron expression is evaluated in,
+	// e.g. "America/New_York". Defaults to the local time zone of CTS.
+	TimeZone *string `mapstructure:"time_zone" json:"time_zone"`
+}
+
+// DefaultBlackoutConfig returns the default configuration struct.
+func DefaultBlackoutConfig() *BlackoutConfig {
+	return &BlackoutConfig{}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *BlackoutConfig) Copy() *BlackoutConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o BlackoutConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	if c.Windows != nil {
+		o.Windows = make([]*BlackoutWindowConfig, 0, len(c.Windows))
+		for _, w := range c.Windows {
+			o.Windows = append(o.Windows, w.Copy())
+		}
+	}
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *BlackoutConfig) Merge(o *BlackoutConfig) *BlackoutConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	for _, w := range o.Windows {
+		r.Windows = append(r.Windows, w.Copy())
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *BlackoutConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(true)
+	}
+
+	if c.Windows == nil {
+		c.Windows = []*BlackoutWindowConfig{}
+	}
+
+	for _, w := range c.Windows {
+		w.Finalize()
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *BlackoutConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for _, w := range c.Windows {
+		if w == nil {
+			return fmt.Errorf("blackout window cannot be empty")
+		}
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *BlackoutConfig) GoString() string {
+	if c == nil {
+		return "(*BlackoutConfig)(nil)"
+	}
+
+	windows := make([]string, len(c.Windows))
+	for i, w := range c.Windows {
+		windows[i] = w.GoString()
+	}
+
+	return fmt.Sprintf("&BlackoutConfig{"+
+		"Enabled:%t, "+
+		"Windows:%v"+
+		"}",
+		BoolVal(c.Enabled),
+		windows,
+	)
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *BlackoutWindowConfig) Copy() *BlackoutWindowConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o BlackoutWindowConfig
+	o.Start = StringCopy(c.Start)
+	o.Duration = TimeDurationCopy(c.Duration)
+	o.TimeZone = StringCopy(c.TimeZone)
+	return &o
+}
+
+// Finalize ensures there no nil pointers.
+func (c *BlackoutWindowConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Start == nil {
+		c.Start = String("")
+	}
+
+	if c.Duration == nil {
+		c.Duration = TimeDuration(0)
+	}
+
+	if c.TimeZone == nil {
+		c.TimeZone = String(DefaultBlackoutTimeZone)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *BlackoutWindowConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if StringVal(c.Start) == "" {
+		return fmt.Errorf("blackout window start is required")
+	}
+	if _, err := cronexpr.Parse(*c.Start); err != nil {
+		return fmt.Errorf("blackout window start %q is not a valid cron "+
+			"expression: %s", *c.Start, err)
+	}
+
+	if TimeDurationVal(c.Duration) <= 0 {
+		return fmt.Errorf("blackout window duration must be greater than 0, "+
+			"got %s", TimeDurationVal(c.Duration))
+	}
+
+	if _, err := time.LoadLocation(StringVal(c.TimeZone)); err != nil {
+		return fmt.Errorf("blackout window time_zone %q is not a valid time "+
+			"zone: %s", StringVal(c.TimeZone), err)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *BlackoutWindowConfig) GoString() string {
+	if c == nil {
+		return "(*BlackoutWindowConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&BlackoutWindowConfig{"+
+		"Start:%s, "+
+		"Duration:%s, "+
+		"TimeZone:%s"+
+		"}",
+		StringVal(c.Start),
+		TimeDurationVal(c.Duration),
+		StringVal(c.TimeZone),
+	)
+}
+
+// Active returns whether the time is within one of the enabled blackout
+// windows and, if so, when the blackout ends. Windows that overlap or adjoin
+// are considered to be one blackout. This method is recommended to run after
+// Validate().
+func (c *BlackoutConfig) Active(t time.Time) (time.Time, bool) {
+	if c == nil || !BoolVal(c.Enabled) {
+		return time.Time{}, false
+	}
+
+	var end time.Time
+	// the number of extensions is limited in case the windows never end
+	for i := 0; i < maxBlackoutExtensions; i++ {
+		extended := false
+		at := t
+		if !end.IsZero() {
+			at = end
+		}
+		for _, w := range c.Windows {
+			wEnd, ok := w.active(at)
+			if ok && wEnd.After(end) {
+				end = wEnd
+				extended = true
+			}
+		}
+		if !extended {
+			break
+		}
+	}
+
+	return end, !end.IsZero()
+}
+
+// active returns whether the time is within the window and, if so, when the
+// window ends
+func (c *BlackoutWindowConfig) active(t time.Time) (time.Time, bool) {
+	if c == nil {
+		return time.Time{}, false
+	}
+
+	expr, err := cronexpr.Parse(StringVal(c.Start))
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := time.LoadLocation(StringVal(c.TimeZone))
+	if err != nil {
+		return time.Time{}, false
+	}
+	d := TimeDurationVal(c.Duration)
+
+	// a window that includes the time started within the duration before it
+	start := expr.Next(t.Add(-d).In(loc))
+	if start.IsZero() || start.After(t) {
+		return time.Time{}, false
+	}
+	return start.Add(d), true
+}
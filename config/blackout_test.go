@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlackoutConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &BlackoutConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *BlackoutConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&BlackoutConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{
+					{
+						Start:    String("0 0 18 * * FRI *"),
+						Duration: TimeDuration(62 * time.Hour),
+						TimeZone: String("America/New_York"),
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestBlackoutConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	weekend := &BlackoutWindowConfig{
+		Start:    String("0 0 18 * * FRI *"),
+		Duration: TimeDuration(62 * time.Hour),
+	}
+	nightly := &BlackoutWindowConfig{
+		Start:    String("0 0 0 * * * *"),
+		Duration: TimeDuration(6 * time.Hour),
+	}
+
+	cases := []struct {
+		name string
+		a    *BlackoutConfig
+		b    *BlackoutConfig
+		r    *BlackoutConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&BlackoutConfig{},
+			&BlackoutConfig{},
+		},
+		{
+			"nil_b",
+			&BlackoutConfig{},
+			nil,
+			&BlackoutConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&BlackoutConfig{},
+			&BlackoutConfig{},
+			&BlackoutConfig{},
+		},
+		{
+			"enabled_overrides",
+			&BlackoutConfig{Enabled: Bool(true)},
+			&BlackoutConfig{Enabled: Bool(false)},
+			&BlackoutConfig{Enabled: Bool(false)},
+		},
+		{
+			"enabled_empty_two",
+			&BlackoutConfig{Enabled: Bool(false)},
+			&BlackoutConfig{},
+			&BlackoutConfig{Enabled: Bool(false)},
+		},
+		{
+			"windows_appended",
+			&BlackoutConfig{Windows: []*BlackoutWindowConfig{weekend}},
+			&BlackoutConfig{Windows: []*BlackoutWindowConfig{nightly}},
+			&BlackoutConfig{Windows: []*BlackoutWindowConfig{weekend, nightly}},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestBlackoutConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *BlackoutConfig
+		r    *BlackoutConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&BlackoutConfig{},
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{},
+			},
+		},
+		{
+			"windows",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{Start: String("0 0 18 * * FRI *")},
+				},
+			},
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{
+					{
+						Start:    String("0 0 18 * * FRI *"),
+						Duration: TimeDuration(0),
+						TimeZone: String(DefaultBlackoutTimeZone),
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestBlackoutConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *BlackoutConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&BlackoutConfig{},
+			true,
+		},
+		{
+			"valid",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{
+						Start:    String("0 0 18 * * FRI *"),
+						Duration: TimeDuration(62 * time.Hour),
+						TimeZone: String("America/New_York"),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"nil_window",
+			&BlackoutConfig{Windows: []*BlackoutWindowConfig{nil}},
+			false,
+		},
+		{
+			"missing_start",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{Duration: TimeDuration(time.Hour)},
+				},
+			},
+			false,
+		},
+		{
+			"invalid_start",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{
+						Start:    String("not a cron"),
+						Duration: TimeDuration(time.Hour),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"missing_duration",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{Start: String("0 0 18 * * FRI *")},
+				},
+			},
+			false,
+		},
+		{
+			"invalid_time_zone",
+			&BlackoutConfig{
+				Windows: []*BlackoutWindowConfig{
+					{
+						Start:    String("0 0 18 * * FRI *"),
+						Duration: TimeDuration(time.Hour),
+						TimeZone: String("Mars/Olympus_Mons"),
+					},
+				},
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBlackoutConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &BlackoutConfig{
+		Enabled: Bool(true),
+		Windows: []*BlackoutWindowConfig{
+			{
+				Start:    String("0 0 18 * * FRI *"),
+				Duration: TimeDuration(62 * time.Hour),
+				TimeZone: String("America/New_York"),
+			},
+		},
+	}
+	expected := "&BlackoutConfig{Enabled:true, Windows:[" +
+		"&BlackoutWindowConfig{Start:0 0 18 * * FRI *, Duration:62h0m0s, " +
+		"TimeZone:America/New_York}]}"
+	assert.Equal(t, expected, c.GoString())
+}
+
+func TestBlackoutConfig_Active(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// weekend change freeze from Friday 18:00 to Monday 08:00 New York time
+	weekend := &BlackoutWindowConfig{
+		Start:    String("0 0 18 * * FRI *"),
+		Duration: TimeDuration(62 * time.Hour),
+		TimeZone: String("America/New_York"),
+	}
+	mondayEnd := time.Date(2025, time.June, 16, 8, 0, 0, 0, ny)
+
+	// nightly window from 06:00 to 10:00 New York time, which overlaps the end
+	// of the weekend window on Monday
+	nightly := &BlackoutWindowConfig{
+		Start:    String("0 0 6 * * * *"),
+		Duration: TimeDuration(4 * time.Hour),
+		TimeZone: String("America/New_York"),
+	}
+
+	cases := []struct {
+		name   string
+		c      *BlackoutConfig
+		t      time.Time
+		active bool
+		end    time.Time
+	}{
+		{
+			"nil",
+			nil,
+			time.Date(2025, time.June, 14, 12, 0, 0, 0, ny),
+			false,
+			time.Time{},
+		},
+		{
+			"within_window",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			time.Date(2025, time.June, 14, 12, 0, 0, 0, ny),
+			true,
+			mondayEnd,
+		},
+		{
+			"window_start",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			time.Date(2025, time.June, 13, 18, 0, 0, 0, ny),
+			true,
+			mondayEnd,
+		},
+		{
+			"window_end",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			mondayEnd,
+			false,
+			time.Time{},
+		},
+		{
+			"other_time_zone",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			// 18:30 in New York
+			time.Date(2025, time.June, 13, 22, 30, 0, 0, time.UTC),
+			true,
+			mondayEnd,
+		},
+		{
+			"outside_window",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			time.Date(2025, time.June, 11, 12, 0, 0, 0, ny),
+			false,
+			time.Time{},
+		},
+		{
+			"disabled",
+			&BlackoutConfig{
+				Enabled: Bool(false),
+				Windows: []*BlackoutWindowConfig{weekend},
+			},
+			time.Date(2025, time.June, 14, 12, 0, 0, 0, ny),
+			false,
+			time.Time{},
+		},
+		{
+			"overlapping_windows",
+			&BlackoutConfig{
+				Enabled: Bool(true),
+				Windows: []*BlackoutWindowConfig{weekend, nightly},
+			},
+			time.Date(2025, time.June, 14, 12, 0, 0, 0, ny),
+			true,
+			time.Date(2025, time.June, 16, 10, 0, 0, 0, ny),
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			end, active := tc.c.Active(tc.t)
+			assert.Equal(t, tc.active, active)
+			assert.True(t, tc.end.Equal(end), "expected %s, got %s", tc.end, end)
+		})
+	}
+}
@@ -70,10 +70,26 @@ func conditionToTypeFunc() mapstructure.DecodeHookFunc {
 			var config ConsulKVConditionConfig
 			return decodeConditionToType(c, &config)
 		}
+		if c, ok := conditions[intentionsType]; ok {
+			var config IntentionsConditionConfig
+			return decodeConditionToType(c, &config)
+		}
+		if c, ok := conditions[nodesType]; ok {
+			var config NodesConditionConfig
+			return decodeConditionToType(c, &config)
+		}
+		if c, ok := conditions[healthChecksType]; ok {
+			var config HealthChecksConditionConfig
+			return decodeConditionToType(c, &config)
+		}
 		if c, ok := conditions[scheduleType]; ok {
 			var config ScheduleConditionConfig
 			return decodeConditionToType(c, &config)
 		}
+		if c, ok := conditions[compositeType]; ok {
+			var config CompositeConditionConfig
+			return decodeConditionToType(c, &config)
+		}
 
 		return nil, fmt.Errorf("unsupported condition type: %v", data)
 	}
@@ -88,6 +104,8 @@ func decodeConditionToType(data interface{}, monitor MonitorConfig) (MonitorConf
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			decode.HookWeakDecodeFromSlice,
+			// decodes the conditions of a composite condition
+			conditionToTypeFunc(),
 		),
 		WeaklyTypedInput: true,
 		ErrorUnused:      false,
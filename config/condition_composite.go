@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	compositeType = "composite"
+
+	// CompositeOperatorAnd triggers a composite condition when all of its
+	// conditions are met
+	CompositeOperatorAnd = "and"
+
+	// CompositeOperatorOr triggers a composite condition when any of its
+	// conditions is met
+	CompositeOperatorOr = "or"
+)
+
+var _ ConditionConfig = (*CompositeConditionConfig)(nil)
+
+// ConditionConfigs is a list of condition configurations. It is used to
+// configure the conditions of a composite condition.
+type ConditionConfigs []ConditionConfig
+
+// CompositeMonitorConfig exists purely to allow json / hcl conversions
+// to work seamlessly by encoding and decoding under the "composite" name.
+// It should not be treated as a standalone module input.
+type CompositeMonitorConfig struct {
+	// Operator is how the conditions are combined, either "and" or "or".
+	// Defaults to "and".
+	Operator *string `mapstructure:"operator" json:"operator"`
+
+	// Conditions are the conditions that are combined.
+	Conditions ConditionConfigs `mapstructure:"condition" json:"condition"`
+}
+
+// CompositeConditionConfig configures a condition configuration block of type
+// 'composite'. A composite condition combines other conditions:
+//   - with the "and" operator, the task is triggered once all of the
+//     conditions have been triggered since the task last ran. If one of the
+//     conditions is a schedule, the task is triggered on schedule only when all
+//     of the other conditions have been triggered since the task last ran.
+//   - with the "or" operator, the task is triggered by any of the conditions.
+type CompositeConditionConfig struct {
+	CompositeMonitorConfig `mapstructure:",squash" json:"composite"`
+}
+
+func (c *CompositeConditionConfig) VariableType() string {
+	return ""
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *CompositeConditionConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o CompositeConditionConfig
+	o.Operator = StringCopy(c.Operator)
+	o.Conditions = c.Conditions.Copy()
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *CompositeConditionConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*CompositeConditionConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*CompositeConditionConfig)
+
+	if o2.Operator != nil {
+		r2.Operator = StringCopy(o2.Operator)
+	}
+
+	if o2.Conditions != nil {
+		r2.Conditions = append(r2.Conditions, o2.Conditions.Copy()...)
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *CompositeConditionConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Operator == nil {
+		c.Operator = String(CompositeOperatorAnd)
+	}
+	*c.Operator = strings.ToLower(*c.Operator)
+
+	if c.Conditions == nil {
+		c.Conditions = ConditionConfigs{}
+	}
+
+	for _, cond := range c.Conditions {
+		if !isConditionNil(cond) {
+			cond.Finalize()
+		}
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *CompositeConditionConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("error validating `condition \"composite\"` block: %s",
+			err)
+	}
+	return nil
+}
+
+func (c *CompositeConditionConfig) validate() error {
+	switch StringVal(c.Operator) {
+	case CompositeOperatorAnd, CompositeOperatorOr:
+	default:
+		return fmt.Errorf("unsupported operator %q. operator must be %q or %q",
+			StringVal(c.Operator), CompositeOperatorAnd, CompositeOperatorOr)
+	}
+
+	if len(c.Conditions) < 2 {
+		return fmt.Errorf("at least two condition blocks must be configured")
+	}
+
+	var schedules int
+	varTypes := make(map[string]bool)
+	for _, cond := range c.Conditions {
+		switch cond.(type) {
+		case *CompositeConditionConfig:
+			return fmt.Errorf("composite conditions cannot be nested")
+		case *NoConditionConfig:
+			return fmt.Errorf("condition blocks must have a type")
+		case *ScheduleConditionConfig:
+			schedules++
+		}
+
+		if isConditionNil(cond) {
+			return fmt.Errorf("condition blocks cannot be empty")
+		}
+
+		if err := cond.Validate(); err != nil {
+			return err
+		}
+
+		// Condition variable types must be unique since they are rendered
+		// as module variables and their changes are attributed to each
+		// condition by their type
+		varType := cond.VariableType()
+		if varType == "" {
+			continue
+		}
+		if varTypes[varType] {
+			return fmt.Errorf("more than one condition block monitors the %q "+
+				"variable. variable types must be unique", varType)
+		}
+		varTypes[varType] = true
+	}
+
+	if schedules > 1 {
+		return fmt.Errorf("only one schedule condition block can be configured")
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *CompositeConditionConfig) GoString() string {
+	if c == nil {
+		return "(*CompositeConditionConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&CompositeConditionConfig{"+
+		"Operator:%s, "+
+		"Conditions:%s"+
+		"}",
+		StringVal(c.Operator),
+		c.Conditions.GoString(),
+	)
+}
+
+// Schedule returns the schedule condition of the composite condition. Returns
+// false if the composite condition has no schedule condition.
+func (c *CompositeConditionConfig) Schedule() (*ScheduleConditionConfig, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	for _, cond := range c.Conditions {
+		if s, ok := cond.(*ScheduleConditionConfig); ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Copy returns a deep copy of this configuration.
+func (c ConditionConfigs) Copy() ConditionConfigs {
+	if c == nil {
+		return nil
+	}
+
+	o := make(ConditionConfigs, 0, len(c))
+	for _, cond := range c {
+		if isConditionNil(cond) {
+			o = append(o, nil)
+			continue
+		}
+		o = append(o, cond.Copy())
+	}
+	return o
+}
+
+// GoString defines the printable version of this list.
+func (c ConditionConfigs) GoString() string {
+	s := make([]string, len(c))
+	for i, cond := range c {
+		if isConditionNil(cond) {
+			s[i] = "nil"
+			continue
+		}
+		s[i] = cond.GoString()
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// MarshalJSON encodes the conditions in their JSON configuration file format
+// so that they can be decoded, e.g. [{"services": {"names": ["api"]}}]
+func (c ConditionConfigs) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+
+	list := make([]interface{}, 0, len(c))
+	for _, cond := range c {
+		if isConditionNil(cond) {
+			continue
+		}
+		m, err := monitorToJSONMap(cond)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return json.Marshal(list)
+}
+
+// Conditions returns the conditions that a condition is composed of. For a
+// composite condition, these are its conditions. Otherwise, it is the condition
+// itself.
+func Conditions(c ConditionConfig) ConditionConfigs {
+	if isConditionNil(c) {
+		return nil
+	}
+
+	if cc, ok := c.(*CompositeConditionConfig); ok {
+		return cc.Conditions
+	}
+	return ConditionConfigs{c}
+}
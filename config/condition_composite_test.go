@@ -0,0 +1,521 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &CompositeConditionConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String(CompositeOperatorOr),
+					Conditions: ConditionConfigs{
+						&ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+								Path: String("key"),
+							},
+						},
+						&ScheduleConditionConfig{
+							ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+
+	t.Run("deep_copy", func(t *testing.T) {
+		a := &CompositeConditionConfig{
+			CompositeMonitorConfig{
+				Conditions: ConditionConfigs{
+					&ConsulKVConditionConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path: String("key"),
+						},
+					},
+				},
+			},
+		}
+		r := a.Copy().(*CompositeConditionConfig)
+		r.Conditions[0].(*ConsulKVConditionConfig).Path = String("changed")
+		assert.Equal(t, "key",
+			StringVal(a.Conditions[0].(*ConsulKVConditionConfig).Path))
+	})
+}
+
+func TestCompositeConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	kv := &ConsulKVConditionConfig{
+		ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+			Path: String("key"),
+		},
+	}
+	schedule := &ScheduleConditionConfig{
+		ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+	}
+
+	cases := []struct {
+		name string
+		a    *CompositeConditionConfig
+		b    *CompositeConditionConfig
+		r    *CompositeConditionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+		},
+		{
+			"nil_b",
+			&CompositeConditionConfig{},
+			nil,
+			&CompositeConditionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{},
+		},
+		{
+			"operator_overrides",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String(CompositeOperatorAnd)},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String(CompositeOperatorOr)},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String(CompositeOperatorOr)},
+			},
+		},
+		{
+			"operator_empty_two",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String(CompositeOperatorOr)},
+			},
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String(CompositeOperatorOr)},
+			},
+		},
+		{
+			"conditions_merged",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Conditions: ConditionConfigs{kv}},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Conditions: ConditionConfigs{schedule}},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Conditions: ConditionConfigs{kv, schedule}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *CompositeConditionConfig
+		r    *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&CompositeConditionConfig{},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{},
+				},
+			},
+		},
+		{
+			"operator_lowercased",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{Operator: String("OR")},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorOr),
+					Conditions: ConditionConfigs{},
+				},
+			},
+		},
+		{
+			"conditions_finalized",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Conditions: ConditionConfigs{
+						&ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+								Path: String("key"),
+							},
+						},
+					},
+				},
+			},
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{
+						&ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+								Path:       String("key"),
+								Recurse:    Bool(false),
+								Datacenter: String(""),
+								Namespace:  String(""),
+							},
+							UseAsModuleInput: Bool(true),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	kv := func() ConditionConfig {
+		return &ConsulKVConditionConfig{
+			ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+				Path: String("key"),
+			},
+		}
+	}
+	schedule := func() ConditionConfig {
+		return &ScheduleConditionConfig{
+			ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+		}
+	}
+	services := func() ConditionConfig {
+		return &ServicesConditionConfig{
+			ServicesMonitorConfig: ServicesMonitorConfig{
+				Names: []string{"api"},
+			},
+		}
+	}
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *CompositeConditionConfig
+	}{
+		{
+			"nil",
+			false,
+			nil,
+		},
+		{
+			"happy_path_and",
+			false,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv(), services()},
+				},
+			},
+		},
+		{
+			"happy_path_or_schedule",
+			false,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorOr),
+					Conditions: ConditionConfigs{kv(), schedule()},
+				},
+			},
+		},
+		{
+			"unsupported_operator",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String("xor"),
+					Conditions: ConditionConfigs{kv(), services()},
+				},
+			},
+		},
+		{
+			"one_condition",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv()},
+				},
+			},
+		},
+		{
+			"nested_composite",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{
+						kv(),
+						&CompositeConditionConfig{
+							CompositeMonitorConfig{
+								Operator:   String(CompositeOperatorOr),
+								Conditions: ConditionConfigs{services(), schedule()},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"no_condition_type",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv(), &NoConditionConfig{}},
+				},
+			},
+		},
+		{
+			"nil_condition",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv(), nil},
+				},
+			},
+		},
+		{
+			"invalid_condition",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{
+						kv(),
+						&ConsulKVConditionConfig{},
+					},
+				},
+			},
+		},
+		{
+			"duplicate_variable_type",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv(), kv()},
+				},
+			},
+		},
+		{
+			"multiple_schedules",
+			true,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator:   String(CompositeOperatorAnd),
+					Conditions: ConditionConfigs{kv(), schedule(), schedule()},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.c.Finalize()
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCompositeConditionConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *CompositeConditionConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"(*CompositeConditionConfig)(nil)",
+		},
+		{
+			"fully_configured",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String(CompositeOperatorOr),
+					Conditions: ConditionConfigs{
+						&ScheduleConditionConfig{
+							ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+						},
+						nil,
+					},
+				},
+			},
+			"&CompositeConditionConfig{Operator:or, Conditions:[" +
+				"&ScheduleConditionConfig{Cron:* * * * * * *, TimeZone:}, nil]}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.i.GoString()
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCompositeConditionConfig_Schedule(t *testing.T) {
+	t.Parallel()
+
+	schedule := &ScheduleConditionConfig{
+		ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+	}
+
+	t.Run("with_schedule", func(t *testing.T) {
+		c := &CompositeConditionConfig{
+			CompositeMonitorConfig{
+				Conditions: ConditionConfigs{&ConsulKVConditionConfig{}, schedule},
+			},
+		}
+		s, ok := c.Schedule()
+		assert.True(t, ok)
+		assert.Equal(t, schedule, s)
+	})
+
+	t.Run("without_schedule", func(t *testing.T) {
+		c := &CompositeConditionConfig{
+			CompositeMonitorConfig{
+				Conditions: ConditionConfigs{&ConsulKVConditionConfig{}},
+			},
+		}
+		_, ok := c.Schedule()
+		assert.False(t, ok)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var c *CompositeConditionConfig
+		_, ok := c.Schedule()
+		assert.False(t, ok)
+	})
+}
+
+func TestConditions(t *testing.T) {
+	t.Parallel()
+
+	kv := &ConsulKVConditionConfig{}
+	schedule := &ScheduleConditionConfig{}
+
+	cases := []struct {
+		name     string
+		c        ConditionConfig
+		expected ConditionConfigs
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"condition",
+			kv,
+			ConditionConfigs{kv},
+		},
+		{
+			"composite",
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Conditions: ConditionConfigs{kv, schedule},
+				},
+			},
+			ConditionConfigs{kv, schedule},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Conditions(tc.c))
+		})
+	}
+}
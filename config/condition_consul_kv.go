@@ -20,6 +20,10 @@ type ConsulKVConditionConfig struct {
 	// UseAsModuleInput was previously named SourceIncludesVar - deprecated v0.5
 	UseAsModuleInput            *bool `mapstructure:"use_as_module_input" json:"use_as_module_input"`
 	DeprecatedSourceIncludesVar *bool `mapstructure:"source_includes_var" json:"source_includes_var"`
+
+	// Predicate is optional. When configured, the task is only triggered when
+	// the value of a key-value changes and satisfies the predicate.
+	Predicate *ConsulKVPredicateConfig `mapstructure:"predicate" json:"predicate"`
 }
 
 // Copy returns a deep copy of this configuration.
@@ -31,6 +35,7 @@ func (c *ConsulKVConditionConfig) Copy() MonitorConfig {
 	var o ConsulKVConditionConfig
 	o.UseAsModuleInput = BoolCopy(c.UseAsModuleInput)
 	o.DeprecatedSourceIncludesVar = BoolCopy(c.DeprecatedSourceIncludesVar)
+	o.Predicate = c.Predicate.Copy()
 
 	m, ok := c.ConsulKVMonitorConfig.Copy().(*ConsulKVMonitorConfig)
 	if !ok {
@@ -70,6 +75,7 @@ func (c *ConsulKVConditionConfig) Merge(o MonitorConfig) MonitorConfig {
 	if o2.DeprecatedSourceIncludesVar != nil {
 		r2.DeprecatedSourceIncludesVar = BoolCopy(o2.DeprecatedSourceIncludesVar)
 	}
+	r2.Predicate = c.Predicate.Merge(o2.Predicate)
 
 	mm, ok := c.ConsulKVMonitorConfig.Merge(&o2.ConsulKVMonitorConfig).(*ConsulKVMonitorConfig)
 	if !ok {
@@ -80,7 +86,8 @@ func (c *ConsulKVConditionConfig) Merge(o MonitorConfig) MonitorConfig {
 	return r2
 }
 
-// Finalize ensures there no nil pointers.
+// Finalize ensures there no nil pointers with the _exception_ of Predicate,
+// which is optional.
 func (c *ConsulKVConditionConfig) Finalize() {
 	if c == nil { // config not required, return early
 		return
@@ -112,7 +119,16 @@ func (c *ConsulKVConditionConfig) Validate() error {
 		return nil
 	}
 
-	return c.ConsulKVMonitorConfig.Validate()
+	if err := c.ConsulKVMonitorConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Predicate.Validate(); err != nil {
+		return fmt.Errorf("error validating `condition \"consul-kv\"` "+
+			"block: %s", err)
+	}
+
+	return nil
 }
 
 // GoString defines the printable version of this struct.
@@ -123,9 +139,11 @@ func (c *ConsulKVConditionConfig) GoString() string {
 
 	return fmt.Sprintf("&ConsulKVConditionConfig{"+
 		"%s, "+
-		"UseAsModuleInput:%v"+
+		"UseAsModuleInput:%v, "+
+		"Predicate:%s"+
 		"}",
 		c.ConsulKVMonitorConfig.GoString(),
 		BoolVal(c.UseAsModuleInput),
+		c.Predicate.GoString(),
 	)
 }
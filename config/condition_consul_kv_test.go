@@ -42,6 +42,9 @@ func TestConsulKVConditionConfig_Copy(t *testing.T) {
 				},
 				UseAsModuleInput:            Bool(true),
 				DeprecatedSourceIncludesVar: Bool(true),
+				Predicate: &ConsulKVPredicateConfig{
+					Equals: String("enabled"),
+				},
 			},
 		},
 	}
@@ -352,6 +355,28 @@ func TestConsulKVConditionConfig_Validate(t *testing.T) {
 			true,
 			&ConsulKVConditionConfig{},
 		},
+		{
+			"valid_predicate",
+			false,
+			&ConsulKVConditionConfig{
+				ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+					Path: String("config/feature-x"),
+				},
+				Predicate: &ConsulKVPredicateConfig{
+					Equals: String("enabled"),
+				},
+			},
+		},
+		{
+			"invalid_predicate",
+			true,
+			&ConsulKVConditionConfig{
+				ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+					Path: String("config/feature-x"),
+				},
+				Predicate: &ConsulKVPredicateConfig{},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const healthChecksType = "health-checks"
+
+var _ ConditionConfig = (*HealthChecksConditionConfig)(nil)
+
+// HealthChecksMonitorConfig configures the services whose health is monitored
+// by a condition of type 'health-checks'. It exists to allow json / hcl
+// conversions to work seamlessly by encoding and decoding under the
+// "health-checks" name.
+type HealthChecksMonitorConfig struct {
+	ServicesMonitorConfig `mapstructure:",squash"`
+
+	// CriticalThreshold is the percentage of a service's instances that must
+	// be critical for the service to be considered failed. When configured,
+	// the condition is only triggered when a service crosses the threshold
+	// instead of on every health check status transition. When unset, it
+	// will retain a nil value even after Finalize().
+	CriticalThreshold *int `mapstructure:"critical_threshold" json:"critical_threshold"`
+}
+
+// HealthChecksConditionConfig configures a condition configuration block of
+// type 'health-checks'. A health-checks condition is triggered when the
+// aggregate status of an instance of the task's services or the status of one
+// of its health checks transitions, e.g. passing to critical. Changes to other
+// service instance information do not trigger the condition.
+type HealthChecksConditionConfig struct {
+	HealthChecksMonitorConfig `mapstructure:",squash" json:"health-checks"`
+
+	UseAsModuleInput *bool `mapstructure:"use_as_module_input" json:"use_as_module_input"`
+}
+
+// VariableType returns "services" since the condition monitors, and can
+// render, the services variable
+func (c *HealthChecksConditionConfig) VariableType() string {
+	return servicesType
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *HealthChecksConditionConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o HealthChecksConditionConfig
+	o.CriticalThreshold = IntCopy(c.CriticalThreshold)
+	o.UseAsModuleInput = BoolCopy(c.UseAsModuleInput)
+
+	svc, ok := c.ServicesMonitorConfig.Copy().(*ServicesMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	o.ServicesMonitorConfig = *svc
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *HealthChecksConditionConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*HealthChecksConditionConfig)
+	if !ok {
+		return nil
+	}
+
+	r2 := r.(*HealthChecksConditionConfig)
+
+	if o2.CriticalThreshold != nil {
+		r2.CriticalThreshold = IntCopy(o2.CriticalThreshold)
+	}
+	if o2.UseAsModuleInput != nil {
+		r2.UseAsModuleInput = BoolCopy(o2.UseAsModuleInput)
+	}
+
+	merged, ok := c.ServicesMonitorConfig.Merge(&o2.ServicesMonitorConfig).(*ServicesMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	r2.ServicesMonitorConfig = *merged
+	return r2
+}
+
+// Finalize ensures there no nil pointers with the _exception_ of Regexp and
+// CriticalThreshold. There is a need to distinguish between an unconfigured
+// threshold and a threshold of 0 (any critical instance).
+func (c *HealthChecksConditionConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.UseAsModuleInput == nil {
+		c.UseAsModuleInput = Bool(true)
+	}
+
+	c.ServicesMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *HealthChecksConditionConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.ServicesMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `condition \"health-checks\"` "+
+			"block: %s", err)
+	}
+
+	// a single query for all of the services is used to compare health across
+	// services, which does not aggregate multiple sources
+	if len(c.Datacenters) > 0 || len(c.Peers) > 0 {
+		return fmt.Errorf("error validating `condition \"health-checks\"` " +
+			"block: datacenters and peers fields are not supported")
+	}
+
+	// the condition is triggered by changes to the health of the instances
+	if c.SignificantFields != nil {
+		return fmt.Errorf("error validating `condition \"health-checks\"` " +
+			"block: significant_fields field is not supported")
+	}
+
+	if c.CriticalThreshold != nil {
+		if t := *c.CriticalThreshold; t < 0 || t >= 100 {
+			return fmt.Errorf("error validating `condition \"health-checks\"` "+
+				"block: critical_threshold must be a percentage between 0 and "+
+				"99, got %d", t)
+		}
+	}
+
+	return nil
+}
+
+// ServicesRegexp returns the regular expression matching the names of the
+// monitored services. The configured names are converted to a regular
+// expression that only matches the exact names.
+func (c *HealthChecksConditionConfig) ServicesRegexp() string {
+	if c.Regexp != nil {
+		return *c.Regexp
+	}
+
+	names := make([]string, len(c.Names))
+	for ix, name := range c.Names {
+		names[ix] = regexp.QuoteMeta(name)
+	}
+	return fmt.Sprintf("^(?:%s)$", strings.Join(names, "|"))
+}
+
+// GoString defines the printable version of this struct.
+func (c *HealthChecksConditionConfig) GoString() string {
+	if c == nil {
+		return "(*HealthChecksConditionConfig)(nil)"
+	}
+
+	threshold := "<nil>"
+	if c.CriticalThreshold != nil {
+		threshold = fmt.Sprintf("%d", *c.CriticalThreshold)
+	}
+
+	return fmt.Sprintf("&HealthChecksConditionConfig{"+
+		"%s, "+
+		"CriticalThreshold:%s, "+
+		"UseAsModuleInput:%v"+
+		"}",
+		c.ServicesMonitorConfig.GoString(),
+		threshold,
+		BoolVal(c.UseAsModuleInput),
+	)
+}
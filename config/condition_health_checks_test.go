@@ -0,0 +1,416 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthChecksConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &HealthChecksConditionConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *HealthChecksConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&HealthChecksConditionConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"happy_path",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names:      []string{"api"},
+						Datacenter: String("dc"),
+						Namespace:  String("namespace"),
+						Filter:     String("filter"),
+						CTSUserDefinedMeta: map[string]string{
+							"key": "value",
+						},
+					},
+					CriticalThreshold: Int(50),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestHealthChecksConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *HealthChecksConditionConfig
+		b    *HealthChecksConditionConfig
+		r    *HealthChecksConditionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&HealthChecksConditionConfig{},
+			&HealthChecksConditionConfig{},
+		},
+		{
+			"nil_b",
+			&HealthChecksConditionConfig{},
+			nil,
+			&HealthChecksConditionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&HealthChecksConditionConfig{},
+			&HealthChecksConditionConfig{},
+			&HealthChecksConditionConfig{},
+		},
+		{
+			"critical_threshold_overrides",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					CriticalThreshold: Int(10),
+				},
+			},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					CriticalThreshold: Int(0),
+				},
+			},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					CriticalThreshold: Int(0),
+				},
+			},
+		},
+		{
+			"critical_threshold_empty_two",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					CriticalThreshold: Int(10),
+				},
+			},
+			&HealthChecksConditionConfig{},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					CriticalThreshold: Int(10),
+				},
+			},
+		},
+		{
+			"use_as_module_input_overrides",
+			&HealthChecksConditionConfig{UseAsModuleInput: Bool(true)},
+			&HealthChecksConditionConfig{UseAsModuleInput: Bool(false)},
+			&HealthChecksConditionConfig{UseAsModuleInput: Bool(false)},
+		},
+		{
+			"names_merged",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+			},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"web"},
+					},
+				},
+			},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api", "web"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestHealthChecksConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *HealthChecksConditionConfig
+		r    *HealthChecksConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&HealthChecksConditionConfig{},
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Regexp:             nil,
+						Names:              []string{},
+						Datacenter:         String(""),
+						Namespace:          String(""),
+						Filter:             String(""),
+						CTSUserDefinedMeta: map[string]string{},
+					},
+					CriticalThreshold: nil,
+				},
+				UseAsModuleInput: Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestHealthChecksConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *HealthChecksConditionConfig
+	}{
+		{
+			"valid",
+			false,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+					CriticalThreshold: Int(0),
+				},
+			},
+		},
+		{
+			"invalid_services",
+			true,
+			&HealthChecksConditionConfig{},
+		},
+		{
+			"peers_unsupported",
+			true,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+						Peers: []string{"peer-a"},
+					},
+				},
+			},
+		},
+		{
+			"significant_fields_unsupported",
+			true,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names:             []string{"api"},
+						SignificantFields: []string{"status"},
+					},
+				},
+			},
+		},
+		{
+			"negative_critical_threshold",
+			true,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+					CriticalThreshold: Int(-1),
+				},
+			},
+		},
+		{
+			"critical_threshold_100",
+			true,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Regexp: String(".*"),
+					},
+					CriticalThreshold: Int(100),
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHealthChecksConditionConfig_ServicesRegexp(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		c        *HealthChecksConditionConfig
+		expected string
+		matches  []string
+		misses   []string
+	}{
+		{
+			"regexp",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Regexp: String("^web.*"),
+					},
+				},
+			},
+			"^web.*",
+			[]string{"web", "web-api"},
+			[]string{"api"},
+		},
+		{
+			"names",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api", "web"},
+					},
+				},
+			},
+			"^(?:api|web)$",
+			[]string{"api", "web"},
+			[]string{"api-v2", "webapi", "db"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.ServicesRegexp()
+			assert.Equal(t, tc.expected, actual)
+
+			re, err := regexp.Compile(actual)
+			require.NoError(t, err)
+			for _, name := range tc.matches {
+				assert.True(t, re.MatchString(name), name)
+			}
+			for _, name := range tc.misses {
+				assert.False(t, re.MatchString(name), name)
+			}
+		})
+	}
+}
+
+func TestHealthChecksCondition_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *HealthChecksConditionConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"(*HealthChecksConditionConfig)(nil)",
+		},
+		{
+			"fully_configured",
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names:      []string{"api"},
+						Datacenter: String("dc"),
+						Namespace:  String("namespace"),
+						Filter:     String("filter"),
+					},
+					CriticalThreshold: Int(50),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"&HealthChecksConditionConfig{&ServicesMonitorConfig{Regexp:, " +
+				"Names:[api], Datacenter:dc, Datacenters:[], Peers:[], " +
+				"Namespace:namespace, Filter:filter, " +
+				"CTSUserDefinedMeta:map[], SignificantFields:[]}, CriticalThreshold:50, " +
+				"UseAsModuleInput:false}",
+		},
+		{
+			"no_critical_threshold",
+			&HealthChecksConditionConfig{},
+			"&HealthChecksConditionConfig{&ServicesMonitorConfig{Regexp:, " +
+				"Names:[], Datacenter:, Datacenters:[], Peers:[], Namespace:, Filter:, " +
+				"CTSUserDefinedMeta:map[], SignificantFields:[]}, CriticalThreshold:<nil>, " +
+				"UseAsModuleInput:false}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.i.GoString()
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
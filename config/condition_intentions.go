@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+var _ ConditionConfig = (*IntentionsConditionConfig)(nil)
+
+// IntentionsConditionConfig configures a condition configuration block of
+// type 'intentions'. An intentions condition is triggered by changes that
+// occur to the Consul intentions of the configured source and destination
+// services.
+type IntentionsConditionConfig struct {
+	IntentionsMonitorConfig `mapstructure:",squash" json:"intentions"`
+
+	UseAsModuleInput *bool `mapstructure:"use_as_module_input" json:"use_as_module_input"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *IntentionsConditionConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o IntentionsConditionConfig
+	o.UseAsModuleInput = BoolCopy(c.UseAsModuleInput)
+
+	m, ok := c.IntentionsMonitorConfig.Copy().(*IntentionsMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	o.IntentionsMonitorConfig = *m
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *IntentionsConditionConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*IntentionsConditionConfig)
+	if !ok {
+		return nil
+	}
+
+	r2 := r.(*IntentionsConditionConfig)
+
+	if o2.UseAsModuleInput != nil {
+		r2.UseAsModuleInput = BoolCopy(o2.UseAsModuleInput)
+	}
+
+	mm, ok := c.IntentionsMonitorConfig.Merge(&o2.IntentionsMonitorConfig).(*IntentionsMonitorConfig)
+	if !ok {
+		return nil
+	}
+	r2.IntentionsMonitorConfig = *mm
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *IntentionsConditionConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.UseAsModuleInput == nil {
+		c.UseAsModuleInput = Bool(true)
+	}
+
+	c.IntentionsMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *IntentionsConditionConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.IntentionsMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `condition \"intentions\"` block: %s",
+			err)
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *IntentionsConditionConfig) GoString() string {
+	if c == nil {
+		return "(*IntentionsConditionConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&IntentionsConditionConfig{"+
+		"%s, "+
+		"UseAsModuleInput:%v"+
+		"}",
+		c.IntentionsMonitorConfig.GoString(),
+		BoolVal(c.UseAsModuleInput),
+	)
+}
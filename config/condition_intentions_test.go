@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntentionsConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &IntentionsConditionConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *IntentionsConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsConditionConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter:          String("dc"),
+					Namespace:           String("namespace"),
+					SourceServices:      []string{"api"},
+					DestinationServices: []string{"db"},
+				},
+				UseAsModuleInput: Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *IntentionsConditionConfig
+		b    *IntentionsConditionConfig
+		r    *IntentionsConditionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&IntentionsConditionConfig{},
+			&IntentionsConditionConfig{},
+		},
+		{
+			"nil_b",
+			&IntentionsConditionConfig{},
+			nil,
+			&IntentionsConditionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsConditionConfig{},
+			&IntentionsConditionConfig{},
+			&IntentionsConditionConfig{},
+		},
+		{
+			"datacenter_overrides",
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter: String("dc1"),
+				},
+			},
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+		},
+		{
+			"services_merged",
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					SourceServices:      []string{"api"},
+					DestinationServices: []string{"db"},
+				},
+			},
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					SourceServices:      []string{"web"},
+					DestinationServices: []string{"db", "cache"},
+				},
+			},
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					SourceServices:      []string{"api", "web"},
+					DestinationServices: []string{"db", "cache"},
+				},
+			},
+		},
+		{
+			"use_as_module_input_overrides",
+			&IntentionsConditionConfig{UseAsModuleInput: Bool(true)},
+			&IntentionsConditionConfig{UseAsModuleInput: Bool(false)},
+			&IntentionsConditionConfig{UseAsModuleInput: Bool(false)},
+		},
+		{
+			"use_as_module_input_empty_two",
+			&IntentionsConditionConfig{UseAsModuleInput: Bool(false)},
+			&IntentionsConditionConfig{},
+			&IntentionsConditionConfig{UseAsModuleInput: Bool(false)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *IntentionsConditionConfig
+		r    *IntentionsConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsConditionConfig{},
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter:          String(""),
+					Namespace:           String(""),
+					SourceServices:      []string{},
+					DestinationServices: []string{},
+				},
+				UseAsModuleInput: Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestIntentionsConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *IntentionsConditionConfig
+	}{
+		{
+			"source_services",
+			false,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					SourceServices: []string{"api"},
+				},
+			},
+		},
+		{
+			"destination_services",
+			false,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					DestinationServices: []string{"db"},
+				},
+			},
+		},
+		{
+			"no_services",
+			true,
+			&IntentionsConditionConfig{},
+		},
+		{
+			"empty_source_service",
+			true,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					SourceServices: []string{"api", ""},
+				},
+			},
+		},
+		{
+			"empty_destination_service",
+			true,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					DestinationServices: []string{""},
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntentionsConditionConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *IntentionsConditionConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"(*IntentionsConditionConfig)(nil)",
+		},
+		{
+			"fully_configured",
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter:          String("dc"),
+					Namespace:           String("namespace"),
+					SourceServices:      []string{"api", "web"},
+					DestinationServices: []string{"db"},
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"&IntentionsConditionConfig{&IntentionsMonitorConfig{" +
+				"Datacenter:dc, Namespace:namespace, SourceServices:[api web], " +
+				"DestinationServices:[db]}, UseAsModuleInput:false}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.i.GoString()
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+var _ ConditionConfig = (*NodesConditionConfig)(nil)
+
+// NodesConditionConfig configures a condition configuration block of
+// type 'nodes'. A nodes condition is triggered by changes that occur to the
+// nodes registered in the Consul catalog, e.g. a node registering or
+// deregistering or a change to its address or metadata.
+type NodesConditionConfig struct {
+	NodesMonitorConfig `mapstructure:",squash" json:"nodes"`
+
+	UseAsModuleInput *bool `mapstructure:"use_as_module_input" json:"use_as_module_input"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *NodesConditionConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o NodesConditionConfig
+	o.UseAsModuleInput = BoolCopy(c.UseAsModuleInput)
+
+	m, ok := c.NodesMonitorConfig.Copy().(*NodesMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	o.NodesMonitorConfig = *m
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *NodesConditionConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*NodesConditionConfig)
+	if !ok {
+		return nil
+	}
+
+	r2 := r.(*NodesConditionConfig)
+
+	if o2.UseAsModuleInput != nil {
+		r2.UseAsModuleInput = BoolCopy(o2.UseAsModuleInput)
+	}
+
+	mm, ok := c.NodesMonitorConfig.Merge(&o2.NodesMonitorConfig).(*NodesMonitorConfig)
+	if !ok {
+		return nil
+	}
+	r2.NodesMonitorConfig = *mm
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *NodesConditionConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.UseAsModuleInput == nil {
+		c.UseAsModuleInput = Bool(true)
+	}
+
+	c.NodesMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *NodesConditionConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.NodesMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `condition \"nodes\"` block: %s",
+			err)
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *NodesConditionConfig) GoString() string {
+	if c == nil {
+		return "(*NodesConditionConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&NodesConditionConfig{"+
+		"%s, "+
+		"UseAsModuleInput:%v"+
+		"}",
+		c.NodesMonitorConfig.GoString(),
+		BoolVal(c.UseAsModuleInput),
+	)
+}
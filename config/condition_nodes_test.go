@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesConditionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &NodesConditionConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *NodesConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&NodesConditionConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc"),
+					NodeMeta:   map[string]string{"key": "value"},
+					Filter:     String("filter"),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestNodesConditionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NodesConditionConfig
+		b    *NodesConditionConfig
+		r    *NodesConditionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&NodesConditionConfig{},
+			&NodesConditionConfig{},
+		},
+		{
+			"nil_b",
+			&NodesConditionConfig{},
+			nil,
+			&NodesConditionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&NodesConditionConfig{},
+			&NodesConditionConfig{},
+			&NodesConditionConfig{},
+		},
+		{
+			"datacenter_overrides",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc1"),
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+		},
+		{
+			"filter_overrides",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Filter: String("filter1"),
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Filter: String("filter2"),
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Filter: String("filter2"),
+				},
+			},
+		},
+		{
+			"node_meta_merged",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					NodeMeta: map[string]string{"foo": "bar", "key": "a"},
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					NodeMeta: map[string]string{"key": "b"},
+				},
+			},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					NodeMeta: map[string]string{"foo": "bar", "key": "b"},
+				},
+			},
+		},
+		{
+			"use_as_module_input_overrides",
+			&NodesConditionConfig{UseAsModuleInput: Bool(true)},
+			&NodesConditionConfig{UseAsModuleInput: Bool(false)},
+			&NodesConditionConfig{UseAsModuleInput: Bool(false)},
+		},
+		{
+			"use_as_module_input_empty_two",
+			&NodesConditionConfig{UseAsModuleInput: Bool(false)},
+			&NodesConditionConfig{},
+			&NodesConditionConfig{UseAsModuleInput: Bool(false)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestNodesConditionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *NodesConditionConfig
+		r    *NodesConditionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&NodesConditionConfig{},
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String(""),
+					NodeMeta:   map[string]string{},
+					Filter:     String(""),
+				},
+				UseAsModuleInput: Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestNodesConditionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *NodesConditionConfig
+	}{
+		{
+			"empty",
+			false,
+			&NodesConditionConfig{},
+		},
+		{
+			"fully_configured",
+			false,
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc"),
+					NodeMeta:   map[string]string{"key": "value"},
+					Filter:     String("filter"),
+				},
+			},
+		},
+		{
+			"empty_node_meta_key",
+			true,
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					NodeMeta: map[string]string{"": "value"},
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNodesConditionConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *NodesConditionConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"(*NodesConditionConfig)(nil)",
+		},
+		{
+			"fully_configured",
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc"),
+					NodeMeta:   map[string]string{"key": "value"},
+					Filter:     String("filter"),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"&NodesConditionConfig{&NodesMonitorConfig{" +
+				"Datacenter:dc, NodeMeta:map[key:value], Filter:filter}, " +
+				"UseAsModuleInput:false}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.i.GoString()
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
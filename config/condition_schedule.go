@@ -5,12 +5,17 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/cronexpr"
 )
 
 const scheduleType = "schedule"
 
+// DefaultScheduleTimeZone is the default time zone that the cron expression of
+// a schedule condition is evaluated in, which is the local time zone of CTS
+const DefaultScheduleTimeZone = "Local"
+
 var _ ConditionConfig = (*ScheduleConditionConfig)(nil)
 
 // ScheduleMonitorConfig exists purely to allow json / hcl conversions
@@ -18,6 +23,10 @@ var _ ConditionConfig = (*ScheduleConditionConfig)(nil)
 // It should not be treated as a standalone module input.
 type ScheduleMonitorConfig struct {
 	Cron *string `mapstructure:"cron" json:"cron"`
+
+	// TimeZone is the IANA time zone that the cron expression is evaluated in,
+	// e.g. "America/New_York". Defaults to the local time zone of CTS.
+	TimeZone *string `mapstructure:"time_zone" json:"time_zone"`
 }
 
 // ScheduleConditionConfig configures a condition configuration block of type
@@ -38,6 +47,7 @@ func (c *ScheduleConditionConfig) Copy() MonitorConfig {
 
 	var o ScheduleConditionConfig
 	o.Cron = StringCopy(c.Cron)
+	o.TimeZone = StringCopy(c.TimeZone)
 
 	return &o
 }
@@ -68,6 +78,10 @@ func (c *ScheduleConditionConfig) Merge(o MonitorConfig) MonitorConfig {
 		r2.Cron = StringCopy(o2.Cron)
 	}
 
+	if o2.TimeZone != nil {
+		r2.TimeZone = StringCopy(o2.TimeZone)
+	}
+
 	return r2
 }
 
@@ -80,6 +94,10 @@ func (c *ScheduleConditionConfig) Finalize() {
 	if c.Cron == nil {
 		c.Cron = String("")
 	}
+
+	if c.TimeZone == nil {
+		c.TimeZone = String(DefaultScheduleTimeZone)
+	}
 }
 
 // Validate validates the values and required options. This method is recommended
@@ -99,6 +117,11 @@ func (c *ScheduleConditionConfig) Validate() error {
 			StringVal(c.Cron), err, "https://github.com/hashicorp/cronexpr")
 	}
 
+	if _, err := c.Location(); err != nil {
+		return fmt.Errorf("unable to load schedule condition's time_zone "+
+			"%q: %s", StringVal(c.TimeZone), err)
+	}
+
 	return nil
 }
 
@@ -110,7 +133,18 @@ func (c *ScheduleConditionConfig) GoString() string {
 
 	return fmt.Sprintf("&ScheduleConditionConfig{"+
 		"Cron:%s, "+
+		"TimeZone:%s"+
 		"}",
 		StringVal(c.Cron),
+		StringVal(c.TimeZone),
 	)
 }
+
+// Location returns the time zone that the cron expression is evaluated in.
+// Returns the local time zone if the time zone is not configured.
+func (c *ScheduleConditionConfig) Location() (*time.Location, error) {
+	if c == nil || StringVal(c.TimeZone) == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(*c.TimeZone)
+}
@@ -5,8 +5,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestScheduleConditionConfig_Copy(t *testing.T) {
@@ -35,7 +37,8 @@ func TestScheduleConditionConfig_Copy(t *testing.T) {
 			"fully_configured",
 			&ScheduleConditionConfig{
 				ScheduleMonitorConfig: ScheduleMonitorConfig{
-					Cron: String("* * * * * * *"),
+					Cron:     String("* * * * * * *"),
+					TimeZone: String("America/New_York"),
 				},
 			},
 		},
@@ -151,6 +154,38 @@ func TestScheduleConditionConfig_Merge(t *testing.T) {
 				},
 			},
 		},
+		{
+			"time_zone_overrides",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("UTC"),
+				},
+			},
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("America/New_York"),
+				},
+			},
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("America/New_York"),
+				},
+			},
+		},
+		{
+			"time_zone_empty_two",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("UTC"),
+				},
+			},
+			&ScheduleConditionConfig{},
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("UTC"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -184,7 +219,8 @@ func TestScheduleConditionConfig_Finalize(t *testing.T) {
 			&ScheduleConditionConfig{},
 			&ScheduleConditionConfig{
 				ScheduleMonitorConfig: ScheduleMonitorConfig{
-					Cron: String(""),
+					Cron:     String(""),
+					TimeZone: String(DefaultScheduleTimeZone),
 				},
 			},
 		},
@@ -197,7 +233,23 @@ func TestScheduleConditionConfig_Finalize(t *testing.T) {
 			},
 			&ScheduleConditionConfig{
 				ScheduleMonitorConfig: ScheduleMonitorConfig{
-					Cron: String("* * * * *"),
+					Cron:     String("* * * * *"),
+					TimeZone: String(DefaultScheduleTimeZone),
+				},
+			},
+		},
+		{
+			"time_zone_configured",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					Cron:     String("* * * * *"),
+					TimeZone: String("America/New_York"),
+				},
+			},
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					Cron:     String("* * * * *"),
+					TimeZone: String("America/New_York"),
 				},
 			},
 		},
@@ -233,11 +285,31 @@ func TestScheduleConditionConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			"valid_time_zone",
+			false,
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					Cron:     String("* * * * * * *"),
+					TimeZone: String("America/New_York"),
+				},
+			},
+		},
 		{
 			"nil_cron",
 			true,
 			&ScheduleConditionConfig{},
 		},
+		{
+			"invalid_time_zone",
+			true,
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					Cron:     String("* * * * * * *"),
+					TimeZone: String("Mars/Olympus_Mons"),
+				},
+			},
+		},
 		{
 			"invalid_cron",
 			true,
@@ -260,3 +332,72 @@ func TestScheduleConditionConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleConditionConfig_Location(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		c         *ScheduleConditionConfig
+		expected  *time.Location
+		expectErr bool
+	}{
+		{
+			"nil",
+			nil,
+			time.Local,
+			false,
+		},
+		{
+			"unconfigured",
+			&ScheduleConditionConfig{},
+			time.Local,
+			false,
+		},
+		{
+			"local",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String(DefaultScheduleTimeZone),
+				},
+			},
+			time.Local,
+			false,
+		},
+		{
+			"time_zone",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("America/New_York"),
+				},
+			},
+			ny,
+			false,
+		},
+		{
+			"invalid_time_zone",
+			&ScheduleConditionConfig{
+				ScheduleMonitorConfig: ScheduleMonitorConfig{
+					TimeZone: String("Mars/Olympus_Mons"),
+				},
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			loc, err := tc.c.Location()
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.String(), loc.String())
+		})
+	}
+}
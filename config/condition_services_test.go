@@ -349,8 +349,8 @@ func TestServicesCondition_GoString(t *testing.T) {
 				UseAsModuleInput: Bool(false),
 			},
 			"&ServicesConditionConfig{&ServicesMonitorConfig{Regexp:^api$, Names:[], " +
-				"Datacenter:dc, Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}, UseAsModuleInput:false}",
+				"Datacenter:dc, Datacenters:[], Peers:[], Namespace:namespace, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], SignificantFields:[]}, UseAsModuleInput:false}",
 		},
 	}
 
@@ -92,6 +92,31 @@ task {
 			key = "value"
 		}
 	}
+}`,
+		},
+		{
+			"services: significant fields",
+			false,
+			&ServicesConditionConfig{
+				ServicesMonitorConfig: ServicesMonitorConfig{
+					Names:              []string{"api"},
+					Datacenter:         String(""),
+					Namespace:          String(""),
+					Filter:             String(""),
+					CTSUserDefinedMeta: map[string]string{},
+					SignificantFields:  []string{"address", "port"},
+				},
+				UseAsModuleInput: Bool(true),
+			},
+			"config.hcl",
+			`
+task {
+	name = "services_condition_task"
+	module = "..."
+	condition "services" {
+		names = ["api"]
+		significant_fields = ["address", "port"]
+	}
 }`,
 		},
 		{
@@ -106,6 +131,234 @@ task {
 	condition "services" {
 		nonexistent_field = true
 	}
+}`,
+		},
+		{
+			"intentions: happy path",
+			false,
+			&IntentionsConditionConfig{
+				IntentionsMonitorConfig: IntentionsMonitorConfig{
+					Datacenter:          String("dc"),
+					Namespace:           String("namespace"),
+					SourceServices:      []string{"api", "web"},
+					DestinationServices: []string{},
+				},
+				UseAsModuleInput: Bool(true),
+			},
+			"config.hcl",
+			`
+task {
+	name = "intentions_condition_task"
+	module = "..."
+	condition "intentions" {
+		datacenter = "dc"
+		namespace = "namespace"
+		source_services = ["api", "web"]
+	}
+}`,
+		},
+		{
+			"intentions: unsupported field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "intentions" {
+		nonexistent_field = true
+	}
+}`,
+		},
+		{
+			"nodes: happy path",
+			false,
+			&NodesConditionConfig{
+				NodesMonitorConfig: NodesMonitorConfig{
+					Datacenter: String("dc"),
+					NodeMeta:   map[string]string{"rack": "rack-1"},
+					Filter:     String("Node != \"worker-01\""),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"config.hcl",
+			`
+task {
+	name = "nodes_condition_task"
+	module = "..."
+	condition "nodes" {
+		datacenter = "dc"
+		node_meta {
+			rack = "rack-1"
+		}
+		filter = "Node != \"worker-01\""
+		use_as_module_input = false
+	}
+}`,
+		},
+		{
+			"nodes: unsupported field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "nodes" {
+		nonexistent_field = true
+	}
+}`,
+		},
+		{
+			"composite: happy path",
+			false,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String("and"),
+					Conditions: ConditionConfigs{
+						&ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+								Path:       String("key-path"),
+								Recurse:    Bool(false),
+								Datacenter: String(""),
+								Namespace:  String(""),
+							},
+							UseAsModuleInput: Bool(true),
+						},
+						&ScheduleConditionConfig{
+							ScheduleMonitorConfig{
+								Cron:     String("0 2 * * *"),
+								TimeZone: String(DefaultScheduleTimeZone),
+							},
+						},
+					},
+				},
+			},
+			"config.hcl",
+			`
+task {
+	name = "composite_condition_task"
+	module = "..."
+	condition "composite" {
+		operator = "AND"
+		condition "consul-kv" {
+			path = "key-path"
+		}
+		condition "schedule" {
+			cron = "0 2 * * *"
+		}
+	}
+}`,
+		},
+		{
+			"composite: json",
+			false,
+			&CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Operator: String("or"),
+					Conditions: ConditionConfigs{
+						&ConsulKVConditionConfig{
+							ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+								Path:       String("key-path"),
+								Recurse:    Bool(false),
+								Datacenter: String(""),
+								Namespace:  String(""),
+							},
+							UseAsModuleInput: Bool(true),
+						},
+						&CatalogServicesConditionConfig{
+							CatalogServicesMonitorConfig{
+								Regexp:           String(".*"),
+								UseAsModuleInput: Bool(false),
+								Datacenter:       String(""),
+								Namespace:        String(""),
+								NodeMeta:         map[string]string{},
+							},
+						},
+					},
+				},
+			},
+			"config.json",
+			`{
+	"task": [{
+		"name": "composite_condition_task",
+		"module": "...",
+		"condition": {
+			"composite": {
+				"operator": "or",
+				"condition": [
+					{"consul-kv": {"path": "key-path"}},
+					{"catalog-services": {"regexp": ".*", "use_as_module_input": false}}
+				]
+			}
+		}
+	}]
+}`,
+		},
+		{
+			"composite: unsupported nested field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "composite" {
+		condition "consul-kv" {
+			nonexistent_field = true
+		}
+		condition "schedule" {
+			cron = "0 2 * * *"
+		}
+	}
+}`,
+		},
+		{
+			"health-checks: happy path",
+			false,
+			&HealthChecksConditionConfig{
+				HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names:              []string{"api", "web"},
+						Datacenter:         String("dc"),
+						Namespace:          String("namespace"),
+						Filter:             String("filter"),
+						CTSUserDefinedMeta: map[string]string{},
+					},
+					CriticalThreshold: Int(50),
+				},
+				UseAsModuleInput: Bool(false),
+			},
+			"config.hcl",
+			`
+task {
+	name = "health_checks_condition_task"
+	module = "..."
+	condition "health-checks" {
+		names = ["api", "web"]
+		datacenter = "dc"
+		namespace = "namespace"
+		filter = "filter"
+		critical_threshold = 50
+		use_as_module_input = false
+	}
+}`,
+		},
+		{
+			"health-checks: unsupported field",
+			true,
+			nil,
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "health-checks" {
+		nonexistent_field = true
+	}
 }`,
 		},
 		{
@@ -113,7 +366,8 @@ task {
 			false,
 			&ScheduleConditionConfig{
 				ScheduleMonitorConfig: ScheduleMonitorConfig{
-					Cron: String("* * * * * * *"),
+					Cron:     String("* * * * * * *"),
+					TimeZone: String("America/New_York"),
 				},
 			},
 			"config.hcl",
@@ -123,6 +377,7 @@ task {
 	module = "..."
 	condition "schedule" {
 		cron = "* * * * * * *"
+		time_zone = "America/New_York"
 	}
 }`,
 		},
@@ -181,6 +436,36 @@ task {
 		datacenter = "dc2"
 		recurse = true
 	}
+}`,
+		},
+		{
+			"consul-kv: predicate",
+			false,
+			&ConsulKVConditionConfig{
+				ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+					Path:       String("config/feature-x"),
+					Datacenter: String(""),
+					Namespace:  String(""),
+					Recurse:    Bool(false),
+				},
+				UseAsModuleInput: Bool(true),
+				Predicate: &ConsulKVPredicateConfig{
+					JSONPath:    String("$.replicas"),
+					GreaterThan: Float64(2),
+				},
+			},
+			"config.hcl",
+			`
+task {
+	name = "condition_task"
+	module = "..."
+	condition "consul-kv" {
+		path = "config/feature-x"
+		predicate {
+			json_path = "$.replicas"
+			greater_than = 2
+		}
+	}
 }`,
 		},
 		{
@@ -66,6 +66,13 @@ type Config struct {
 	TerraformProviders *TerraformProviderConfigs `mapstructure:"terraform_provider"`
 	BufferPeriod       *BufferPeriodConfig       `mapstructure:"buffer_period"`
 	TLS                *CTSTLSConfig             `mapstructure:"tls"`
+	LocalState         *LocalStateConfig         `mapstructure:"local_state"`
+	HighAvailability   *HighAvailabilityConfig   `mapstructure:"high_availability"`
+	Sharding           *ShardingConfig           `mapstructure:"sharding"`
+	EventRetention     *EventRetentionConfig     `mapstructure:"event_retention"`
+	Tracing            *TracingConfig            `mapstructure:"tracing"`
+	Notification       *NotificationConfig       `mapstructure:"notification"`
+	Blackout           *BlackoutConfig           `mapstructure:"blackout"`
 }
 
 // BuildConfig builds a new Config object from the default configuration and
@@ -106,6 +113,13 @@ func DefaultConfig() *Config {
 		TerraformProviders: DefaultTerraformProviderConfigs(),
 		BufferPeriod:       DefaultBufferPeriodConfig(),
 		TLS:                DefaultCTSTLSConfig(),
+		LocalState:         DefaultLocalStateConfig(),
+		HighAvailability:   DefaultHighAvailabilityConfig(),
+		Sharding:           DefaultShardingConfig(),
+		EventRetention:     DefaultEventRetentionConfig(),
+		Tracing:            DefaultTracingConfig(),
+		Notification:       DefaultNotificationConfig(),
+		Blackout:           DefaultBlackoutConfig(),
 	}
 }
 
@@ -130,6 +144,13 @@ func (c *Config) Copy() *Config {
 		TerraformProviders: c.TerraformProviders.Copy(),
 		BufferPeriod:       c.BufferPeriod.Copy(),
 		TLS:                c.TLS.Copy(),
+		LocalState:         c.LocalState.Copy(),
+		HighAvailability:   c.HighAvailability.Copy(),
+		Sharding:           c.Sharding.Copy(),
+		EventRetention:     c.EventRetention.Copy(),
+		Tracing:            c.Tracing.Copy(),
+		Notification:       c.Notification.Copy(),
+		Blackout:           c.Blackout.Copy(),
 		ClientType:         StringCopy(c.ClientType),
 	}
 }
@@ -204,6 +225,34 @@ func (c *Config) Merge(o *Config) *Config {
 		r.TLS = r.TLS.Merge(o.TLS)
 	}
 
+	if o.LocalState != nil {
+		r.LocalState = r.LocalState.Merge(o.LocalState)
+	}
+
+	if o.HighAvailability != nil {
+		r.HighAvailability = r.HighAvailability.Merge(o.HighAvailability)
+	}
+
+	if o.Sharding != nil {
+		r.Sharding = r.Sharding.Merge(o.Sharding)
+	}
+
+	if o.EventRetention != nil {
+		r.EventRetention = r.EventRetention.Merge(o.EventRetention)
+	}
+
+	if o.Tracing != nil {
+		r.Tracing = r.Tracing.Merge(o.Tracing)
+	}
+
+	if o.Notification != nil {
+		r.Notification = r.Notification.Merge(o.Notification)
+	}
+
+	if o.Blackout != nil {
+		r.Blackout = r.Blackout.Merge(o.Blackout)
+	}
+
 	return r
 }
 
@@ -291,6 +340,41 @@ func (c *Config) Finalize() error {
 	}
 	c.TLS.Finalize()
 
+	if c.LocalState == nil {
+		c.LocalState = DefaultLocalStateConfig()
+	}
+	c.LocalState.Finalize()
+
+	if c.HighAvailability == nil {
+		c.HighAvailability = DefaultHighAvailabilityConfig()
+	}
+	c.HighAvailability.Finalize()
+
+	if c.Sharding == nil {
+		c.Sharding = DefaultShardingConfig()
+	}
+	c.Sharding.Finalize()
+
+	if c.EventRetention == nil {
+		c.EventRetention = DefaultEventRetentionConfig()
+	}
+	c.EventRetention.Finalize()
+
+	if c.Tracing == nil {
+		c.Tracing = DefaultTracingConfig()
+	}
+	c.Tracing.Finalize()
+
+	if c.Notification == nil {
+		c.Notification = DefaultNotificationConfig()
+	}
+	c.Notification.Finalize()
+
+	if c.Blackout == nil {
+		c.Blackout = DefaultBlackoutConfig()
+	}
+	c.Blackout.Finalize()
+
 	return nil
 }
 
@@ -308,6 +392,14 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.Tasks != nil {
+		for _, t := range *c.Tasks {
+			if err := t.validateDriverOptions(c.Driver); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := c.DeprecatedServices.Validate(); err != nil {
 		return err
 	}
@@ -336,6 +428,59 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.LocalState.Validate(); err != nil {
+		return err
+	}
+
+	if c.LocalState != nil && BoolVal(c.LocalState.Enabled) &&
+		c.Consul != nil && BoolVal(c.Consul.PersistState) {
+		return fmt.Errorf("local_state and consul.persist_state cannot both " +
+			"be enabled. only one location can be used to persist state")
+	}
+
+	if err := c.HighAvailability.Validate(); err != nil {
+		return err
+	}
+
+	if c.HighAvailability != nil && BoolVal(c.HighAvailability.Enabled) &&
+		c.LocalState != nil && BoolVal(c.LocalState.Enabled) {
+		return fmt.Errorf("local_state cannot be enabled with " +
+			"high_availability. state must be shared between CTS instances, " +
+			"use consul.persist_state instead")
+	}
+
+	if err := c.Sharding.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.EventRetention.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Tracing.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Notification.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Blackout.Validate(); err != nil {
+		return err
+	}
+
+	if c.Sharding != nil && BoolVal(c.Sharding.Enabled) {
+		if c.HighAvailability != nil && BoolVal(c.HighAvailability.Enabled) {
+			return fmt.Errorf("sharding and high_availability cannot both " +
+				"be enabled")
+		}
+		if c.LocalState != nil && BoolVal(c.LocalState.Enabled) {
+			return fmt.Errorf("local_state cannot be enabled with sharding. " +
+				"state must be shared between CTS instances, use " +
+				"consul.persist_state instead")
+		}
+	}
+
 	return nil
 }
 
@@ -390,7 +535,14 @@ func (c *Config) GoString() string {
 		"Services (deprecated):%s, "+
 		"TerraformProviders:%s, "+
 		"BufferPeriod:%s,"+
-		"TLS:%s"+
+		"TLS:%s, "+
+		"LocalState:%s, "+
+		"HighAvailability:%s, "+
+		"Sharding:%s, "+
+		"EventRetention:%s, "+
+		"Tracing:%s, "+
+		"Notification:%s, "+
+		"Blackout:%s"+
 		"}",
 		StringVal(c.LogLevel),
 		IntVal(c.Port),
@@ -405,6 +557,13 @@ func (c *Config) GoString() string {
 		c.TerraformProviders.GoString(),
 		c.BufferPeriod.GoString(),
 		c.TLS.GoString(),
+		c.LocalState.GoString(),
+		c.HighAvailability.GoString(),
+		c.Sharding.GoString(),
+		c.EventRetention.GoString(),
+		c.Tracing.GoString(),
+		c.Notification.GoString(),
+		c.Blackout.GoString(),
 	)
 }
 
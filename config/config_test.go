@@ -41,7 +41,8 @@ var (
 				Username: String("username"),
 				Password: String("password"),
 			},
-			KVPath: String("kv_path"),
+			KVPath:       String("kv_path"),
+			PersistState: Bool(true),
 			TLS: &TLSConfig{
 				CACert:     String("ca_cert"),
 				CAPath:     String("ca_path"),
@@ -77,6 +78,42 @@ var (
 			VerifyIncoming: Bool(true),
 			CACert:         String("../testutils/certs/consul_cert.pem"),
 		},
+		LocalState: &LocalStateConfig{
+			Enabled: Bool(false),
+			Path:    String("state.db"),
+		},
+		HighAvailability: &HighAvailabilityConfig{
+			Enabled:     Bool(true),
+			ClusterName: String("cts-cluster"),
+			SessionTTL:  TimeDuration(30 * time.Second),
+		},
+		Sharding: &ShardingConfig{
+			Enabled:    Bool(false),
+			SessionTTL: TimeDuration(20 * time.Second),
+		},
+		EventRetention: &EventRetentionConfig{
+			Count:  Int(10),
+			MaxAge: TimeDuration(168 * time.Hour),
+		},
+		Tracing: &TracingConfig{
+			Enabled:  Bool(true),
+			Endpoint: String("otel-collector:4318"),
+			Insecure: Bool(true),
+			Headers:  map[string]string{"x-api-key": "abcd"},
+		},
+		Notification: &NotificationConfig{
+			URLs:   []string{"https://hooks.example.com/cts"},
+			Secret: String("secret"),
+		},
+		Blackout: &BlackoutConfig{
+			Windows: []*BlackoutWindowConfig{
+				{
+					Start:    String("0 0 18 * * FRI *"),
+					Duration: TimeDuration(62 * time.Hour),
+					TimeZone: String("America/New_York"),
+				},
+			},
+		},
 		Driver: &DriverConfig{
 			Terraform: &TerraformConfig{
 				Log:  Bool(true),
@@ -136,6 +173,20 @@ var (
 						},
 					},
 				},
+				EventRetention: &EventRetentionConfig{
+					Count: Int(3),
+				},
+				Notification: &NotificationConfig{
+					URLs:         []string{"https://hooks.example.com/task"},
+					FailuresOnly: Bool(true),
+				},
+				Blackout: &BlackoutConfig{
+					Enabled: Bool(false),
+				},
+				DriftDetection: &DriftDetectionConfig{
+					Interval:  TimeDuration(30 * time.Minute),
+					Remediate: Bool(true),
+				},
 			},
 		},
 		TerraformProviders: &TerraformProviderConfigs{{
@@ -214,6 +265,88 @@ func TestDecodeConfig(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "terraform_provider")
 	})
+
+	t.Run("terraform cloud driver", func(t *testing.T) {
+		content := []byte(`
+driver "terraform-cloud" {
+  hostname = "tfe.example.com"
+  organization = "org"
+  token = "token"
+  workspace_prefix = "prefix-"
+  workspace_tags = ["cts"]
+  required_providers {
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+}`)
+		c, err := decodeConfig(content, "config.hcl")
+		require.NoError(t, err)
+		require.NotNil(t, c.Driver)
+		assert.Nil(t, c.Driver.Terraform)
+		assert.Equal(t, &TerraformCloudConfig{
+			Hostname:        String("tfe.example.com"),
+			Organization:    String("org"),
+			Token:           String("token"),
+			WorkspacePrefix: String("prefix-"),
+			WorkspaceTags:   []string{"cts"},
+			RequiredProviders: map[string]interface{}{
+				"local": map[string]interface{}{
+					"source": "hashicorp/local",
+				},
+			},
+		}, c.Driver.TerraformCloud)
+	})
+
+	t.Run("opentofu driver", func(t *testing.T) {
+		content := []byte(`
+driver "opentofu" {
+  version = "1.8.8"
+  path = "/usr/local/bin"
+}`)
+		c, err := decodeConfig(content, "config.hcl")
+		require.NoError(t, err)
+		require.NotNil(t, c.Driver)
+		assert.Nil(t, c.Driver.Terraform)
+		require.NotNil(t, c.Driver.OpenTofu)
+		assert.Equal(t, "1.8.8", StringVal(c.Driver.OpenTofu.Version))
+		assert.Equal(t, "/usr/local/bin", StringVal(c.Driver.OpenTofu.Path))
+
+		c.Finalize()
+		assert.True(t, c.Driver.OpenTofu.IsOpenTofu())
+		assert.Equal(t, c.Driver.OpenTofu, c.Driver.TerraformCLI())
+	})
+
+	t.Run("plugin driver", func(t *testing.T) {
+		content := []byte(`
+driver "plugin" {
+  path = "/opt/cts/plugins/ansible"
+  args = ["-inventory", "hosts"]
+  start_timeout = "30s"
+}`)
+		c, err := decodeConfig(content, "config.hcl")
+		require.NoError(t, err)
+		require.NotNil(t, c.Driver)
+		assert.Nil(t, c.Driver.Terraform)
+		assert.Equal(t, &PluginConfig{
+			Path:         String("/opt/cts/plugins/ansible"),
+			Args:         []string{"-inventory", "hosts"},
+			StartTimeout: TimeDuration(30 * time.Second),
+		}, c.Driver.Plugin)
+
+		c.Finalize()
+		assert.Nil(t, c.Driver.TerraformCLI())
+		assert.NoError(t, c.Driver.Validate())
+	})
+
+	t.Run("opentofu driver product not configurable", func(t *testing.T) {
+		content := []byte(`
+driver "opentofu" {
+  product = "terraform"
+}`)
+		_, err := decodeConfig(content, "config.hcl")
+		assert.Error(t, err)
+	})
 }
 
 func TestFromPath(t *testing.T) {
@@ -371,13 +504,18 @@ func TestConfig_Finalize(t *testing.T) {
 	expected.TLS.CACert = String("../testutils/certs/consul_cert.pem")
 	expected.TLS.Finalize()
 	expected.Driver.consul = expected.Consul
+	expected.Driver.Terraform.Product = String(ProductTerraform)
 	expected.Driver.Terraform.Version = String("")
 	expected.Driver.Terraform.PersistLog = Bool(false)
 	backend := expected.Driver.Terraform.Backend["consul"].(map[string]interface{})
 	backend["scheme"] = "https"
 	backend["ca_file"] = "ca_cert"
 	backend["key_file"] = "key"
+	expected.Notification.FailuresOnly = Bool(false)
+	expected.Blackout.Enabled = Bool(true)
 	(*expected.Tasks)[0].Enabled = Bool(true)
+	(*expected.Tasks)[0].ApplyMode = String(ApplyModeAuto)
+	(*expected.Tasks)[0].DriftDetection.Enabled = Bool(true)
 	(*expected.Tasks)[0].DeprecatedTFVersion = String("")
 	(*expected.Tasks)[0].TFCWorkspace = DefaultTerraformCloudWorkspaceConfig()
 	(*expected.Tasks)[0].VarFiles = []string{}
@@ -447,6 +585,25 @@ func TestConfig_Validate(t *testing.T) {
 	validEmptyTasks := longConfig.Copy()
 	*validEmptyTasks.Tasks = TaskConfigs{}
 
+	// state persisted to both Consul KV and a local file (should err)
+	stateConflict := valid.Copy()
+	stateConflict.Consul.PersistState = Bool(true)
+	stateConflict.LocalState.Enabled = Bool(true)
+
+	// high availability with state persisted to a local file (should err)
+	haLocalState := valid.Copy()
+	haLocalState.Consul.PersistState = Bool(false)
+	haLocalState.LocalState.Enabled = Bool(true)
+
+	// sharding with high availability (should err)
+	shardingHA := valid.Copy()
+	shardingHA.Sharding.Enabled = Bool(true)
+
+	// sharding without high availability
+	validSharding := valid.Copy()
+	validSharding.HighAvailability.Enabled = Bool(false)
+	validSharding.Sharding.Enabled = Bool(true)
+
 	cases := []struct {
 		name    string
 		i       *Config
@@ -484,6 +641,22 @@ func TestConfig_Validate(t *testing.T) {
 			"autocommitting provider reuse error",
 			autoCommit.Copy(),
 			false,
+		}, {
+			"persist state conflict",
+			stateConflict.Copy(),
+			false,
+		}, {
+			"high availability local state",
+			haLocalState.Copy(),
+			false,
+		}, {
+			"sharding with high availability",
+			shardingHA.Copy(),
+			false,
+		}, {
+			"sharding valid",
+			validSharding.Copy(),
+			true,
 		},
 	}
 
@@ -30,6 +30,11 @@ type ConsulConfig struct {
 	// data
 	KVPath *string `mapstructure:"kv_path"`
 
+	// PersistState configures CTS to persist its state, such as tasks and task
+	// events, in the Consul KV store under KVPath so that the state is restored
+	// when CTS restarts.
+	PersistState *bool `mapstructure:"persist_state"`
+
 	// TLS indicates we should use a secure connection while talking to
 	// Consul. This requires Consul to be configured to serve HTTPS.
 	TLS *TLSConfig `mapstructure:"tls"`
@@ -73,6 +78,8 @@ func (c *ConsulConfig) Copy() *ConsulConfig {
 
 	o.KVPath = StringCopy(c.KVPath)
 
+	o.PersistState = BoolCopy(c.PersistState)
+
 	if c.TLS != nil {
 		o.TLS = c.TLS.Copy()
 	}
@@ -124,6 +131,10 @@ func (c *ConsulConfig) Merge(o *ConsulConfig) *ConsulConfig {
 		r.KVPath = StringCopy(o.KVPath)
 	}
 
+	if o.PersistState != nil {
+		r.PersistState = BoolCopy(o.PersistState)
+	}
+
 	if o.TLS != nil {
 		r.TLS = r.TLS.Merge(o.TLS)
 	}
@@ -168,6 +179,10 @@ func (c *ConsulConfig) Finalize() {
 		c.KVPath = String(DefaultConsulKVPath)
 	}
 
+	if c.PersistState == nil {
+		c.PersistState = Bool(false)
+	}
+
 	if c.TLS == nil {
 		c.TLS = DefaultTLSConfig()
 	}
@@ -220,6 +235,7 @@ func (c *ConsulConfig) GoString() string {
 		"Auth:%s, "+
 		"KVNamespace:%s, "+
 		"KVPath:%s, "+
+		"PersistState:%t, "+
 		"TLS:%s, "+
 		"Token:%s, "+
 		"Transport:%s, "+
@@ -229,6 +245,7 @@ func (c *ConsulConfig) GoString() string {
 		c.Auth.GoString(),
 		StringVal(c.KVNamespace),
 		StringVal(c.KVPath),
+		BoolVal(c.PersistState),
 		c.TLS.GoString(),
 		sensitiveGoString(c.Token),
 		c.Transport.GoString(),
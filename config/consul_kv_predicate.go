@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ConsulKVPredicateConfig configures the predicate that the value of a Consul
+// KV pair must satisfy for a consul-kv condition to trigger a task. All of the
+// configured options must be satisfied.
+type ConsulKVPredicateConfig struct {
+	// Equals is the value that the value must be equal to.
+	Equals *string `mapstructure:"equals" json:"equals"`
+
+	// Regexp is the regular expression that the value must match.
+	Regexp *string `mapstructure:"regexp" json:"regexp"`
+
+	// JSONPath is the path of the value to evaluate within a JSON value, e.g.
+	// "$.features[0].enabled". If it is the only configured option, the path
+	// must exist.
+	JSONPath *string `mapstructure:"json_path" json:"json_path"`
+
+	// GreaterThan is the number that the value must be greater than.
+	GreaterThan *float64 `mapstructure:"greater_than" json:"greater_than"`
+
+	// LessThan is the number that the value must be less than.
+	LessThan *float64 `mapstructure:"less_than" json:"less_than"`
+
+	// regexp is the compiled Regexp, set by Validate
+	regexp *regexp.Regexp
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *ConsulKVPredicateConfig) Copy() *ConsulKVPredicateConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o ConsulKVPredicateConfig
+	o.Equals = StringCopy(c.Equals)
+	o.Regexp = StringCopy(c.Regexp)
+	o.regexp = c.regexp
+	o.JSONPath = StringCopy(c.JSONPath)
+	o.GreaterThan = Float64Copy(c.GreaterThan)
+	o.LessThan = Float64Copy(c.LessThan)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+func (c *ConsulKVPredicateConfig) Merge(o *ConsulKVPredicateConfig) *ConsulKVPredicateConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Equals != nil {
+		r.Equals = StringCopy(o.Equals)
+	}
+
+	if o.Regexp != nil {
+		r.Regexp = StringCopy(o.Regexp)
+		r.regexp = o.regexp
+	}
+
+	if o.JSONPath != nil {
+		r.JSONPath = StringCopy(o.JSONPath)
+	}
+
+	if o.GreaterThan != nil {
+		r.GreaterThan = Float64Copy(o.GreaterThan)
+	}
+
+	if o.LessThan != nil {
+		r.LessThan = Float64Copy(o.LessThan)
+	}
+
+	return r
+}
+
+// Validate validates the values and required options, and compiles the
+// regexp. The options are not finalized since there is a need to distinguish
+// between an unconfigured option and an empty value.
+func (c *ConsulKVPredicateConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Equals == nil && c.Regexp == nil && c.JSONPath == nil &&
+		c.GreaterThan == nil && c.LessThan == nil {
+		return fmt.Errorf("predicate requires at least one of equals, " +
+			"regexp, json_path, greater_than or less_than to be configured")
+	}
+
+	if c.Regexp != nil {
+		re, err := regexp.Compile(*c.Regexp)
+		if err != nil {
+			return fmt.Errorf("unable to compile predicate regexp %q: %s",
+				*c.Regexp, err)
+		}
+		c.regexp = re
+	}
+
+	if c.JSONPath != nil {
+		if _, err := parseJSONPath(*c.JSONPath); err != nil {
+			return fmt.Errorf("unable to parse predicate json_path %q: %s",
+				*c.JSONPath, err)
+		}
+	}
+
+	if c.GreaterThan != nil && c.LessThan != nil && *c.GreaterThan >= *c.LessThan {
+		return fmt.Errorf("predicate greater_than %v must be less than "+
+			"less_than %v", *c.GreaterThan, *c.LessThan)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *ConsulKVPredicateConfig) GoString() string {
+	if c == nil {
+		return "(*ConsulKVPredicateConfig)(nil)"
+	}
+
+	greaterThan, lessThan := "<nil>", "<nil>"
+	if c.GreaterThan != nil {
+		greaterThan = strconv.FormatFloat(*c.GreaterThan, 'g', -1, 64)
+	}
+	if c.LessThan != nil {
+		lessThan = strconv.FormatFloat(*c.LessThan, 'g', -1, 64)
+	}
+
+	return fmt.Sprintf("&ConsulKVPredicateConfig{"+
+		"Equals:%s, "+
+		"Regexp:%s, "+
+		"JSONPath:%s, "+
+		"GreaterThan:%s, "+
+		"LessThan:%s"+
+		"}",
+		StringVal(c.Equals),
+		StringVal(c.Regexp),
+		StringVal(c.JSONPath),
+		greaterThan,
+		lessThan,
+	)
+}
+
+// Match returns whether the value satisfies the predicate. This method is
+// recommended to run after Validate().
+func (c *ConsulKVPredicateConfig) Match(value string) bool {
+	if c == nil {
+		return true
+	}
+
+	if c.JSONPath != nil {
+		v, ok := selectJSONPath(value, *c.JSONPath)
+		if !ok {
+			return false
+		}
+		value = v
+	}
+
+	if c.Equals != nil && value != *c.Equals {
+		return false
+	}
+
+	if c.Regexp != nil {
+		re := c.regexp
+		if re == nil {
+			// Not validated, compile the regexp for this match only
+			var err error
+			if re, err = regexp.Compile(*c.Regexp); err != nil {
+				return false
+			}
+		}
+		if !re.MatchString(value) {
+			return false
+		}
+	}
+
+	if c.GreaterThan != nil || c.LessThan != nil {
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false
+		}
+		if c.GreaterThan != nil && n <= *c.GreaterThan {
+			return false
+		}
+		if c.LessThan != nil && n >= *c.LessThan {
+			return false
+		}
+	}
+
+	return true
+}
+
+// jsonPathStep is a step of a JSON path, which selects either the key of an
+// object or the index of an array
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath parses a JSON path of object keys separated by dots and array
+// indexes in brackets, optionally prefixed with "$", e.g. "$.a.b[0]" or "a.b"
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	p := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	if p != "" && p[0] != '.' && p[0] != '[' {
+		p = "." + p
+	}
+
+	var steps []jsonPathStep
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end == -1 {
+				end = len(p)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty key")
+			}
+			steps = append(steps, jsonPathStep{key: p[:end]})
+			p = p[end:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("missing closing bracket")
+			}
+			i, err := strconv.Atoi(p[1:end])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid array index %q", p[1:end])
+			}
+			steps = append(steps, jsonPathStep{index: i, isIndex: true})
+			p = p[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected character %q", p[0])
+		}
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("path is empty")
+	}
+	return steps, nil
+}
+
+// selectJSONPath returns the value at the path of a JSON value. Strings are
+// returned without quotes and objects and arrays are returned as JSON. Returns
+// false if the value is not JSON or the path does not exist.
+func selectJSONPath(value, path string) (string, bool) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return "", false
+	}
+
+	d := json.NewDecoder(strings.NewReader(value))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return "", false
+	}
+
+	for _, step := range steps {
+		if step.isIndex {
+			a, ok := v.([]interface{})
+			if !ok || step.index >= len(a) {
+				return "", false
+			}
+			v = a[step.index]
+			continue
+		}
+
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[step.key]; !ok {
+			return "", false
+		}
+	}
+
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case json.Number:
+		return s.String(), true
+	default:
+		var b bytes.Buffer
+		e := json.NewEncoder(&b)
+		e.SetEscapeHTML(false)
+		if err := e.Encode(s); err != nil {
+			return "", false
+		}
+		return strings.TrimSpace(b.String()), true
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsulKVPredicateConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *ConsulKVPredicateConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&ConsulKVPredicateConfig{},
+		},
+		{
+			"fully_configured",
+			&ConsulKVPredicateConfig{
+				Equals:      String("3"),
+				Regexp:      String("^[0-9]+$"),
+				JSONPath:    String("$.replicas"),
+				GreaterThan: Float64(1),
+				LessThan:    Float64(5),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestConsulKVPredicateConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *ConsulKVPredicateConfig
+		b    *ConsulKVPredicateConfig
+		r    *ConsulKVPredicateConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&ConsulKVPredicateConfig{},
+			&ConsulKVPredicateConfig{},
+		},
+		{
+			"nil_b",
+			&ConsulKVPredicateConfig{},
+			nil,
+			&ConsulKVPredicateConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"overrides",
+			&ConsulKVPredicateConfig{
+				Equals:      String("enabled"),
+				GreaterThan: Float64(1),
+			},
+			&ConsulKVPredicateConfig{
+				Equals:   String("disabled"),
+				LessThan: Float64(5),
+			},
+			&ConsulKVPredicateConfig{
+				Equals:      String("disabled"),
+				GreaterThan: Float64(1),
+				LessThan:    Float64(5),
+			},
+		},
+		{
+			"empty_two",
+			&ConsulKVPredicateConfig{
+				Regexp:   String("^v2"),
+				JSONPath: String("$.version"),
+			},
+			&ConsulKVPredicateConfig{},
+			&ConsulKVPredicateConfig{
+				Regexp:   String("^v2"),
+				JSONPath: String("$.version"),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestConsulKVPredicateConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *ConsulKVPredicateConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&ConsulKVPredicateConfig{},
+			false,
+		},
+		{
+			"equals_empty_value",
+			&ConsulKVPredicateConfig{Equals: String("")},
+			true,
+		},
+		{
+			"fully_configured",
+			&ConsulKVPredicateConfig{
+				Equals:      String("3"),
+				Regexp:      String("^[0-9]+$"),
+				JSONPath:    String("$.replicas"),
+				GreaterThan: Float64(1),
+				LessThan:    Float64(5),
+			},
+			true,
+		},
+		{
+			"invalid_regexp",
+			&ConsulKVPredicateConfig{Regexp: String("*")},
+			false,
+		},
+		{
+			"invalid_json_path",
+			&ConsulKVPredicateConfig{JSONPath: String("$.a[b]")},
+			false,
+		},
+		{
+			"empty_json_path",
+			&ConsulKVPredicateConfig{JSONPath: String("$")},
+			false,
+		},
+		{
+			"invalid_range",
+			&ConsulKVPredicateConfig{
+				GreaterThan: Float64(5),
+				LessThan:    Float64(5),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConsulKVPredicateConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		i        *ConsulKVPredicateConfig
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"(*ConsulKVPredicateConfig)(nil)",
+		},
+		{
+			"fully_configured",
+			&ConsulKVPredicateConfig{
+				Equals:      String("3"),
+				Regexp:      String("^[0-9]+$"),
+				JSONPath:    String("$.replicas"),
+				GreaterThan: Float64(1.5),
+				LessThan:    Float64(5),
+			},
+			"&ConsulKVPredicateConfig{Equals:3, Regexp:^[0-9]+$, " +
+				"JSONPath:$.replicas, GreaterThan:1.5, LessThan:5}",
+		},
+		{
+			"unset_numbers",
+			&ConsulKVPredicateConfig{Equals: String("enabled")},
+			"&ConsulKVPredicateConfig{Equals:enabled, Regexp:, JSONPath:, " +
+				"GreaterThan:<nil>, LessThan:<nil>}",
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.i.GoString())
+		})
+	}
+}
+
+func TestConsulKVPredicateConfig_Match(t *testing.T) {
+	t.Parallel()
+
+	doc := `{"features": [{"name": "x", "enabled": true}], "replicas": 3,
+		"version": "v2.1.0", "owner": {"team": "network"}}`
+
+	cases := []struct {
+		name     string
+		i        *ConsulKVPredicateConfig
+		value    string
+		expected bool
+	}{
+		{
+			"nil",
+			nil,
+			"anything",
+			true,
+		},
+		{
+			"equals",
+			&ConsulKVPredicateConfig{Equals: String("enabled")},
+			"enabled",
+			true,
+		},
+		{
+			"not_equals",
+			&ConsulKVPredicateConfig{Equals: String("enabled")},
+			"disabled",
+			false,
+		},
+		{
+			"regexp",
+			&ConsulKVPredicateConfig{Regexp: String("^v2\\.")},
+			"v2.1.0",
+			true,
+		},
+		{
+			"regexp_no_match",
+			&ConsulKVPredicateConfig{Regexp: String("^v2\\.")},
+			"v1.9.0",
+			false,
+		},
+		{
+			"greater_than",
+			&ConsulKVPredicateConfig{GreaterThan: Float64(2)},
+			" 3 ",
+			true,
+		},
+		{
+			"not_greater_than",
+			&ConsulKVPredicateConfig{GreaterThan: Float64(3)},
+			"3",
+			false,
+		},
+		{
+			"less_than",
+			&ConsulKVPredicateConfig{LessThan: Float64(0.5)},
+			"0.25",
+			true,
+		},
+		{
+			"within_range",
+			&ConsulKVPredicateConfig{
+				GreaterThan: Float64(1),
+				LessThan:    Float64(5),
+			},
+			"3",
+			true,
+		},
+		{
+			"not_a_number",
+			&ConsulKVPredicateConfig{GreaterThan: Float64(1)},
+			"three",
+			false,
+		},
+		{
+			"json_path_exists",
+			&ConsulKVPredicateConfig{JSONPath: String("$.owner.team")},
+			doc,
+			true,
+		},
+		{
+			"json_path_missing",
+			&ConsulKVPredicateConfig{JSONPath: String("$.owner.name")},
+			doc,
+			false,
+		},
+		{
+			"json_path_without_prefix",
+			&ConsulKVPredicateConfig{
+				JSONPath: String("owner.team"),
+				Equals:   String("network"),
+			},
+			doc,
+			true,
+		},
+		{
+			"json_path_array_index",
+			&ConsulKVPredicateConfig{
+				JSONPath: String("$.features[0].enabled"),
+				Equals:   String("true"),
+			},
+			doc,
+			true,
+		},
+		{
+			"json_path_index_out_of_range",
+			&ConsulKVPredicateConfig{JSONPath: String("$.features[1].enabled")},
+			doc,
+			false,
+		},
+		{
+			"json_path_number",
+			&ConsulKVPredicateConfig{
+				JSONPath:    String("$.replicas"),
+				GreaterThan: Float64(2),
+			},
+			doc,
+			true,
+		},
+		{
+			"json_path_object",
+			&ConsulKVPredicateConfig{
+				JSONPath: String("$.owner"),
+				Equals:   String(`{"team":"network"}`),
+			},
+			doc,
+			true,
+		},
+		{
+			"json_path_invalid_json",
+			&ConsulKVPredicateConfig{JSONPath: String("$.owner")},
+			"enabled",
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.i.Match(tc.value))
+
+			// a copy of the validated predicate matches with the compiled regexp
+			validated := tc.i.Copy()
+			if validated.Validate() == nil {
+				assert.Equal(t, tc.expected, validated.Copy().Match(tc.value))
+			}
+		})
+	}
+}
+
+func TestConsulKVPredicateConfig_CompiledRegexp(t *testing.T) {
+	t.Parallel()
+
+	c := &ConsulKVPredicateConfig{Regexp: String("^v2\\.")}
+	assert.Nil(t, c.regexp)
+	require.NoError(t, c.Validate())
+	require.NotNil(t, c.regexp)
+
+	// the compiled regexp is shared by copies and kept when merged
+	assert.Same(t, c.regexp, c.Copy().regexp)
+	assert.Same(t, c.regexp, (&ConsulKVPredicateConfig{}).Merge(c).regexp)
+
+	// the compiled regexp is not kept when merged with another regexp
+	merged := c.Merge(&ConsulKVPredicateConfig{Regexp: String("^v3\\.")})
+	assert.Nil(t, merged.regexp)
+	assert.True(t, merged.Match("v3.0.0"))
+	assert.False(t, merged.Match("v2.0.0"))
+}
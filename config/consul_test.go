@@ -37,12 +37,13 @@ func TestConsulConfig_Copy(t *testing.T) {
 		{
 			"same_enabled",
 			&ConsulConfig{
-				Address:     String("1.2.3.4"),
-				Auth:        &AuthConfig{Enabled: Bool(true)},
-				KVPath:      String("consul-terraform-sync/"),
-				KVNamespace: String("org"),
-				TLS:         &TLSConfig{Enabled: Bool(true)},
-				Token:       String("abcd1234"),
+				Address:      String("1.2.3.4"),
+				Auth:         &AuthConfig{Enabled: Bool(true)},
+				KVPath:       String("consul-terraform-sync/"),
+				KVNamespace:  String("org"),
+				PersistState: Bool(true),
+				TLS:          &TLSConfig{Enabled: Bool(true)},
+				Token:        String("abcd1234"),
 				ServiceRegistration: &ServiceRegistrationConfig{
 					Enabled:     Bool(false),
 					ServiceName: String("test-service"),
@@ -170,6 +171,18 @@ func TestConsulConfig_Merge(t *testing.T) {
 			&ConsulConfig{TLS: &TLSConfig{Enabled: Bool(true)}},
 			&ConsulConfig{TLS: &TLSConfig{Enabled: Bool(true)}},
 		},
+		{
+			"persist_state_overrides",
+			&ConsulConfig{PersistState: Bool(true)},
+			&ConsulConfig{PersistState: Bool(false)},
+			&ConsulConfig{PersistState: Bool(false)},
+		},
+		{
+			"persist_state_empty_one",
+			&ConsulConfig{PersistState: Bool(true)},
+			&ConsulConfig{},
+			&ConsulConfig{PersistState: Bool(true)},
+		},
 		{
 			"token_overrides",
 			&ConsulConfig{Token: String("same")},
@@ -270,8 +283,9 @@ func TestConsulConfig_Finalize(t *testing.T) {
 					Username: String(""),
 					Password: String(""),
 				},
-				KVNamespace: String(""),
-				KVPath:      String(DefaultConsulKVPath),
+				KVNamespace:  String(""),
+				KVPath:       String(DefaultConsulKVPath),
+				PersistState: Bool(false),
 				TLS: &TLSConfig{
 					CACert:     String(""),
 					CAPath:     String(""),
@@ -57,6 +57,29 @@ func IntCopy(i *int) *int {
 	return Int(*i)
 }
 
+// Float64 returns a pointer to the given float64.
+func Float64(f float64) *float64 {
+	return &f
+}
+
+// Float64Val returns the value of the float64 at the pointer, or 0 if the
+// pointer is nil.
+func Float64Val(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
+
+// Float64Copy returns a copy of the float64 pointer
+func Float64Copy(f *float64) *float64 {
+	if f == nil {
+		return nil
+	}
+
+	return Float64(*f)
+}
+
 // String returns a pointer to the given string.
 func String(s string) *string {
 	return &s
@@ -33,12 +33,6 @@ similar to provider blocks in Terraform but have additional features
 supported only by CTS.`, err)
 
 			// Enterprise-specific configurations, will only error in OSS
-		case "driver.terraform-cloud":
-			return fmt.Errorf(`%s
-
-Terraform Cloud is a Consul-Terraform-Sync (CTS) Enterprise feature.
-Upgrade to Consul Enterprise to enable CTS Enterprise features.`, err)
-
 		case "license_path":
 			return fmt.Errorf(`%s
 
@@ -49,12 +43,6 @@ Upgrade to Consul Enterprise to enable CTS Enterprise features.`, err)
 
 'license' is a Consul-Terraform-Sync (CTS) Enterprise configuration.`, err)
 
-		case "high_availability":
-			return fmt.Errorf(`%s
-
-High availability is a Consul-Terraform-Sync (CTS) Enterprise feature.
-Upgrade to Consul Enterprise to enable CTS Enterprise features.`, err)
-
 		}
 	}
 	return err
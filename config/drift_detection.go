@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultDriftDetectionInterval is the default interval between drift
+	// detection runs of a task
+	DefaultDriftDetectionInterval = time.Hour
+
+	// minDriftDetectionInterval is the minimum interval between drift
+	// detection runs of a task, which limits the load on the infrastructure
+	// providers from refreshing the resources of the task
+	minDriftDetectionInterval = time.Minute
+)
+
+// DriftDetectionConfig configures a task to periodically detect drift, i.e.
+// changes made to the infrastructure of the task outside of CTS. Drift is
+// detected by planning the task with its last rendered template, and a
+// detected drift is recorded as a drift event of the task.
+type DriftDetectionConfig struct {
+	// Enabled determines whether drift detection runs for the task. Enabled
+	// by default when the block is configured.
+	Enabled *bool `mapstructure:"enabled" json:"enabled"`
+
+	// Interval is the time between drift detection runs.
+	Interval *time.Duration `mapstructure:"interval" json:"interval"`
+
+	// Remediate determines whether detected drift is remediated by applying
+	// the task. For tasks with a manual apply mode, the remediation is
+	// planned for approval instead.
+	Remediate *bool `mapstructure:"remediate" json:"remediate"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *DriftDetectionConfig) Copy() *DriftDetectionConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o DriftDetectionConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	o.Interval = TimeDurationCopy(c.Interval)
+	o.Remediate = BoolCopy(c.Remediate)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *DriftDetectionConfig) Merge(o *DriftDetectionConfig) *DriftDetectionConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	if o.Interval != nil {
+		r.Interval = TimeDurationCopy(o.Interval)
+	}
+
+	if o.Remediate != nil {
+		r.Remediate = BoolCopy(o.Remediate)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *DriftDetectionConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(true)
+	}
+
+	if c.Interval == nil {
+		c.Interval = TimeDuration(DefaultDriftDetectionInterval)
+	}
+
+	if c.Remediate == nil {
+		c.Remediate = Bool(false)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *DriftDetectionConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Interval != nil && *c.Interval < minDriftDetectionInterval {
+		return fmt.Errorf("drift_detection: interval must be at least %s, "+
+			"got %s", minDriftDetectionInterval, *c.Interval)
+	}
+
+	return nil
+}
+
+// IsEnabled returns whether drift detection is configured and enabled.
+func (c *DriftDetectionConfig) IsEnabled() bool {
+	return c != nil && BoolVal(c.Enabled)
+}
+
+// GoString defines the printable version of this struct.
+func (c *DriftDetectionConfig) GoString() string {
+	if c == nil {
+		return "(*DriftDetectionConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&DriftDetectionConfig{"+
+		"Enabled:%v, "+
+		"Interval:%s, "+
+		"Remediate:%v"+
+		"}",
+		BoolVal(c.Enabled),
+		TimeDurationVal(c.Interval),
+		BoolVal(c.Remediate),
+	)
+}
@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriftDetectionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &DriftDetectionConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *DriftDetectionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&DriftDetectionConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&DriftDetectionConfig{
+				Enabled:   Bool(true),
+				Interval:  TimeDuration(30 * time.Minute),
+				Remediate: Bool(true),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestDriftDetectionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *DriftDetectionConfig
+		b    *DriftDetectionConfig
+		r    *DriftDetectionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&DriftDetectionConfig{},
+			&DriftDetectionConfig{},
+		},
+		{
+			"nil_b",
+			&DriftDetectionConfig{},
+			nil,
+			&DriftDetectionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&DriftDetectionConfig{},
+			&DriftDetectionConfig{},
+			&DriftDetectionConfig{},
+		},
+		{
+			"enabled_overrides",
+			&DriftDetectionConfig{Enabled: Bool(true)},
+			&DriftDetectionConfig{Enabled: Bool(false)},
+			&DriftDetectionConfig{Enabled: Bool(false)},
+		},
+		{
+			"interval_overrides",
+			&DriftDetectionConfig{Interval: TimeDuration(time.Hour)},
+			&DriftDetectionConfig{Interval: TimeDuration(time.Minute)},
+			&DriftDetectionConfig{Interval: TimeDuration(time.Minute)},
+		},
+		{
+			"remediate_empty_two",
+			&DriftDetectionConfig{Remediate: Bool(true)},
+			&DriftDetectionConfig{},
+			&DriftDetectionConfig{Remediate: Bool(true)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestDriftDetectionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *DriftDetectionConfig
+		r    *DriftDetectionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&DriftDetectionConfig{},
+			&DriftDetectionConfig{
+				Enabled:   Bool(true),
+				Interval:  TimeDuration(DefaultDriftDetectionInterval),
+				Remediate: Bool(false),
+			},
+		},
+		{
+			"configured",
+			&DriftDetectionConfig{
+				Enabled:   Bool(false),
+				Interval:  TimeDuration(5 * time.Minute),
+				Remediate: Bool(true),
+			},
+			&DriftDetectionConfig{
+				Enabled:   Bool(false),
+				Interval:  TimeDuration(5 * time.Minute),
+				Remediate: Bool(true),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestDriftDetectionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *DriftDetectionConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&DriftDetectionConfig{},
+			true,
+		},
+		{
+			"minimum_interval",
+			&DriftDetectionConfig{Interval: TimeDuration(time.Minute)},
+			true,
+		},
+		{
+			"interval_too_short",
+			&DriftDetectionConfig{Interval: TimeDuration(30 * time.Second)},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDriftDetectionConfig_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	var c *DriftDetectionConfig
+	assert.False(t, c.IsEnabled())
+
+	c = &DriftDetectionConfig{}
+	c.Finalize()
+	assert.True(t, c.IsEnabled())
+
+	c.Enabled = Bool(false)
+	assert.False(t, c.IsEnabled())
+}
+
+func TestDriftDetectionConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &DriftDetectionConfig{
+		Enabled:   Bool(true),
+		Interval:  TimeDuration(30 * time.Minute),
+		Remediate: Bool(true),
+	}
+	expected := "&DriftDetectionConfig{Enabled:true, Interval:30m0s, " +
+		"Remediate:true}"
+	assert.Equal(t, expected, c.GoString())
+}
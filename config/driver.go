@@ -3,14 +3,20 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // DriverConfig is the configuration for the CTS driver used to execute
 // infrastructure updates.
 type DriverConfig struct {
 	consul *ConsulConfig
 
-	Terraform *TerraformConfig `mapstructure:"terraform"`
+	Terraform      *TerraformConfig      `mapstructure:"terraform"`
+	OpenTofu       *TerraformConfig      `mapstructure:"opentofu"`
+	TerraformCloud *TerraformCloudConfig `mapstructure:"terraform-cloud"`
+	Plugin         *PluginConfig         `mapstructure:"plugin"`
 }
 
 // DefaultDriverConfig returns the default configuration struct.
@@ -34,6 +40,18 @@ func (c *DriverConfig) Copy() *DriverConfig {
 		o.Terraform = c.Terraform.Copy()
 	}
 
+	if c.OpenTofu != nil {
+		o.OpenTofu = c.OpenTofu.Copy()
+	}
+
+	if c.TerraformCloud != nil {
+		o.TerraformCloud = c.TerraformCloud.Copy()
+	}
+
+	if c.Plugin != nil {
+		o.Plugin = c.Plugin.Copy()
+	}
+
 	return &o
 }
 
@@ -63,6 +81,18 @@ func (c *DriverConfig) Merge(o *DriverConfig) *DriverConfig {
 		r.Terraform = r.Terraform.Merge(o.Terraform)
 	}
 
+	if o.OpenTofu != nil {
+		r.OpenTofu = r.OpenTofu.Merge(o.OpenTofu)
+	}
+
+	if o.TerraformCloud != nil {
+		r.TerraformCloud = r.TerraformCloud.Merge(o.TerraformCloud)
+	}
+
+	if o.Plugin != nil {
+		r.Plugin = r.Plugin.Merge(o.Plugin)
+	}
+
 	return r
 }
 
@@ -72,6 +102,22 @@ func (c *DriverConfig) Finalize() {
 		return
 	}
 
+	if c.TerraformCloud != nil {
+		c.TerraformCloud.Finalize()
+		return
+	}
+
+	if c.Plugin != nil {
+		c.Plugin.Finalize()
+		return
+	}
+
+	if c.OpenTofu != nil {
+		c.OpenTofu.Product = String(ProductOpenTofu)
+		c.OpenTofu.Finalize(c.consul)
+		return
+	}
+
 	if c.Terraform == nil {
 		c.Terraform = DefaultTerraformConfig()
 	}
@@ -84,9 +130,75 @@ func (c *DriverConfig) Validate() error {
 		return fmt.Errorf("missing driver configuration")
 	}
 
+	var drivers []string
+	if c.Terraform != nil {
+		drivers = append(drivers, "'terraform'")
+	}
+	if c.OpenTofu != nil {
+		drivers = append(drivers, "'opentofu'")
+	}
+	if c.TerraformCloud != nil {
+		drivers = append(drivers, "'terraform-cloud'")
+	}
+	if c.Plugin != nil {
+		drivers = append(drivers, "'plugin'")
+	}
+	if len(drivers) > 1 {
+		return fmt.Errorf("only one driver can be configured, found %s",
+			strings.Join(drivers, " and "))
+	}
+
+	if c.TerraformCloud != nil {
+		return c.TerraformCloud.Validate()
+	}
+
+	if c.Plugin != nil {
+		return c.Plugin.Validate()
+	}
+
+	if c.OpenTofu != nil {
+		if !c.OpenTofu.IsOpenTofu() {
+			return fmt.Errorf("opentofu: driver is not finalized for OpenTofu")
+		}
+		return c.OpenTofu.Validate()
+	}
+
 	return c.Terraform.Validate()
 }
 
+// TerraformCLI returns the configuration of the driver that executes tasks
+// with a locally installed CLI, which is either the Terraform or the OpenTofu
+// driver. Nil is returned for other drivers.
+func (c *DriverConfig) TerraformCLI() *TerraformConfig {
+	if c == nil || c.TerraformCloud != nil || c.Plugin != nil {
+		return nil
+	}
+
+	if c.OpenTofu != nil {
+		return c.OpenTofu
+	}
+
+	return c.Terraform
+}
+
+// RequiredProviders returns the required provider information of the
+// configured driver.
+func (c *DriverConfig) RequiredProviders() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	if c.TerraformCloud != nil {
+		return c.TerraformCloud.RequiredProviders
+	}
+
+	if tf := c.TerraformCLI(); tf != nil {
+		return tf.RequiredProviders
+	}
+
+	return nil
+}
+
 // GoString defines the printable version of this struct.
 func (c *DriverConfig) GoString() string {
 	if c == nil {
@@ -94,8 +206,14 @@ func (c *DriverConfig) GoString() string {
 	}
 
 	return fmt.Sprintf("&DriverConfig{"+
-		"Terraform:%s"+
+		"Terraform:%s, "+
+		"OpenTofu:%s, "+
+		"TerraformCloud:%s, "+
+		"Plugin:%s"+
 		"}",
 		c.Terraform.GoString(),
+		c.OpenTofu.GoString(),
+		c.TerraformCloud.GoString(),
+		c.Plugin.GoString(),
 	)
 }
@@ -159,6 +159,7 @@ func TestDriverConfig_Finalize(t *testing.T) {
 			&DriverConfig{},
 			&DriverConfig{
 				Terraform: &TerraformConfig{
+					Product:           String(ProductTerraform),
 					Version:           String(""),
 					Log:               Bool(false),
 					PersistLog:        Bool(false),
@@ -177,6 +178,7 @@ func TestDriverConfig_Finalize(t *testing.T) {
 			},
 			&DriverConfig{
 				Terraform: &TerraformConfig{
+					Product:           String(ProductTerraform),
 					Version:           String(""),
 					Log:               Bool(true),
 					PersistLog:        Bool(false),
@@ -186,6 +188,57 @@ func TestDriverConfig_Finalize(t *testing.T) {
 				},
 			},
 		},
+		{
+			"with_opentofu",
+			&DriverConfig{
+				OpenTofu: &TerraformConfig{
+					Version: String("1.8.8"),
+				},
+			},
+			&DriverConfig{
+				OpenTofu: &TerraformConfig{
+					Product:           String(ProductOpenTofu),
+					Version:           String("1.8.8"),
+					Log:               Bool(false),
+					PersistLog:        Bool(false),
+					Path:              String(wd),
+					Backend:           map[string]interface{}{},
+					RequiredProviders: map[string]interface{}{},
+				},
+			},
+		},
+		{
+			"with_plugin",
+			&DriverConfig{
+				Plugin: &PluginConfig{Path: String("/opt/cts/plugin")},
+			},
+			&DriverConfig{
+				Plugin: &PluginConfig{
+					Path:         String("/opt/cts/plugin"),
+					Args:         []string{},
+					StartTimeout: TimeDuration(DefaultPluginStartTimeout),
+				},
+			},
+		},
+		{
+			"with_terraform_cloud",
+			&DriverConfig{
+				TerraformCloud: &TerraformCloudConfig{
+					Organization: String("org"),
+					Token:        String("token"),
+				},
+			},
+			&DriverConfig{
+				TerraformCloud: &TerraformCloudConfig{
+					Hostname:          String(DefaultTFCHostname),
+					Organization:      String("org"),
+					Token:             String("token"),
+					WorkspacePrefix:   String(DefaultTFCWorkspacePrefix),
+					WorkspaceTags:     []string{},
+					RequiredProviders: map[string]interface{}{},
+				},
+			},
+		},
 	}
 
 	for i, tc := range cases {
@@ -220,6 +273,27 @@ func TestDriverConfig_Validate(t *testing.T) {
 			"terraform_invalid",
 			&DriverConfig{Terraform: &TerraformConfig{}},
 			false,
+		}, {
+			"terraform_cloud",
+			&DriverConfig{TerraformCloud: &TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String("token"),
+			}},
+			true,
+		}, {
+			"terraform_cloud_invalid",
+			&DriverConfig{TerraformCloud: &TerraformCloudConfig{}},
+			false,
+		}, {
+			"multiple_drivers",
+			&DriverConfig{
+				Terraform: &TerraformConfig{Backend: map[string]interface{}{"consul": nil}},
+				TerraformCloud: &TerraformCloudConfig{
+					Organization: String("org"),
+					Token:        String("token"),
+				},
+			},
+			false,
 		},
 	}
 
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultEventRetentionCount is the default number of events that are
+	// retained per task
+	DefaultEventRetentionCount = 5
+
+	// DefaultEventRetentionMaxAge is the default maximum age of the events
+	// that are retained. Zero retains events regardless of their age.
+	DefaultEventRetentionMaxAge = time.Duration(0)
+)
+
+// EventRetentionConfig configures how many task events are retained and for
+// how long. It can be configured globally and for each task. Values that are
+// not configured for a task are inherited from the global configuration.
+type EventRetentionConfig struct {
+	// Count is the maximum number of events retained per task. The oldest
+	// events are removed first.
+	Count *int `mapstructure:"count" json:"count"`
+
+	// MaxAge is the maximum age of the events retained, based on the end time
+	// of the event. Zero retains events regardless of their age.
+	MaxAge *time.Duration `mapstructure:"max_age" json:"max_age"`
+}
+
+// DefaultEventRetentionConfig returns the default configuration struct.
+func DefaultEventRetentionConfig() *EventRetentionConfig {
+	return &EventRetentionConfig{
+		Count:  Int(DefaultEventRetentionCount),
+		MaxAge: TimeDuration(DefaultEventRetentionMaxAge),
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *EventRetentionConfig) Copy() *EventRetentionConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o EventRetentionConfig
+	o.Count = IntCopy(c.Count)
+	o.MaxAge = TimeDurationCopy(c.MaxAge)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *EventRetentionConfig) Merge(o *EventRetentionConfig) *EventRetentionConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Count != nil {
+		r.Count = IntCopy(o.Count)
+	}
+
+	if o.MaxAge != nil {
+		r.MaxAge = TimeDurationCopy(o.MaxAge)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *EventRetentionConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Count == nil {
+		c.Count = Int(DefaultEventRetentionCount)
+	}
+
+	if c.MaxAge == nil {
+		c.MaxAge = TimeDuration(DefaultEventRetentionMaxAge)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed. Values
+// are optional so that the task configuration can inherit them.
+func (c *EventRetentionConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Count != nil && *c.Count < 1 {
+		return fmt.Errorf("event_retention.count must be at least 1, got %d",
+			*c.Count)
+	}
+
+	if c.MaxAge != nil && *c.MaxAge < 0 {
+		return fmt.Errorf("event_retention.max_age cannot be negative, got %s",
+			*c.MaxAge)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *EventRetentionConfig) GoString() string {
+	if c == nil {
+		return "(*EventRetentionConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&EventRetentionConfig{"+
+		"Count:%d, "+
+		"MaxAge:%s"+
+		"}",
+		IntVal(c.Count),
+		TimeDurationVal(c.MaxAge),
+	)
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventRetentionConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *EventRetentionConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&EventRetentionConfig{},
+		},
+		{
+			"default",
+			DefaultEventRetentionConfig(),
+		},
+		{
+			"fully_configured",
+			&EventRetentionConfig{
+				Count:  Int(10),
+				MaxAge: TimeDuration(24 * time.Hour),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestEventRetentionConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *EventRetentionConfig
+		b    *EventRetentionConfig
+		r    *EventRetentionConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&EventRetentionConfig{},
+			&EventRetentionConfig{},
+		},
+		{
+			"nil_b",
+			&EventRetentionConfig{},
+			nil,
+			&EventRetentionConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"count_overrides",
+			&EventRetentionConfig{Count: Int(5)},
+			&EventRetentionConfig{Count: Int(10)},
+			&EventRetentionConfig{Count: Int(10)},
+		},
+		{
+			"count_empty_two",
+			&EventRetentionConfig{Count: Int(5)},
+			&EventRetentionConfig{},
+			&EventRetentionConfig{Count: Int(5)},
+		},
+		{
+			"max_age_overrides",
+			&EventRetentionConfig{MaxAge: TimeDuration(time.Hour)},
+			&EventRetentionConfig{MaxAge: TimeDuration(2 * time.Hour)},
+			&EventRetentionConfig{MaxAge: TimeDuration(2 * time.Hour)},
+		},
+		{
+			"inherit_unset_values",
+			&EventRetentionConfig{
+				Count:  Int(5),
+				MaxAge: TimeDuration(time.Hour),
+			},
+			&EventRetentionConfig{Count: Int(10)},
+			&EventRetentionConfig{
+				Count:  Int(10),
+				MaxAge: TimeDuration(time.Hour),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestEventRetentionConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *EventRetentionConfig
+		r    *EventRetentionConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&EventRetentionConfig{},
+			DefaultEventRetentionConfig(),
+		},
+		{
+			"count",
+			&EventRetentionConfig{Count: Int(10)},
+			&EventRetentionConfig{
+				Count:  Int(10),
+				MaxAge: TimeDuration(0),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestEventRetentionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *EventRetentionConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&EventRetentionConfig{},
+			true,
+		},
+		{
+			"valid",
+			&EventRetentionConfig{
+				Count:  Int(1),
+				MaxAge: TimeDuration(time.Hour),
+			},
+			true,
+		},
+		{
+			"count_zero",
+			&EventRetentionConfig{Count: Int(0)},
+			false,
+		},
+		{
+			"max_age_negative",
+			&EventRetentionConfig{MaxAge: TimeDuration(-time.Hour)},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
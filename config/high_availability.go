@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultHighAvailabilityClusterName is the default name of the cluster of
+	// CTS instances that elect a leader amongst themselves.
+	DefaultHighAvailabilityClusterName = "consul-terraform-sync"
+
+	// Bounds on the session TTL set by Consul
+	minSessionTTL = 10 * time.Second
+	maxSessionTTL = 24 * time.Hour
+)
+
+var (
+	// DefaultSessionTTL is the default TTL of the Consul session that holds the
+	// leader lock. The session is renewed periodically by the leader.
+	DefaultSessionTTL = 15 * time.Second
+)
+
+// HighAvailabilityConfig configures running multiple CTS instances as a
+// cluster. A leader is elected using a lock in Consul KV, and only the leader
+// executes tasks. Followers continue to monitor the task conditions so that
+// they can take over if the leader is lost.
+type HighAvailabilityConfig struct {
+	// Enabled determines if this CTS instance participates in leader election.
+	Enabled *bool `mapstructure:"enabled"`
+
+	// ClusterName is the name of the cluster of CTS instances. Instances with
+	// the same cluster name compete for the same leader lock.
+	ClusterName *string `mapstructure:"cluster_name"`
+
+	// SessionTTL is the TTL of the Consul session that holds the leader lock.
+	// When the leader fails to renew its session within the TTL, the lock is
+	// released and a follower is elected.
+	SessionTTL *time.Duration `mapstructure:"session_ttl"`
+}
+
+// DefaultHighAvailabilityConfig returns the default configuration struct.
+func DefaultHighAvailabilityConfig() *HighAvailabilityConfig {
+	return &HighAvailabilityConfig{
+		// No default values. `Enabled` value depends on other fields as
+		// handled in Finalize()
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *HighAvailabilityConfig) Copy() *HighAvailabilityConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o HighAvailabilityConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	o.ClusterName = StringCopy(c.ClusterName)
+	o.SessionTTL = TimeDurationCopy(c.SessionTTL)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *HighAvailabilityConfig) Merge(o *HighAvailabilityConfig) *HighAvailabilityConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	if o.ClusterName != nil {
+		r.ClusterName = StringCopy(o.ClusterName)
+	}
+
+	if o.SessionTTL != nil {
+		r.SessionTTL = TimeDurationCopy(o.SessionTTL)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *HighAvailabilityConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(StringPresent(c.ClusterName))
+	}
+
+	if c.ClusterName == nil {
+		c.ClusterName = String(DefaultHighAvailabilityClusterName)
+	}
+
+	if c.SessionTTL == nil {
+		c.SessionTTL = TimeDuration(DefaultSessionTTL)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *HighAvailabilityConfig) Validate() error {
+	if c == nil || !BoolVal(c.Enabled) {
+		return nil
+	}
+
+	if StringVal(c.ClusterName) == "" {
+		return fmt.Errorf("high_availability.cluster_name cannot be empty")
+	}
+
+	ttl := TimeDurationVal(c.SessionTTL)
+	if ttl < minSessionTTL || ttl > maxSessionTTL {
+		return fmt.Errorf("high_availability.session_ttl must be between "+
+			"%s and %s, got %s", minSessionTTL, maxSessionTTL, ttl)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *HighAvailabilityConfig) GoString() string {
+	if c == nil {
+		return "(*HighAvailabilityConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&HighAvailabilityConfig{"+
+		"Enabled:%t, "+
+		"ClusterName:%s, "+
+		"SessionTTL:%s"+
+		"}",
+		BoolVal(c.Enabled),
+		StringVal(c.ClusterName),
+		TimeDurationVal(c.SessionTTL),
+	)
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighAvailabilityConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &HighAvailabilityConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *HighAvailabilityConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&HighAvailabilityConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String("cts-cluster"),
+				SessionTTL:  TimeDuration(30 * time.Second),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestHighAvailabilityConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *HighAvailabilityConfig
+		b    *HighAvailabilityConfig
+		r    *HighAvailabilityConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{},
+		},
+		{
+			"nil_b",
+			&HighAvailabilityConfig{},
+			nil,
+			&HighAvailabilityConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{},
+		},
+		{
+			"enabled_overrides",
+			&HighAvailabilityConfig{Enabled: Bool(true)},
+			&HighAvailabilityConfig{Enabled: Bool(false)},
+			&HighAvailabilityConfig{Enabled: Bool(false)},
+		},
+		{
+			"enabled_empty_one",
+			&HighAvailabilityConfig{Enabled: Bool(true)},
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{Enabled: Bool(true)},
+		},
+		{
+			"cluster_name_overrides",
+			&HighAvailabilityConfig{ClusterName: String("a")},
+			&HighAvailabilityConfig{ClusterName: String("b")},
+			&HighAvailabilityConfig{ClusterName: String("b")},
+		},
+		{
+			"cluster_name_empty_two",
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{ClusterName: String("b")},
+			&HighAvailabilityConfig{ClusterName: String("b")},
+		},
+		{
+			"session_ttl_overrides",
+			&HighAvailabilityConfig{SessionTTL: TimeDuration(10 * time.Second)},
+			&HighAvailabilityConfig{SessionTTL: TimeDuration(20 * time.Second)},
+			&HighAvailabilityConfig{SessionTTL: TimeDuration(20 * time.Second)},
+		},
+		{
+			"session_ttl_empty_one",
+			&HighAvailabilityConfig{SessionTTL: TimeDuration(10 * time.Second)},
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{SessionTTL: TimeDuration(10 * time.Second)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestHighAvailabilityConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *HighAvailabilityConfig
+		r    *HighAvailabilityConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&HighAvailabilityConfig{},
+			&HighAvailabilityConfig{
+				Enabled:     Bool(false),
+				ClusterName: String(DefaultHighAvailabilityClusterName),
+				SessionTTL:  TimeDuration(DefaultSessionTTL),
+			},
+		},
+		{
+			"with_cluster_name",
+			&HighAvailabilityConfig{
+				ClusterName: String("cts-cluster"),
+			},
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String("cts-cluster"),
+				SessionTTL:  TimeDuration(DefaultSessionTTL),
+			},
+		},
+		{
+			"enabled",
+			&HighAvailabilityConfig{
+				Enabled: Bool(true),
+			},
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String(DefaultHighAvailabilityClusterName),
+				SessionTTL:  TimeDuration(DefaultSessionTTL),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestHighAvailabilityConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *HighAvailabilityConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"disabled",
+			&HighAvailabilityConfig{Enabled: Bool(false)},
+			true,
+		},
+		{
+			"valid",
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String("cts-cluster"),
+				SessionTTL:  TimeDuration(15 * time.Second),
+			},
+			true,
+		},
+		{
+			"empty_cluster_name",
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String(""),
+				SessionTTL:  TimeDuration(15 * time.Second),
+			},
+			false,
+		},
+		{
+			"session_ttl_too_short",
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String("cts-cluster"),
+				SessionTTL:  TimeDuration(5 * time.Second),
+			},
+			false,
+		},
+		{
+			"session_ttl_too_long",
+			&HighAvailabilityConfig{
+				Enabled:     Bool(true),
+				ClusterName: String("cts-cluster"),
+				SessionTTL:  TimeDuration(48 * time.Hour),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
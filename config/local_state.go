@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+const (
+	// DefaultLocalStatePath is the default path of the local state file. The
+	// path is relative to the working directory.
+	DefaultLocalStatePath = "cts-state.db"
+)
+
+// LocalStateConfig configures persisting the CTS state, such as tasks and task
+// events, to a file on the local filesystem so that the state is restored when
+// CTS restarts. This is an alternative to persisting the state in Consul KV.
+type LocalStateConfig struct {
+	// Enabled determines if the CTS state is persisted to a local file.
+	Enabled *bool `mapstructure:"enabled"`
+
+	// Path is the path of the local state file. A relative path is relative to
+	// the working directory.
+	Path *string `mapstructure:"path"`
+}
+
+// DefaultLocalStateConfig returns the default configuration struct.
+func DefaultLocalStateConfig() *LocalStateConfig {
+	return &LocalStateConfig{
+		// No default values. `Enabled` value depends on other fields as
+		// handled in Finalize()
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *LocalStateConfig) Copy() *LocalStateConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o LocalStateConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	o.Path = StringCopy(c.Path)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *LocalStateConfig) Merge(o *LocalStateConfig) *LocalStateConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	if o.Path != nil {
+		r.Path = StringCopy(o.Path)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *LocalStateConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(StringPresent(c.Path))
+	}
+
+	if c.Path == nil {
+		c.Path = String(DefaultLocalStatePath)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *LocalStateConfig) Validate() error {
+	if c == nil || !BoolVal(c.Enabled) {
+		return nil
+	}
+
+	if StringVal(c.Path) == "" {
+		return fmt.Errorf("local_state.path cannot be empty")
+	}
+
+	return nil
+}
+
+// ResolvePath returns the path of the local state file. A relative path is
+// resolved against the given working directory.
+func (c *LocalStateConfig) ResolvePath(workingDir string) string {
+	path := StringVal(c.Path)
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(workingDir, path)
+}
+
+// GoString defines the printable version of this struct.
+func (c *LocalStateConfig) GoString() string {
+	if c == nil {
+		return "(*LocalStateConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&LocalStateConfig{"+
+		"Enabled:%t, "+
+		"Path:%s"+
+		"}",
+		BoolVal(c.Enabled),
+		StringVal(c.Path),
+	)
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStateConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &LocalStateConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *LocalStateConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&LocalStateConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&LocalStateConfig{
+				Enabled: Bool(true),
+				Path:    String("state.db"),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestLocalStateConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *LocalStateConfig
+		b    *LocalStateConfig
+		r    *LocalStateConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&LocalStateConfig{},
+			&LocalStateConfig{},
+		},
+		{
+			"nil_b",
+			&LocalStateConfig{},
+			nil,
+			&LocalStateConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&LocalStateConfig{},
+			&LocalStateConfig{},
+			&LocalStateConfig{},
+		},
+		{
+			"enabled_overrides",
+			&LocalStateConfig{Enabled: Bool(true)},
+			&LocalStateConfig{Enabled: Bool(false)},
+			&LocalStateConfig{Enabled: Bool(false)},
+		},
+		{
+			"enabled_empty_one",
+			&LocalStateConfig{Enabled: Bool(true)},
+			&LocalStateConfig{},
+			&LocalStateConfig{Enabled: Bool(true)},
+		},
+		{
+			"enabled_empty_two",
+			&LocalStateConfig{},
+			&LocalStateConfig{Enabled: Bool(true)},
+			&LocalStateConfig{Enabled: Bool(true)},
+		},
+		{
+			"path_overrides",
+			&LocalStateConfig{Path: String("a.db")},
+			&LocalStateConfig{Path: String("b.db")},
+			&LocalStateConfig{Path: String("b.db")},
+		},
+		{
+			"path_empty_one",
+			&LocalStateConfig{Path: String("a.db")},
+			&LocalStateConfig{},
+			&LocalStateConfig{Path: String("a.db")},
+		},
+		{
+			"path_empty_two",
+			&LocalStateConfig{},
+			&LocalStateConfig{Path: String("b.db")},
+			&LocalStateConfig{Path: String("b.db")},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestLocalStateConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *LocalStateConfig
+		r    *LocalStateConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&LocalStateConfig{},
+			&LocalStateConfig{
+				Enabled: Bool(false),
+				Path:    String(DefaultLocalStatePath),
+			},
+		},
+		{
+			"with_path",
+			&LocalStateConfig{
+				Path: String("state.db"),
+			},
+			&LocalStateConfig{
+				Enabled: Bool(true),
+				Path:    String("state.db"),
+			},
+		},
+		{
+			"enabled",
+			&LocalStateConfig{
+				Enabled: Bool(true),
+			},
+			&LocalStateConfig{
+				Enabled: Bool(true),
+				Path:    String(DefaultLocalStatePath),
+			},
+		},
+		{
+			"disabled_with_path",
+			&LocalStateConfig{
+				Enabled: Bool(false),
+				Path:    String("state.db"),
+			},
+			&LocalStateConfig{
+				Enabled: Bool(false),
+				Path:    String("state.db"),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestLocalStateConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *LocalStateConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"disabled",
+			&LocalStateConfig{Enabled: Bool(false), Path: String("")},
+			true,
+		},
+		{
+			"valid",
+			&LocalStateConfig{Enabled: Bool(true), Path: String("state.db")},
+			true,
+		},
+		{
+			"empty_path",
+			&LocalStateConfig{Enabled: Bool(true), Path: String("")},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLocalStateConfig_ResolvePath(t *testing.T) {
+	t.Parallel()
+
+	abs, err := filepath.Abs("state.db")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			"relative",
+			"state.db",
+			filepath.Join("sync-tasks", "state.db"),
+		},
+		{
+			"absolute",
+			abs,
+			abs,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			c := &LocalStateConfig{Path: String(tc.path)}
+			assert.Equal(t, tc.expected, c.ResolvePath("sync-tasks"))
+		})
+	}
+}
@@ -67,6 +67,26 @@ func moduleInputToTypeFunc() mapstructure.DecodeHookFunc {
 			return decodeModuleInputToType(c, &config)
 		}
 
+		if c, ok := moduleInputs[intentionsType]; ok {
+			var config IntentionsModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[nodesType]; ok {
+			var config NodesModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[preparedQueryType]; ok {
+			var config PreparedQueryModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
+		if c, ok := moduleInputs[gatewaysType]; ok {
+			var config GatewaysModuleInputConfig
+			return decodeModuleInputToType(c, &config)
+		}
+
 		return nil, fmt.Errorf("unsupported module_input type: %v", data)
 	}
 }
@@ -193,8 +213,8 @@ func (c *ModuleInputConfigs) Validate(services []string, condition ConditionConf
 	// Confirm module_input types are different from task.services variable type
 	if len(services) > 0 {
 
-		// ServicesModuleInput is the only module_input with the same variable
-		// type as task.services
+		// ServicesModuleInput and PreparedQueryModuleInput are the only
+		// module_inputs with the same variable type as task.services
 		servicesType := &ServicesModuleInputConfig{}
 
 		if ok := varTypes[servicesType.VariableType()]; ok {
@@ -209,19 +229,19 @@ func (c *ModuleInputConfigs) Validate(services []string, condition ConditionConf
 		}
 	}
 
-	// Confirm module_input's type is different from condition
-	if condition == nil {
-		return nil
-	}
-	if ok := varTypes[condition.VariableType()]; ok {
-		err := fmt.Errorf("task's condition block and module_input block "+
-			"both monitor %q variable type. condition and module_input "+
-			"variable type must be unique", condition.VariableType())
-		logger.Error("condition and module_input block cannot monitor same "+
-			"variable type. If both are needed, consider combining the "+
-			"module_input with the condition block or creating separate tasks",
-			"error", err)
-		return err
+	// Confirm module_input's type is different from condition, including the
+	// conditions of a composite condition
+	for _, cond := range Conditions(condition) {
+		if ok := varTypes[cond.VariableType()]; ok {
+			err := fmt.Errorf("task's condition block and module_input block "+
+				"both monitor %q variable type. condition and module_input "+
+				"variable type must be unique", cond.VariableType())
+			logger.Error("condition and module_input block cannot monitor same "+
+				"variable type. If both are needed, consider combining the "+
+				"module_input with the condition block or creating separate tasks",
+				"error", err)
+			return err
+		}
 	}
 
 	return nil
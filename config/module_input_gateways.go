@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+var _ ModuleInputConfig = (*GatewaysModuleInputConfig)(nil)
+
+// GatewaysModuleInputConfig configures a module_input configuration block
+// of type 'gateways'. The gateway instances and their linked services will be
+// used as input for the gateways variable.
+type GatewaysModuleInputConfig struct {
+	GatewaysMonitorConfig `mapstructure:",squash" json:"gateways"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *GatewaysModuleInputConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	m, ok := c.GatewaysMonitorConfig.Copy().(*GatewaysMonitorConfig)
+	if !ok {
+		return nil
+	}
+	return &GatewaysModuleInputConfig{
+		GatewaysMonitorConfig: *m,
+	}
+}
+
+// Merge combines all values in this configuration `c` with the values in the other
+// configuration `o`, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *GatewaysModuleInputConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isModuleInputNil(o) { // o is interface, use isModuleInputNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isModuleInputNil(o) {
+		return c.Copy()
+	}
+
+	imc, ok := o.(*GatewaysModuleInputConfig)
+	if !ok {
+		return nil
+	}
+
+	merged, ok := c.GatewaysMonitorConfig.Merge(&imc.GatewaysMonitorConfig).(*GatewaysMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	return &GatewaysModuleInputConfig{
+		GatewaysMonitorConfig: *merged,
+	}
+}
+
+// Finalize ensures there are no nil pointers.
+func (c *GatewaysModuleInputConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+	c.GatewaysMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *GatewaysModuleInputConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.GatewaysMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `module_input \"gateways\"` "+
+			"block: %s", err)
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *GatewaysModuleInputConfig) GoString() string {
+	if c == nil {
+		return "(*GatewaysModuleInputConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&GatewaysModuleInputConfig{"+
+		"%s"+
+		"}",
+		c.GatewaysMonitorConfig.GoString(),
+	)
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewaysModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &GatewaysModuleInputConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *GatewaysModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&GatewaysModuleInputConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names:      []string{"mesh-gateway", "ingress-gateway"},
+					Datacenter: String("dc2"),
+					Namespace:  String("ns"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestGatewaysModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *GatewaysModuleInputConfig
+		b    *GatewaysModuleInputConfig
+		r    *GatewaysModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&GatewaysModuleInputConfig{},
+			&GatewaysModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&GatewaysModuleInputConfig{},
+			nil,
+			&GatewaysModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"happy_path",
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names:      []string{"mesh-gateway"},
+					Datacenter: String("dc1"),
+				},
+			},
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names:      []string{"ingress-gateway"},
+					Datacenter: String("dc2"),
+					Namespace:  String("ns"),
+				},
+			},
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names:      []string{"mesh-gateway", "ingress-gateway"},
+					Datacenter: String("dc2"),
+					Namespace:  String("ns"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestGatewaysModuleInputConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	c := &GatewaysModuleInputConfig{
+		GatewaysMonitorConfig{
+			Names: []string{"mesh-gateway"},
+		},
+	}
+	c.Finalize()
+
+	expected := &GatewaysModuleInputConfig{
+		GatewaysMonitorConfig{
+			Names:      []string{"mesh-gateway"},
+			Datacenter: String(""),
+			Namespace:  String(""),
+		},
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestGatewaysModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *GatewaysModuleInputConfig
+	}{
+		{
+			"happy_path",
+			false,
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names: []string{"mesh-gateway"},
+				},
+			},
+		},
+		{
+			"missing_names",
+			true,
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+		},
+		{
+			"empty_name",
+			true,
+			&GatewaysModuleInputConfig{
+				GatewaysMonitorConfig{
+					Names: []string{"mesh-gateway", ""},
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGatewaysModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	i := &GatewaysModuleInputConfig{
+		GatewaysMonitorConfig{
+			Names:      []string{"mesh-gateway", "ingress-gateway"},
+			Datacenter: String("dc"),
+			Namespace:  String("ns"),
+		},
+	}
+	expected := "&GatewaysModuleInputConfig{&GatewaysMonitorConfig{" +
+		"Names:[mesh-gateway ingress-gateway], Datacenter:dc, Namespace:ns}}"
+	assert.Equal(t, expected, i.GoString())
+}
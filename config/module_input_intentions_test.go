@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntentionsModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &IntentionsModuleInputConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *IntentionsModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&IntentionsModuleInputConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&IntentionsModuleInputConfig{
+				IntentionsMonitorConfig{
+					Datacenter:          String("dc2"),
+					Namespace:           String("ns2"),
+					SourceServices:      []string{"api"},
+					DestinationServices: []string{"db"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *IntentionsModuleInputConfig
+		b    *IntentionsModuleInputConfig
+		r    *IntentionsModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&IntentionsModuleInputConfig{},
+			&IntentionsModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&IntentionsModuleInputConfig{},
+			nil,
+			&IntentionsModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"happy_path",
+			&IntentionsModuleInputConfig{
+				IntentionsMonitorConfig{
+					Namespace:      String("ns1"),
+					SourceServices: []string{"api"},
+				},
+			},
+			&IntentionsModuleInputConfig{
+				IntentionsMonitorConfig{
+					Namespace:           String("ns2"),
+					DestinationServices: []string{"db"},
+				},
+			},
+			&IntentionsModuleInputConfig{
+				IntentionsMonitorConfig{
+					Namespace:           String("ns2"),
+					SourceServices:      []string{"api"},
+					DestinationServices: []string{"db"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestIntentionsModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *IntentionsModuleInputConfig
+	}{
+		{
+			"happy_path",
+			false,
+			&IntentionsModuleInputConfig{
+				IntentionsMonitorConfig{
+					SourceServices: []string{"api"},
+				},
+			},
+		},
+		{
+			"no_services",
+			true,
+			&IntentionsModuleInputConfig{},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntentionsModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	i := &IntentionsModuleInputConfig{
+		IntentionsMonitorConfig{
+			Datacenter:          String("dc"),
+			Namespace:           String("ns"),
+			SourceServices:      []string{"api"},
+			DestinationServices: []string{"db"},
+		},
+	}
+	expected := "&IntentionsModuleInputConfig{&IntentionsMonitorConfig{" +
+		"Datacenter:dc, Namespace:ns, SourceServices:[api], " +
+		"DestinationServices:[db]}}"
+	assert.Equal(t, expected, i.GoString())
+}
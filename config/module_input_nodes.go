@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+var _ ModuleInputConfig = (*NodesModuleInputConfig)(nil)
+
+// NodesModuleInputConfig configures a module_input configuration block of
+// type 'nodes'. The Consul catalog nodes will be used as input for the
+// module variables.
+type NodesModuleInputConfig struct {
+	NodesMonitorConfig `mapstructure:",squash" json:"nodes"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *NodesModuleInputConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	m, ok := c.NodesMonitorConfig.Copy().(*NodesMonitorConfig)
+	if !ok {
+		return nil
+	}
+	return &NodesModuleInputConfig{
+		NodesMonitorConfig: *m,
+	}
+}
+
+// Merge combines all values in this configuration `c` with the values in the other
+// configuration `o`, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *NodesModuleInputConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isModuleInputNil(o) { // o is interface, use isModuleInputNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isModuleInputNil(o) {
+		return c.Copy()
+	}
+
+	imc, ok := o.(*NodesModuleInputConfig)
+	if !ok {
+		return nil
+	}
+
+	merged, ok := c.NodesMonitorConfig.Merge(&imc.NodesMonitorConfig).(*NodesMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	return &NodesModuleInputConfig{
+		NodesMonitorConfig: *merged,
+	}
+}
+
+// Finalize ensures there are no nil pointers.
+func (c *NodesModuleInputConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+	c.NodesMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *NodesModuleInputConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.NodesMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `module_input \"nodes\"` "+
+			"block: %s", err)
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *NodesModuleInputConfig) GoString() string {
+	if c == nil {
+		return "(*NodesModuleInputConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&NodesModuleInputConfig{"+
+		"%s"+
+		"}",
+		c.NodesMonitorConfig.GoString(),
+	)
+}
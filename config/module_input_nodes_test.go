@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &NodesModuleInputConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *NodesModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&NodesModuleInputConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					Datacenter: String("dc2"),
+					NodeMeta:   map[string]string{"key": "value"},
+					Filter:     String("filter"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestNodesModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NodesModuleInputConfig
+		b    *NodesModuleInputConfig
+		r    *NodesModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&NodesModuleInputConfig{},
+			&NodesModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&NodesModuleInputConfig{},
+			nil,
+			&NodesModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"happy_path",
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					Datacenter: String("dc1"),
+					NodeMeta:   map[string]string{"foo": "bar"},
+				},
+			},
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					Datacenter: String("dc2"),
+					Filter:     String("filter"),
+				},
+			},
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					Datacenter: String("dc2"),
+					NodeMeta:   map[string]string{"foo": "bar"},
+					Filter:     String("filter"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestNodesModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *NodesModuleInputConfig
+	}{
+		{
+			"happy_path",
+			false,
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					NodeMeta: map[string]string{"key": "value"},
+				},
+			},
+		},
+		{
+			"empty_node_meta_key",
+			true,
+			&NodesModuleInputConfig{
+				NodesMonitorConfig{
+					NodeMeta: map[string]string{"": "value"},
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNodesModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	i := &NodesModuleInputConfig{
+		NodesMonitorConfig{
+			Datacenter: String("dc"),
+			NodeMeta:   map[string]string{"key": "value"},
+			Filter:     String("filter"),
+		},
+	}
+	expected := "&NodesModuleInputConfig{&NodesMonitorConfig{" +
+		"Datacenter:dc, NodeMeta:map[key:value], Filter:filter}}"
+	assert.Equal(t, expected, i.GoString())
+}
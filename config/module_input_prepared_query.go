@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+var _ ModuleInputConfig = (*PreparedQueryModuleInputConfig)(nil)
+
+// PreparedQueryModuleInputConfig configures a module_input configuration block
+// of type 'prepared-query'. The service instances returned by executing the
+// Consul prepared query will be used as input for the services variable.
+type PreparedQueryModuleInputConfig struct {
+	PreparedQueryMonitorConfig `mapstructure:",squash" json:"prepared-query"`
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PreparedQueryModuleInputConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	m, ok := c.PreparedQueryMonitorConfig.Copy().(*PreparedQueryMonitorConfig)
+	if !ok {
+		return nil
+	}
+	return &PreparedQueryModuleInputConfig{
+		PreparedQueryMonitorConfig: *m,
+	}
+}
+
+// Merge combines all values in this configuration `c` with the values in the other
+// configuration `o`, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *PreparedQueryModuleInputConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isModuleInputNil(o) { // o is interface, use isModuleInputNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isModuleInputNil(o) {
+		return c.Copy()
+	}
+
+	imc, ok := o.(*PreparedQueryModuleInputConfig)
+	if !ok {
+		return nil
+	}
+
+	merged, ok := c.PreparedQueryMonitorConfig.Merge(&imc.PreparedQueryMonitorConfig).(*PreparedQueryMonitorConfig)
+	if !ok {
+		return nil
+	}
+
+	return &PreparedQueryModuleInputConfig{
+		PreparedQueryMonitorConfig: *merged,
+	}
+}
+
+// Finalize ensures there are no nil pointers.
+func (c *PreparedQueryModuleInputConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+	c.PreparedQueryMonitorConfig.Finalize()
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PreparedQueryModuleInputConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if err := c.PreparedQueryMonitorConfig.Validate(); err != nil {
+		return fmt.Errorf("error validating `module_input \"prepared-query\"` "+
+			"block: %s", err)
+	}
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PreparedQueryModuleInputConfig) GoString() string {
+	if c == nil {
+		return "(*PreparedQueryModuleInputConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&PreparedQueryModuleInputConfig{"+
+		"%s"+
+		"}",
+		c.PreparedQueryMonitorConfig.GoString(),
+	)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreparedQueryModuleInputConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &PreparedQueryModuleInputConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *PreparedQueryModuleInputConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&PreparedQueryModuleInputConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Name:       String("api-failover"),
+					Datacenter: String("dc2"),
+					Near:       String("_agent"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Copy()
+			if tc.a == nil {
+				// returned nil interface has nil type, which is unequal to tc.a
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.a, r)
+			}
+		})
+	}
+}
+
+func TestPreparedQueryModuleInputConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *PreparedQueryModuleInputConfig
+		b    *PreparedQueryModuleInputConfig
+		r    *PreparedQueryModuleInputConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&PreparedQueryModuleInputConfig{},
+			&PreparedQueryModuleInputConfig{},
+		},
+		{
+			"nil_b",
+			&PreparedQueryModuleInputConfig{},
+			nil,
+			&PreparedQueryModuleInputConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"happy_path",
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Name:       String("api-failover"),
+					Datacenter: String("dc1"),
+				},
+			},
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Datacenter: String("dc2"),
+					Near:       String("_agent"),
+				},
+			},
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Name:       String("api-failover"),
+					Datacenter: String("dc2"),
+					Near:       String("_agent"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			if tc.r == nil {
+				// returned nil interface has nil type, which is unequal to tc.r
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, tc.r, r)
+			}
+		})
+	}
+}
+
+func TestPreparedQueryModuleInputConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	c := &PreparedQueryModuleInputConfig{
+		PreparedQueryMonitorConfig{
+			Name: String("api-failover"),
+		},
+	}
+	c.Finalize()
+
+	expected := &PreparedQueryModuleInputConfig{
+		PreparedQueryMonitorConfig{
+			Name:       String("api-failover"),
+			Datacenter: String(""),
+			Near:       String(""),
+		},
+	}
+	assert.Equal(t, expected, c)
+}
+
+func TestPreparedQueryModuleInputConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		expectErr bool
+		c         *PreparedQueryModuleInputConfig
+	}{
+		{
+			"happy_path",
+			false,
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Name: String("api-failover"),
+				},
+			},
+		},
+		{
+			"missing_name",
+			true,
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Datacenter: String("dc2"),
+				},
+			},
+		},
+		{
+			"empty_name",
+			true,
+			&PreparedQueryModuleInputConfig{
+				PreparedQueryMonitorConfig{
+					Name: String(""),
+				},
+			},
+		},
+		{
+			"nil",
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.c.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreparedQueryModuleInputConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	i := &PreparedQueryModuleInputConfig{
+		PreparedQueryMonitorConfig{
+			Name:       String("api-failover"),
+			Datacenter: String("dc"),
+			Near:       String("_agent"),
+		},
+	}
+	expected := "&PreparedQueryModuleInputConfig{&PreparedQueryMonitorConfig{" +
+		"Name:api-failover, Datacenter:dc, Near:_agent}}"
+	assert.Equal(t, expected, i.GoString())
+}
@@ -247,9 +247,12 @@ func TestGoString(t *testing.T) {
 				"Regexp:^api$, " +
 				"Names:[], " +
 				"Datacenter:dc2, " +
+				"Datacenters:[], " +
+				"Peers:[], " +
 				"Namespace:ns2, " +
 				"Filter:some-filter, " +
-				"CTSUserDefinedMeta:map[key:value]" +
+				"CTSUserDefinedMeta:map[key:value], " +
+				"SignificantFields:[]" +
 				"}" +
 				"}",
 		},
@@ -44,6 +44,73 @@ task {
 		datacenter = "dc2"
 		recurse = true
 	}
+}`
+	testModuleInputIntentionsSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "intentions" {
+		datacenter = "dc2"
+		namespace = "ns2"
+		source_services = ["api"]
+		destination_services = ["db"]
+	}
+}`
+	testModuleInputNodesSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "nodes" {
+		datacenter = "dc2"
+		node_meta {
+			rack = "rack-1"
+		}
+		filter = "Meta.env == prod"
+	}
+}`
+	testModuleInputServicesAggregateSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "services" {
+		names = ["api"]
+		datacenters = ["dc1", "dc2"]
+		peers = ["peer-a"]
+	}
+}`
+	testModuleInputPreparedQuerySuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "prepared-query" {
+		name = "api-failover"
+		datacenter = "dc2"
+		near = "_agent"
+	}
+}`
+	testModuleInputGatewaysSuccess = `
+task {
+	name = "module_input_task"
+	module = "..."
+	condition "schedule" {
+		cron = "* * * * * * *"
+	}
+	module_input "gateways" {
+		names = ["mesh-gateway", "ingress-gateway"]
+		datacenter = "dc2"
+	}
 }`
 	testModuleInputsSuccess = `
 task {
@@ -128,6 +195,76 @@ func TestModuleInput_DecodeConfig_Success(t *testing.T) {
 			},
 			config: testModuleInputConsulKVSuccess,
 		},
+		{
+			name: "intentions",
+			expected: &ModuleInputConfigs{
+				&IntentionsModuleInputConfig{
+					IntentionsMonitorConfig{
+						Datacenter:          String("dc2"),
+						Namespace:           String("ns2"),
+						SourceServices:      []string{"api"},
+						DestinationServices: []string{"db"},
+					},
+				},
+			},
+			config: testModuleInputIntentionsSuccess,
+		},
+		{
+			name: "nodes",
+			expected: &ModuleInputConfigs{
+				&NodesModuleInputConfig{
+					NodesMonitorConfig{
+						Datacenter: String("dc2"),
+						NodeMeta:   map[string]string{"rack": "rack-1"},
+						Filter:     String("Meta.env == prod"),
+					},
+				},
+			},
+			config: testModuleInputNodesSuccess,
+		},
+		{
+			name: "services with datacenters and peers",
+			expected: &ModuleInputConfigs{
+				&ServicesModuleInputConfig{
+					ServicesMonitorConfig{
+						Names:              []string{"api"},
+						Datacenter:         String(""),
+						Datacenters:        []string{"dc1", "dc2"},
+						Peers:              []string{"peer-a"},
+						Namespace:          String(""),
+						Filter:             String(""),
+						CTSUserDefinedMeta: map[string]string{},
+					},
+				},
+			},
+			config: testModuleInputServicesAggregateSuccess,
+		},
+		{
+			name: "prepared-query",
+			expected: &ModuleInputConfigs{
+				&PreparedQueryModuleInputConfig{
+					PreparedQueryMonitorConfig{
+						Name:       String("api-failover"),
+						Datacenter: String("dc2"),
+						Near:       String("_agent"),
+					},
+				},
+			},
+			config: testModuleInputPreparedQuerySuccess,
+		},
+		{
+			name: "gateways",
+			expected: &ModuleInputConfigs{
+				&GatewaysModuleInputConfig{
+					GatewaysMonitorConfig{
+						Names:      []string{"mesh-gateway", "ingress-gateway"},
+						Datacenter: String("dc2"),
+						Namespace:  String(""),
+					},
+				},
+			},
+			config: testModuleInputGatewaysSuccess,
+		},
 		{
 			name: "multiple unique module_inputs",
 			expected: &ModuleInputConfigs{
@@ -475,6 +612,22 @@ func TestModuleInputConfigs_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name:     "invalid: services & prepared-query module_input configured",
+			services: []string{"api"},
+			moduleInputs: &ModuleInputConfigs{
+				&PreparedQueryModuleInputConfig{},
+			},
+			valid: false,
+		},
+		{
+			name:      "invalid: services cond & prepared-query module_input",
+			condition: &ServicesConditionConfig{},
+			moduleInputs: &ModuleInputConfigs{
+				&PreparedQueryModuleInputConfig{},
+			},
+			valid: false,
+		},
 		{
 			name:      "invalid: cond & module_input same type",
 			condition: &ConsulKVConditionConfig{},
@@ -483,6 +636,21 @@ func TestModuleInputConfigs_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid: composite cond & module_input same type",
+			condition: &CompositeConditionConfig{
+				CompositeMonitorConfig{
+					Conditions: ConditionConfigs{
+						&ScheduleConditionConfig{},
+						&ConsulKVConditionConfig{},
+					},
+				},
+			},
+			moduleInputs: &ModuleInputConfigs{
+				&ConsulKVModuleInputConfig{},
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -525,7 +693,8 @@ func TestModuleInputConfigs_GoString(t *testing.T) {
 				},
 			},
 			"{&ServicesModuleInputConfig{&ServicesMonitorConfig{Regexp:^api$, Names:[], " +
-				"Datacenter:, Namespace:, Filter:, CTSUserDefinedMeta:map[]}}, " +
+				"Datacenter:, Datacenters:[], Peers:[], Namespace:, Filter:, " +
+				"CTSUserDefinedMeta:map[], SignificantFields:[]}}, " +
 				"&ConsulKVModuleInputConfig{&ConsulKVMonitorConfig{Path:my/path, " +
 				"Recurse:false, Datacenter:, Namespace:, }}}",
 		},
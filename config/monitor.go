@@ -43,12 +43,28 @@ func isMonitorNil(c MonitorConfig) bool {
 		result = v == nil
 	case *ScheduleConditionConfig:
 		result = v == nil
+	case *HealthChecksConditionConfig:
+		result = v == nil
+	case *IntentionsConditionConfig:
+		result = v == nil
+	case *NodesConditionConfig:
+		result = v == nil
+	case *CompositeConditionConfig:
+		result = v == nil
 
 	// Module Inputs
 	case *ServicesModuleInputConfig:
 		result = v == nil
 	case *ConsulKVModuleInputConfig:
 		result = v == nil
+	case *IntentionsModuleInputConfig:
+		result = v == nil
+	case *NodesModuleInputConfig:
+		result = v == nil
+	case *PreparedQueryModuleInputConfig:
+		result = v == nil
+	case *GatewaysModuleInputConfig:
+		result = v == nil
 	default:
 		return c == nil || reflect.ValueOf(c).IsNil()
 	}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+const gatewaysType = "gateways"
+
+var _ MonitorConfig = (*GatewaysMonitorConfig)(nil)
+
+// GatewaysMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'gateways'. A gateways monitor watches for changes
+// to the instances of Consul service mesh gateways, i.e. mesh, ingress,
+// terminating and API gateways, and to the services linked to the gateways.
+type GatewaysMonitorConfig struct {
+	// Names configures the gateways to monitor by listing the service names
+	// of the gateways.
+	Names []string `mapstructure:"names" json:"names"`
+
+	// Datacenter is the datacenter to query for the gateways.
+	Datacenter *string `mapstructure:"datacenter" json:"datacenter"`
+
+	// Namespace is the namespace of the gateways (Consul Enterprise only). If
+	// not provided, the namespace will be inferred from the CTS ACL token, or
+	// default to the `default` namespace.
+	Namespace *string `mapstructure:"namespace" json:"namespace"`
+}
+
+// VariableType returns the type of variable that a gateways monitor monitors.
+func (c *GatewaysMonitorConfig) VariableType() string {
+	return "gateways"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *GatewaysMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o GatewaysMonitorConfig
+	if c.Names != nil {
+		o.Names = make([]string, 0, len(c.Names))
+		o.Names = append(o.Names, c.Names...)
+	}
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Namespace = StringCopy(c.Namespace)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *GatewaysMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isMonitorNil(o) { // o is interface, use isMonitorNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isMonitorNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*GatewaysMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*GatewaysMonitorConfig)
+
+	r2.Names = mergeSlices(r2.Names, o2.Names)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Namespace != nil {
+		r2.Namespace = StringCopy(o2.Namespace)
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *GatewaysMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Names == nil {
+		c.Names = []string{}
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Namespace == nil {
+		c.Namespace = String("")
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *GatewaysMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if len(c.Names) == 0 {
+		return fmt.Errorf("names field is required")
+	}
+
+	for _, name := range c.Names {
+		if name == "" {
+			return fmt.Errorf("names field includes empty string(s). " +
+				"gateway names cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *GatewaysMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*GatewaysMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&GatewaysMonitorConfig{"+
+		"Names:%s, "+
+		"Datacenter:%s, "+
+		"Namespace:%s"+
+		"}",
+		c.Names,
+		StringVal(c.Datacenter),
+		StringVal(c.Namespace),
+	)
+}
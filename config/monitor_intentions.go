@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+const intentionsType = "intentions"
+
+var _ MonitorConfig = (*IntentionsMonitorConfig)(nil)
+
+// IntentionsMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'intentions'. An intentions monitor watches for
+// changes that occur to the Consul intentions of the configured source and
+// destination services.
+type IntentionsMonitorConfig struct {
+	// Datacenter is the datacenter to query for intentions.
+	Datacenter *string `mapstructure:"datacenter" json:"datacenter"`
+
+	// Namespace is the namespace to query for intentions (Consul Enterprise
+	// only). If not provided, the namespace will be inferred from the CTS ACL
+	// token, or default to the `default` namespace.
+	Namespace *string `mapstructure:"namespace" json:"namespace"`
+
+	// SourceServices configures the intentions to monitor by the name of their
+	// source service. Intentions with a wildcard source also apply to the
+	// configured services and are monitored.
+	SourceServices []string `mapstructure:"source_services" json:"source_services"`
+
+	// DestinationServices configures the intentions to monitor by the name of
+	// their destination service. Intentions with a wildcard destination also
+	// apply to the configured services and are monitored.
+	DestinationServices []string `mapstructure:"destination_services" json:"destination_services"`
+}
+
+func (c *IntentionsMonitorConfig) VariableType() string {
+	return "intentions"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *IntentionsMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o IntentionsMonitorConfig
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Namespace = StringCopy(c.Namespace)
+
+	if c.SourceServices != nil {
+		o.SourceServices = make([]string, 0, len(c.SourceServices))
+		o.SourceServices = append(o.SourceServices, c.SourceServices...)
+	}
+
+	if c.DestinationServices != nil {
+		o.DestinationServices = make([]string, 0, len(c.DestinationServices))
+		o.DestinationServices = append(o.DestinationServices, c.DestinationServices...)
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *IntentionsMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*IntentionsMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*IntentionsMonitorConfig)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Namespace != nil {
+		r2.Namespace = StringCopy(o2.Namespace)
+	}
+
+	r2.SourceServices = mergeSlices(r2.SourceServices, o2.SourceServices)
+	r2.DestinationServices = mergeSlices(r2.DestinationServices, o2.DestinationServices)
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *IntentionsMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Namespace == nil {
+		c.Namespace = String("")
+	}
+
+	if c.SourceServices == nil {
+		c.SourceServices = []string{}
+	}
+
+	if c.DestinationServices == nil {
+		c.DestinationServices = []string{}
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *IntentionsMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if len(c.SourceServices) == 0 && len(c.DestinationServices) == 0 {
+		return fmt.Errorf("either the source_services or destination_services " +
+			"field must be configured")
+	}
+
+	for _, name := range c.SourceServices {
+		if name == "" {
+			return fmt.Errorf("source_services field includes empty " +
+				"string(s). services names cannot be empty")
+		}
+	}
+
+	for _, name := range c.DestinationServices {
+		if name == "" {
+			return fmt.Errorf("destination_services field includes empty " +
+				"string(s). services names cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *IntentionsMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*IntentionsMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&IntentionsMonitorConfig{"+
+		"Datacenter:%s, "+
+		"Namespace:%s, "+
+		"SourceServices:%s, "+
+		"DestinationServices:%s"+
+		"}",
+		StringVal(c.Datacenter),
+		StringVal(c.Namespace),
+		c.SourceServices,
+		c.DestinationServices,
+	)
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+const nodesType = "nodes"
+
+var _ MonitorConfig = (*NodesMonitorConfig)(nil)
+
+// NodesMonitorConfig configures a configuration block adhering to the monitor
+// interface of type 'nodes'. A nodes monitor watches for changes that occur to
+// the nodes registered in the Consul catalog.
+type NodesMonitorConfig struct {
+	// Datacenter is the datacenter to query for nodes.
+	Datacenter *string `mapstructure:"datacenter" json:"datacenter"`
+
+	// NodeMeta configures the nodes to monitor by their node metadata
+	// key/value pairs. Nodes must have all of the key/value pairs.
+	NodeMeta map[string]string `mapstructure:"node_meta" json:"node_meta"`
+
+	// Filter is used to filter nodes based on a Consul compatible filter
+	// expression.
+	Filter *string `mapstructure:"filter" json:"filter"`
+}
+
+func (c *NodesMonitorConfig) VariableType() string {
+	return "nodes"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *NodesMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o NodesMonitorConfig
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Filter = StringCopy(c.Filter)
+
+	if c.NodeMeta != nil {
+		o.NodeMeta = make(map[string]string)
+		for k, v := range c.NodeMeta {
+			o.NodeMeta[k] = v
+		}
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *NodesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isConditionNil(o) { // o is interface, use isConditionNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isConditionNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*NodesMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*NodesMonitorConfig)
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Filter != nil {
+		r2.Filter = StringCopy(o2.Filter)
+	}
+
+	if o2.NodeMeta != nil {
+		if r2.NodeMeta == nil {
+			r2.NodeMeta = make(map[string]string)
+		}
+		for k, v := range o2.NodeMeta {
+			r2.NodeMeta[k] = v
+		}
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *NodesMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Filter == nil {
+		c.Filter = String("")
+	}
+
+	if c.NodeMeta == nil {
+		c.NodeMeta = make(map[string]string)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *NodesMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	for k := range c.NodeMeta {
+		if k == "" {
+			return fmt.Errorf("node_meta field includes an empty key. " +
+				"node metadata keys cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *NodesMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*NodesMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&NodesMonitorConfig{"+
+		"Datacenter:%s, "+
+		"NodeMeta:%s, "+
+		"Filter:%s"+
+		"}",
+		StringVal(c.Datacenter),
+		c.NodeMeta,
+		StringVal(c.Filter),
+	)
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+)
+
+const preparedQueryType = "prepared-query"
+
+var _ MonitorConfig = (*PreparedQueryMonitorConfig)(nil)
+
+// PreparedQueryMonitorConfig configures a configuration block adhering to the
+// monitor interface of type 'prepared-query'. A prepared query monitor watches
+// for changes to the results of executing a Consul prepared query, which
+// includes the service instances of a failover datacenter when the query fails
+// over.
+type PreparedQueryMonitorConfig struct {
+	// Name is the name or ID of the prepared query to execute.
+	Name *string `mapstructure:"name" json:"name"`
+
+	// Datacenter is the datacenter to execute the prepared query in.
+	Datacenter *string `mapstructure:"datacenter" json:"datacenter"`
+
+	// Near is the node name to sort the service instances by network round
+	// trip time. The special value "_agent" sorts by the agent's node.
+	Near *string `mapstructure:"near" json:"near"`
+}
+
+// VariableType returns the type of variable that a prepared query monitors.
+// The results of the query are service instances, so the variable type is
+// "services".
+func (c *PreparedQueryMonitorConfig) VariableType() string {
+	return "services"
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PreparedQueryMonitorConfig) Copy() MonitorConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o PreparedQueryMonitorConfig
+	o.Name = StringCopy(c.Name)
+	o.Datacenter = StringCopy(c.Datacenter)
+	o.Near = StringCopy(c.Near)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *PreparedQueryMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
+	if c == nil {
+		if isMonitorNil(o) { // o is interface, use isMonitorNil()
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if isMonitorNil(o) {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+	o2, ok := o.(*PreparedQueryMonitorConfig)
+	if !ok {
+		return r
+	}
+
+	r2 := r.(*PreparedQueryMonitorConfig)
+
+	if o2.Name != nil {
+		r2.Name = StringCopy(o2.Name)
+	}
+
+	if o2.Datacenter != nil {
+		r2.Datacenter = StringCopy(o2.Datacenter)
+	}
+
+	if o2.Near != nil {
+		r2.Near = StringCopy(o2.Near)
+	}
+
+	return r2
+}
+
+// Finalize ensures there no nil pointers.
+func (c *PreparedQueryMonitorConfig) Finalize() {
+	if c == nil { // config not required, return early
+		return
+	}
+
+	if c.Name == nil {
+		c.Name = String("")
+	}
+
+	if c.Datacenter == nil {
+		c.Datacenter = String("")
+	}
+
+	if c.Near == nil {
+		c.Near = String("")
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *PreparedQueryMonitorConfig) Validate() error {
+	if c == nil { // config not required, return early
+		return nil
+	}
+
+	if c.Name == nil || *c.Name == "" {
+		return fmt.Errorf("name field is required")
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PreparedQueryMonitorConfig) GoString() string {
+	if c == nil {
+		return "(*PreparedQueryMonitorConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&PreparedQueryMonitorConfig{"+
+		"Name:%s, "+
+		"Datacenter:%s, "+
+		"Near:%s"+
+		"}",
+		StringVal(c.Name),
+		StringVal(c.Datacenter),
+		StringVal(c.Near),
+	)
+}
@@ -10,6 +10,23 @@ import (
 
 const servicesType = "services"
 
+// Fields of service instances that can be configured as significant fields
+const (
+	SignificantFieldAddress = "address"
+	SignificantFieldPort    = "port"
+	SignificantFieldTags    = "tags"
+	SignificantFieldMeta    = "meta"
+	SignificantFieldStatus  = "status"
+)
+
+var significantFields = []string{
+	SignificantFieldAddress,
+	SignificantFieldPort,
+	SignificantFieldTags,
+	SignificantFieldMeta,
+	SignificantFieldStatus,
+}
+
 var _ MonitorConfig = (*ServicesMonitorConfig)(nil)
 
 // ServicesMonitorConfig configures a configuration block adhering to the
@@ -29,6 +46,17 @@ type ServicesMonitorConfig struct {
 	// Datacenter is the datacenter the service is deployed in.
 	Datacenter *string `mapstricture:"datacenter" json:"datacenter"`
 
+	// Datacenters configures the datacenters to aggregate the service instances
+	// from. Cannot be configured with Datacenter. When Datacenters is unset, it
+	// will retain a nil value even after Finalize().
+	Datacenters []string `mapstructure:"datacenters" json:"datacenters"`
+
+	// Peers configures the Consul cluster peers to aggregate the service
+	// instances from, in addition to the instances of the datacenter(s). The
+	// instances are imported from the peers into the local datacenter. When
+	// Peers is unset, it will retain a nil value even after Finalize().
+	Peers []string `mapstructure:"peers" json:"peers"`
+
 	// Namespace is the namespace of the service (Consul Enterprise only). If
 	// not provided, the namespace will be inferred from the CTS ACL token, or
 	// default to the `default` namespace.
@@ -41,6 +69,14 @@ type ServicesMonitorConfig struct {
 	// CTSUserDefinedMeta is metadata added to a service automated by CTS for
 	// network infrastructure automation.
 	CTSUserDefinedMeta map[string]string `mapstructure:"cts_user_defined_meta" json:"cts_user_defined_meta"`
+
+	// SignificantFields configures the fields of the service instances whose
+	// changes are significant: address, port, tags, meta, and status. When only
+	// other fields of the instances change, the task is not run. Instances
+	// being registered or deregistered are always significant. When
+	// SignificantFields is unset, it will retain a nil value even after
+	// Finalize() and changes to any field are significant.
+	SignificantFields []string `mapstructure:"significant_fields" json:"significant_fields"`
 }
 
 func (c *ServicesMonitorConfig) VariableType() string {
@@ -63,6 +99,16 @@ func (c *ServicesMonitorConfig) Copy() MonitorConfig {
 
 	o.Datacenter = StringCopy(c.Datacenter)
 
+	if c.Datacenters != nil {
+		o.Datacenters = make([]string, 0, len(c.Datacenters))
+		o.Datacenters = append(o.Datacenters, c.Datacenters...)
+	}
+
+	if c.Peers != nil {
+		o.Peers = make([]string, 0, len(c.Peers))
+		o.Peers = append(o.Peers, c.Peers...)
+	}
+
 	o.Namespace = StringCopy(c.Namespace)
 
 	o.Filter = StringCopy(c.Filter)
@@ -74,6 +120,11 @@ func (c *ServicesMonitorConfig) Copy() MonitorConfig {
 		}
 	}
 
+	if c.SignificantFields != nil {
+		o.SignificantFields = make([]string, 0, len(c.SignificantFields))
+		o.SignificantFields = append(o.SignificantFields, c.SignificantFields...)
+	}
+
 	return &o
 }
 
@@ -110,6 +161,8 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 	if o2.Datacenter != nil {
 		r2.Datacenter = StringCopy(o2.Datacenter)
 	}
+	r2.Datacenters = mergeSlices(r2.Datacenters, o2.Datacenters)
+	r2.Peers = mergeSlices(r2.Peers, o2.Peers)
 	if o2.Namespace != nil {
 		r2.Namespace = StringCopy(o2.Namespace)
 	}
@@ -124,6 +177,7 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 			r2.CTSUserDefinedMeta[k] = v
 		}
 	}
+	r2.SignificantFields = mergeSlices(r2.SignificantFields, o2.SignificantFields)
 
 	return r2
 }
@@ -135,6 +189,9 @@ func (c *ServicesMonitorConfig) Merge(o MonitorConfig) MonitorConfig {
 //     empty string regex ("" regex pattern) at Validate().
 //   - Setting `Regexp` as an empty string is not idempotent. There is a need to
 //     call Finalize() and Validate() multiple times.
+//
+// Exception: `Datacenters`, `Peers`, and `SignificantFields` are optional and
+// are not finalized.
 func (c *ServicesMonitorConfig) Finalize() {
 	if c == nil { // config not required, return early
 		return
@@ -194,6 +251,32 @@ func (c *ServicesMonitorConfig) Validate() error {
 		}
 	}
 
+	// Check that the datacenter is configured in only one way
+	if len(c.Datacenters) > 0 && StringVal(c.Datacenter) != "" {
+		return fmt.Errorf("datacenter and datacenters fields cannot both be " +
+			"configured. Consider including the datacenter in the list of " +
+			"datacenters")
+	}
+	for _, dc := range c.Datacenters {
+		if dc == "" {
+			return fmt.Errorf("datacenters field includes empty string(s). " +
+				"datacenters cannot be empty")
+		}
+	}
+	for _, peer := range c.Peers {
+		if peer == "" {
+			return fmt.Errorf("peers field includes empty string(s). " +
+				"peers cannot be empty")
+		}
+	}
+
+	for _, field := range c.SignificantFields {
+		if !isSignificantField(field) {
+			return fmt.Errorf("significant_fields field includes unsupported "+
+				"field %q. supported fields are %v", field, significantFields)
+		}
+	}
+
 	return nil
 }
 
@@ -207,15 +290,32 @@ func (c *ServicesMonitorConfig) GoString() string {
 		"Regexp:%s, "+
 		"Names:%s, "+
 		"Datacenter:%s, "+
+		"Datacenters:%s, "+
+		"Peers:%s, "+
 		"Namespace:%s, "+
 		"Filter:%s, "+
-		"CTSUserDefinedMeta:%s"+
+		"CTSUserDefinedMeta:%s, "+
+		"SignificantFields:%s"+
 		"}",
 		StringVal(c.Regexp),
 		c.Names,
 		StringVal(c.Datacenter),
+		c.Datacenters,
+		c.Peers,
 		StringVal(c.Namespace),
 		StringVal(c.Filter),
 		c.CTSUserDefinedMeta,
+		c.SignificantFields,
 	)
 }
+
+// isSignificantField returns whether the field can be configured as a
+// significant field
+func isSignificantField(field string) bool {
+	for _, f := range significantFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
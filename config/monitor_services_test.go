@@ -56,6 +56,21 @@ func TestServicesMonitorConfig_Copy(t *testing.T) {
 				},
 			},
 		},
+		{
+			"datacenters_and_peers_configured",
+			&ServicesMonitorConfig{
+				Names:       []string{"api"},
+				Datacenters: []string{"dc1", "dc2"},
+				Peers:       []string{"peer-a"},
+			},
+		},
+		{
+			"significant_fields_configured",
+			&ServicesMonitorConfig{
+				Names:             []string{"api"},
+				SignificantFields: []string{"address", "port"},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -176,6 +191,42 @@ func TestServicesMonitorConfig_Merge(t *testing.T) {
 			&ServicesMonitorConfig{Datacenter: String("datacenter")},
 			&ServicesMonitorConfig{Datacenter: String("datacenter")},
 		},
+		{
+			"datacenters_merges",
+			&ServicesMonitorConfig{Datacenters: []string{"dc1", "dc2"}},
+			&ServicesMonitorConfig{Datacenters: []string{"dc2", "dc3"}},
+			&ServicesMonitorConfig{Datacenters: []string{"dc1", "dc2", "dc3"}},
+		},
+		{
+			"datacenters_empty_one",
+			&ServicesMonitorConfig{Datacenters: []string{"dc1"}},
+			&ServicesMonitorConfig{},
+			&ServicesMonitorConfig{Datacenters: []string{"dc1"}},
+		},
+		{
+			"peers_merges",
+			&ServicesMonitorConfig{Peers: []string{"peer-a"}},
+			&ServicesMonitorConfig{Peers: []string{"peer-b"}},
+			&ServicesMonitorConfig{Peers: []string{"peer-a", "peer-b"}},
+		},
+		{
+			"peers_empty_two",
+			&ServicesMonitorConfig{},
+			&ServicesMonitorConfig{Peers: []string{"peer-a"}},
+			&ServicesMonitorConfig{Peers: []string{"peer-a"}},
+		},
+		{
+			"significant_fields_merges",
+			&ServicesMonitorConfig{SignificantFields: []string{"address"}},
+			&ServicesMonitorConfig{SignificantFields: []string{"port"}},
+			&ServicesMonitorConfig{SignificantFields: []string{"address", "port"}},
+		},
+		{
+			"significant_fields_empty_one",
+			&ServicesMonitorConfig{SignificantFields: []string{"address"}},
+			&ServicesMonitorConfig{},
+			&ServicesMonitorConfig{SignificantFields: []string{"address"}},
+		},
 		{
 			"namespace_overrides",
 			&ServicesMonitorConfig{Namespace: String("namespace")},
@@ -398,6 +449,58 @@ func TestServicesMonitorConfig_Validate(t *testing.T) {
 				Names:  []string{"api"},
 			},
 		},
+		{
+			"valid_datacenters_and_peers",
+			false,
+			&ServicesMonitorConfig{
+				Names:       []string{"api"},
+				Datacenter:  String(""),
+				Datacenters: []string{"dc1", "dc2"},
+				Peers:       []string{"peer-a"},
+			},
+		},
+		{
+			"invalid_both_datacenter_and_datacenters_configured",
+			true,
+			&ServicesMonitorConfig{
+				Names:       []string{"api"},
+				Datacenter:  String("dc1"),
+				Datacenters: []string{"dc2"},
+			},
+		},
+		{
+			"invalid_empty_string_datacenters",
+			true,
+			&ServicesMonitorConfig{
+				Names:       []string{"api"},
+				Datacenters: []string{"dc1", ""},
+			},
+		},
+		{
+			"invalid_empty_string_peers",
+			true,
+			&ServicesMonitorConfig{
+				Regexp: String(".*"),
+				Peers:  []string{""},
+			},
+		},
+		{
+			"valid_significant_fields",
+			false,
+			&ServicesMonitorConfig{
+				Names: []string{"api"},
+				SignificantFields: []string{
+					"address", "port", "tags", "meta", "status"},
+			},
+		},
+		{
+			"invalid_significant_fields",
+			true,
+			&ServicesMonitorConfig{
+				Names:             []string{"api"},
+				SignificantFields: []string{"address", "node"},
+			},
+		},
 		{
 			"invalid_no_regexp_no_names_configured",
 			true,
@@ -442,23 +545,25 @@ func TestServicesMonitorConfig_GoString(t *testing.T) {
 				},
 			},
 			"&ServicesMonitorConfig{Regexp:^api$, Names:[], Datacenter:dc, " +
-				"Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}",
+				"Datacenters:[], Peers:[], Namespace:namespace, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], SignificantFields:[]}",
 		},
 		{
 			"names_fully_configured",
 			&ServicesMonitorConfig{
-				Names:      []string{"api", "web"},
-				Datacenter: String("dc"),
-				Namespace:  String("namespace"),
-				Filter:     String("filter"),
+				Names:       []string{"api", "web"},
+				Datacenters: []string{"dc1", "dc2"},
+				Peers:       []string{"peer-a"},
+				Namespace:   String("namespace"),
+				Filter:      String("filter"),
 				CTSUserDefinedMeta: map[string]string{
 					"key": "value",
 				},
+				SignificantFields: []string{"address", "port"},
 			},
-			"&ServicesMonitorConfig{Regexp:, Names:[api web], Datacenter:dc, " +
-				"Namespace:namespace, Filter:filter, " +
-				"CTSUserDefinedMeta:map[key:value]}",
+			"&ServicesMonitorConfig{Regexp:, Names:[api web], Datacenter:, " +
+				"Datacenters:[dc1 dc2], Peers:[peer-a], Namespace:namespace, Filter:filter, " +
+				"CTSUserDefinedMeta:map[key:value], SignificantFields:[address port]}",
 		},
 	}
 
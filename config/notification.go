@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// NotificationConfig configures the webhooks that are notified when a task run
+// finishes. A JSON payload describing the run is POSTed to each URL.
+type NotificationConfig struct {
+	// URLs are the webhook URLs that are notified.
+	URLs []string `mapstructure:"urls" json:"urls"`
+
+	// Secret is the key used to sign the payload with HMAC-SHA256. The
+	// signature is sent in the X-CTS-Signature header. The payload is not
+	// signed if the secret is not configured.
+	Secret *string `mapstructure:"secret" json:"secret"`
+
+	// FailuresOnly limits the notifications to the task runs that failed.
+	FailuresOnly *bool `mapstructure:"failures_only" json:"failures_only"`
+}
+
+// DefaultNotificationConfig returns the default configuration struct.
+func DefaultNotificationConfig() *NotificationConfig {
+	return &NotificationConfig{}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *NotificationConfig) Copy() *NotificationConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o NotificationConfig
+	if c.URLs != nil {
+		o.URLs = make([]string, 0, len(c.URLs))
+		o.URLs = append(o.URLs, c.URLs...)
+	}
+	o.Secret = StringCopy(c.Secret)
+	o.FailuresOnly = BoolCopy(c.FailuresOnly)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *NotificationConfig) Merge(o *NotificationConfig) *NotificationConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	r.URLs = mergeSlices(r.URLs, o.URLs)
+
+	if o.Secret != nil {
+		r.Secret = StringCopy(o.Secret)
+	}
+
+	if o.FailuresOnly != nil {
+		r.FailuresOnly = BoolCopy(o.FailuresOnly)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *NotificationConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.URLs == nil {
+		c.URLs = []string{}
+	}
+
+	if c.Secret == nil {
+		c.Secret = String("")
+	}
+
+	if c.FailuresOnly == nil {
+		c.FailuresOnly = Bool(false)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *NotificationConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for _, u := range c.URLs {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return fmt.Errorf("invalid notification url %q: %s", u, err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return fmt.Errorf("notification url %q must use the http or "+
+				"https scheme", u)
+		}
+		if parsed.Host == "" {
+			return fmt.Errorf("notification url %q is missing a host", u)
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+// Sensitive information is redacted.
+func (c *NotificationConfig) GoString() string {
+	if c == nil {
+		return "(*NotificationConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&NotificationConfig{"+
+		"URLs:%v, "+
+		"Secret:%s, "+
+		"FailuresOnly:%t"+
+		"}",
+		c.URLs,
+		sensitiveGoString(c.Secret),
+		BoolVal(c.FailuresOnly),
+	)
+}
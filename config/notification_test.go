@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &NotificationConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *NotificationConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&NotificationConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&NotificationConfig{
+				URLs:         []string{"https://hooks.example.com/cts"},
+				Secret:       String("secret"),
+				FailuresOnly: Bool(true),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestNotificationConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *NotificationConfig
+		b    *NotificationConfig
+		r    *NotificationConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&NotificationConfig{},
+			&NotificationConfig{},
+		},
+		{
+			"nil_b",
+			&NotificationConfig{},
+			nil,
+			&NotificationConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"urls_merged",
+			&NotificationConfig{URLs: []string{"https://a", "https://b"}},
+			&NotificationConfig{URLs: []string{"https://b", "https://c"}},
+			&NotificationConfig{URLs: []string{"https://a", "https://b", "https://c"}},
+		},
+		{
+			"secret_overrides",
+			&NotificationConfig{Secret: String("a")},
+			&NotificationConfig{Secret: String("b")},
+			&NotificationConfig{Secret: String("b")},
+		},
+		{
+			"failures_only_overrides",
+			&NotificationConfig{FailuresOnly: Bool(false)},
+			&NotificationConfig{FailuresOnly: Bool(true)},
+			&NotificationConfig{FailuresOnly: Bool(true)},
+		},
+		{
+			"failures_only_empty_two",
+			&NotificationConfig{FailuresOnly: Bool(true)},
+			&NotificationConfig{},
+			&NotificationConfig{FailuresOnly: Bool(true)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestNotificationConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *NotificationConfig
+		r    *NotificationConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&NotificationConfig{},
+			&NotificationConfig{
+				URLs:         []string{},
+				Secret:       String(""),
+				FailuresOnly: Bool(false),
+			},
+		},
+		{
+			"urls",
+			&NotificationConfig{URLs: []string{"https://hooks.example.com/cts"}},
+			&NotificationConfig{
+				URLs:         []string{"https://hooks.example.com/cts"},
+				Secret:       String(""),
+				FailuresOnly: Bool(false),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestNotificationConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *NotificationConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"empty",
+			&NotificationConfig{},
+			true,
+		},
+		{
+			"valid",
+			&NotificationConfig{
+				URLs: []string{"https://hooks.example.com/cts", "http://localhost:8080"},
+			},
+			true,
+		},
+		{
+			"unsupported_scheme",
+			&NotificationConfig{URLs: []string{"ftp://hooks.example.com"}},
+			false,
+		},
+		{
+			"missing_host",
+			&NotificationConfig{URLs: []string{"https://"}},
+			false,
+		},
+		{
+			"invalid_url",
+			&NotificationConfig{URLs: []string{"https://hooks.example.com/%zz"}},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &NotificationConfig{
+		URLs:         []string{"https://hooks.example.com/cts"},
+		Secret:       String("secret"),
+		FailuresOnly: Bool(true),
+	}
+	expected := "&NotificationConfig{URLs:[https://hooks.example.com/cts], " +
+		"Secret:(redacted), FailuresOnly:true}"
+	assert.Equal(t, expected, c.GoString())
+}
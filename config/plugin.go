@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultPluginStartTimeout is the default time to wait for a plugin to start
+const DefaultPluginStartTimeout = time.Minute
+
+// PluginConfig is the configuration for the plugin driver. Tasks are executed
+// by an external plugin executable that CTS launches and communicates with
+// over gRPC.
+type PluginConfig struct {
+	// Path is the path of the plugin executable.
+	Path *string `mapstructure:"path"`
+
+	// Args are the arguments of the plugin executable.
+	Args []string `mapstructure:"args"`
+
+	// StartTimeout is the time to wait for the plugin to start and complete
+	// the handshake with CTS.
+	StartTimeout *time.Duration `mapstructure:"start_timeout"`
+}
+
+// DefaultPluginConfig returns the default configuration struct.
+func DefaultPluginConfig() *PluginConfig {
+	return &PluginConfig{
+		Path:         String(""),
+		Args:         []string{},
+		StartTimeout: TimeDuration(DefaultPluginStartTimeout),
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *PluginConfig) Copy() *PluginConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o PluginConfig
+	o.Path = StringCopy(c.Path)
+	o.StartTimeout = TimeDurationCopy(c.StartTimeout)
+
+	if c.Args != nil {
+		o.Args = make([]string, len(c.Args))
+		copy(o.Args, c.Args)
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// The arguments are overwritten since their order matters.
+func (c *PluginConfig) Merge(o *PluginConfig) *PluginConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Path != nil {
+		r.Path = StringCopy(o.Path)
+	}
+
+	if o.Args != nil {
+		r.Args = make([]string, len(o.Args))
+		copy(r.Args, o.Args)
+	}
+
+	if o.StartTimeout != nil {
+		r.StartTimeout = TimeDurationCopy(o.StartTimeout)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *PluginConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Path == nil {
+		c.Path = String("")
+	}
+
+	if c.Args == nil {
+		c.Args = []string{}
+	}
+
+	if c.StartTimeout == nil || *c.StartTimeout == 0 {
+		c.StartTimeout = TimeDuration(DefaultPluginStartTimeout)
+	}
+}
+
+// Validate validates the values and nested values of the configuration struct
+func (c *PluginConfig) Validate() error {
+	if c == nil {
+		return fmt.Errorf("missing plugin driver configuration")
+	}
+
+	if StringVal(c.Path) == "" {
+		return fmt.Errorf("plugin: path is required")
+	}
+
+	if TimeDurationVal(c.StartTimeout) < 0 {
+		return fmt.Errorf("plugin: start_timeout cannot be negative: %s",
+			TimeDurationVal(c.StartTimeout))
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *PluginConfig) GoString() string {
+	if c == nil {
+		return "(*PluginConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&PluginConfig{"+
+		"Path:%s, "+
+		"Args:%v, "+
+		"StartTimeout:%s"+
+		"}",
+		StringVal(c.Path),
+		c.Args,
+		TimeDurationVal(c.StartTimeout),
+	)
+}
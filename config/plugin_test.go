@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &PluginConfig{Path: String("/opt/cts/plugin")}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *PluginConfig
+	}{
+		{
+			"nil",
+			nil,
+		}, {
+			"empty",
+			&PluginConfig{},
+		}, {
+			"finalized",
+			finalizedConf,
+		}, {
+			"fully_configured",
+			&PluginConfig{
+				Path:         String("/opt/cts/plugin"),
+				Args:         []string{"-log-level", "debug"},
+				StartTimeout: TimeDuration(10 * time.Second),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestPluginConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *PluginConfig
+		b    *PluginConfig
+		r    *PluginConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&PluginConfig{},
+			&PluginConfig{},
+		},
+		{
+			"nil_b",
+			&PluginConfig{},
+			nil,
+			&PluginConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&PluginConfig{},
+			&PluginConfig{},
+			&PluginConfig{},
+		},
+		{
+			"path_overrides",
+			&PluginConfig{Path: String("/opt/cts/a")},
+			&PluginConfig{Path: String("/opt/cts/b")},
+			&PluginConfig{Path: String("/opt/cts/b")},
+		},
+		{
+			"args_override",
+			&PluginConfig{Args: []string{"-a"}},
+			&PluginConfig{Args: []string{"-b", "-c"}},
+			&PluginConfig{Args: []string{"-b", "-c"}},
+		},
+		{
+			"args_empty_two",
+			&PluginConfig{Args: []string{"-a"}},
+			&PluginConfig{},
+			&PluginConfig{Args: []string{"-a"}},
+		},
+		{
+			"start_timeout_overrides",
+			&PluginConfig{StartTimeout: TimeDuration(time.Second)},
+			&PluginConfig{StartTimeout: TimeDuration(time.Minute)},
+			&PluginConfig{StartTimeout: TimeDuration(time.Minute)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestPluginConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *PluginConfig
+		r    *PluginConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&PluginConfig{},
+			DefaultPluginConfig(),
+		},
+		{
+			"configured",
+			&PluginConfig{
+				Path:         String("/opt/cts/plugin"),
+				Args:         []string{"-a"},
+				StartTimeout: TimeDuration(time.Second),
+			},
+			&PluginConfig{
+				Path:         String("/opt/cts/plugin"),
+				Args:         []string{"-a"},
+				StartTimeout: TimeDuration(time.Second),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestPluginConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *PluginConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"valid",
+			&PluginConfig{
+				Path: String("/opt/cts/plugin"),
+			},
+			true,
+		},
+		{
+			"missing_path",
+			&PluginConfig{
+				Path: String(""),
+			},
+			false,
+		},
+		{
+			"negative_start_timeout",
+			&PluginConfig{
+				Path:         String("/opt/cts/plugin"),
+				StartTimeout: TimeDuration(-time.Second),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPluginConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &PluginConfig{
+		Path:         String("/opt/cts/plugin"),
+		Args:         []string{"-a"},
+		StartTimeout: TimeDuration(time.Minute),
+	}
+	expected := "&PluginConfig{Path:/opt/cts/plugin, Args:[-a], StartTimeout:1m0s}"
+	assert.Equal(t, expected, c.GoString())
+}
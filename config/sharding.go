@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ShardingConfig configures partitioning tasks amongst the CTS instances that
+// share the same Consul KV path. Each task is executed by exactly one instance,
+// which is chosen by consistent hashing on the task name. Tasks are
+// rebalanced as instances join or leave.
+type ShardingConfig struct {
+	// Enabled determines if this CTS instance shares tasks with other
+	// instances.
+	Enabled *bool `mapstructure:"enabled"`
+
+	// SessionTTL is the TTL of the Consul session that registers the instance
+	// as a member. When the instance fails to renew its session within the
+	// TTL, it is removed and its tasks are reassigned to other instances.
+	SessionTTL *time.Duration `mapstructure:"session_ttl"`
+}
+
+// DefaultShardingConfig returns the default configuration struct.
+func DefaultShardingConfig() *ShardingConfig {
+	return &ShardingConfig{}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *ShardingConfig) Copy() *ShardingConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o ShardingConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	o.SessionTTL = TimeDurationCopy(c.SessionTTL)
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *ShardingConfig) Merge(o *ShardingConfig) *ShardingConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	if o.SessionTTL != nil {
+		r.SessionTTL = TimeDurationCopy(o.SessionTTL)
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *ShardingConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(false)
+	}
+
+	if c.SessionTTL == nil {
+		c.SessionTTL = TimeDuration(DefaultSessionTTL)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *ShardingConfig) Validate() error {
+	if c == nil || !BoolVal(c.Enabled) {
+		return nil
+	}
+
+	ttl := TimeDurationVal(c.SessionTTL)
+	if ttl < minSessionTTL || ttl > maxSessionTTL {
+		return fmt.Errorf("sharding.session_ttl must be between %s and %s, "+
+			"got %s", minSessionTTL, maxSessionTTL, ttl)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *ShardingConfig) GoString() string {
+	if c == nil {
+		return "(*ShardingConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&ShardingConfig{"+
+		"Enabled:%t, "+
+		"SessionTTL:%s"+
+		"}",
+		BoolVal(c.Enabled),
+		TimeDurationVal(c.SessionTTL),
+	)
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardingConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &ShardingConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *ShardingConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&ShardingConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&ShardingConfig{
+				Enabled:    Bool(true),
+				SessionTTL: TimeDuration(30 * time.Second),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestShardingConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *ShardingConfig
+		b    *ShardingConfig
+		r    *ShardingConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&ShardingConfig{},
+			&ShardingConfig{},
+		},
+		{
+			"nil_b",
+			&ShardingConfig{},
+			nil,
+			&ShardingConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"enabled_overrides",
+			&ShardingConfig{Enabled: Bool(true)},
+			&ShardingConfig{Enabled: Bool(false)},
+			&ShardingConfig{Enabled: Bool(false)},
+		},
+		{
+			"enabled_empty_one",
+			&ShardingConfig{Enabled: Bool(true)},
+			&ShardingConfig{},
+			&ShardingConfig{Enabled: Bool(true)},
+		},
+		{
+			"session_ttl_overrides",
+			&ShardingConfig{SessionTTL: TimeDuration(10 * time.Second)},
+			&ShardingConfig{SessionTTL: TimeDuration(20 * time.Second)},
+			&ShardingConfig{SessionTTL: TimeDuration(20 * time.Second)},
+		},
+		{
+			"session_ttl_empty_two",
+			&ShardingConfig{},
+			&ShardingConfig{SessionTTL: TimeDuration(20 * time.Second)},
+			&ShardingConfig{SessionTTL: TimeDuration(20 * time.Second)},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestShardingConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *ShardingConfig
+		r    *ShardingConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&ShardingConfig{},
+			&ShardingConfig{
+				Enabled:    Bool(false),
+				SessionTTL: TimeDuration(DefaultSessionTTL),
+			},
+		},
+		{
+			"enabled",
+			&ShardingConfig{Enabled: Bool(true)},
+			&ShardingConfig{
+				Enabled:    Bool(true),
+				SessionTTL: TimeDuration(DefaultSessionTTL),
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestShardingConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *ShardingConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"disabled",
+			&ShardingConfig{Enabled: Bool(false)},
+			true,
+		},
+		{
+			"valid",
+			&ShardingConfig{
+				Enabled:    Bool(true),
+				SessionTTL: TimeDuration(15 * time.Second),
+			},
+			true,
+		},
+		{
+			"session_ttl_too_short",
+			&ShardingConfig{
+				Enabled:    Bool(true),
+				SessionTTL: TimeDuration(time.Second),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,14 @@ import (
 
 const (
 	taskSubsystemName = "task"
+
+	// ApplyModeAuto applies the changes of a task as soon as they are
+	// detected
+	ApplyModeAuto = "auto"
+
+	// ApplyModeManual plans the changes of a task when they are detected and
+	// applies the plan once it is approved by an operator
+	ApplyModeManual = "manual"
 )
 
 // TaskConfig is the configuration for a CTS task. This block may be
@@ -70,24 +78,47 @@ type TaskConfig struct {
 	// will be used as the default if omitted.
 	Version *string `mapstructure:"version" json:"version"`
 
-	// The Terraform client version to use for the task when configured with CTS
-	// enterprise and the Terraform Cloud driver. This option is not supported
-	// when using CTS OSS or the Terraform driver.
+	// The Terraform client version to use for the task when configured with
+	// the Terraform Cloud driver. This option is not supported when using the
+	// Terraform driver.
 	// - Deprecated in 0.6. Use `terraform_cloud_workspace.terraform_version` instead
 	DeprecatedTFVersion *string `mapstructure:"terraform_version" json:"terraform_version"`
 
-	// The workspace configurations to use for the task when configured with CTS
-	// enterprise and the Terraform Cloud driver. This option is not supported
-	// when using CTS OSS or the Terraform driver.
+	// The workspace configurations to use for the task when configured with
+	// the Terraform Cloud driver. This option is not supported when using the
+	// Terraform driver.
 	TFCWorkspace *TerraformCloudWorkspaceConfig `mapstructure:"terraform_cloud_workspace" json:"terraform_cloud_workspace"`
 
 	// BufferPeriod configures per-task buffer timers.
 	BufferPeriod *BufferPeriodConfig `mapstructure:"buffer_period" json:"buffer_period"`
 
+	// EventRetention configures per-task event retention. Values that are not
+	// configured are inherited from the global event retention configuration.
+	EventRetention *EventRetentionConfig `mapstructure:"event_retention" json:"event_retention"`
+
+	// Notification configures per-task webhook notifications. URLs are added
+	// to the URLs of the global notification configuration, and other values
+	// that are not configured are inherited from it.
+	Notification *NotificationConfig `mapstructure:"notification" json:"notification"`
+
+	// Blackout configures per-task blackout windows. Windows are added to the
+	// windows of the global blackout configuration.
+	Blackout *BlackoutConfig `mapstructure:"blackout" json:"blackout"`
+
+	// DriftDetection configures the task to periodically detect changes made
+	// to its infrastructure outside of CTS.
+	DriftDetection *DriftDetectionConfig `mapstructure:"drift_detection" json:"drift_detection"`
+
 	// Enabled determines if the task is enabled or not. Enabled by default.
 	// If not enabled, this task will not make any changes to resources.
 	Enabled *bool `mapstructure:"enabled" json:"enabled"`
 
+	// ApplyMode determines how the changes of the task are applied. With
+	// "auto", the changes are applied as soon as they are detected. With
+	// "manual", the changes are saved as a plan that is only applied once an
+	// operator approves it. Defaults to "auto".
+	ApplyMode *string `mapstructure:"apply_mode" json:"apply_mode"`
+
 	// Condition optionally configures a single run condition under which the
 	// task will start executing
 	Condition ConditionConfig `mapstructure:"condition" json:"condition"`
@@ -150,8 +181,18 @@ func (c *TaskConfig) Copy() *TaskConfig {
 
 	o.BufferPeriod = c.BufferPeriod.Copy()
 
+	o.EventRetention = c.EventRetention.Copy()
+
+	o.Notification = c.Notification.Copy()
+
+	o.Blackout = c.Blackout.Copy()
+
+	o.DriftDetection = c.DriftDetection.Copy()
+
 	o.Enabled = BoolCopy(c.Enabled)
 
+	o.ApplyMode = StringCopy(c.ApplyMode)
+
 	if !isConditionNil(c.Condition) {
 		o.Condition = c.Condition.Copy()
 	}
@@ -229,10 +270,30 @@ func (c *TaskConfig) Merge(o *TaskConfig) *TaskConfig {
 		r.BufferPeriod = r.BufferPeriod.Merge(o.BufferPeriod)
 	}
 
+	if o.EventRetention != nil {
+		r.EventRetention = r.EventRetention.Merge(o.EventRetention)
+	}
+
+	if o.Notification != nil {
+		r.Notification = r.Notification.Merge(o.Notification)
+	}
+
+	if o.Blackout != nil {
+		r.Blackout = r.Blackout.Merge(o.Blackout)
+	}
+
+	if o.DriftDetection != nil {
+		r.DriftDetection = r.DriftDetection.Merge(o.DriftDetection)
+	}
+
 	if o.Enabled != nil {
 		r.Enabled = BoolCopy(o.Enabled)
 	}
 
+	if o.ApplyMode != nil {
+		r.ApplyMode = StringCopy(o.ApplyMode)
+	}
+
 	if !isConditionNil(o.Condition) {
 		if isConditionNil(r.Condition) {
 			r.Condition = o.Condition.Copy()
@@ -314,6 +375,12 @@ func (c *TaskConfig) Finalize() error {
 		c.Enabled = Bool(true)
 	}
 
+	if c.ApplyMode == nil {
+		c.ApplyMode = String(ApplyModeAuto)
+	}
+
+	c.DriftDetection.Finalize()
+
 	if isConditionNil(c.Condition) {
 		c.Condition = EmptyConditionConfig()
 	}
@@ -333,8 +400,12 @@ func (c *TaskConfig) Finalize() error {
 	c.ModuleInputs.Finalize()
 
 	// Scheduled conditions should never have buffer periods configured, since they are
-	// triggered through a different flow.
+	// triggered through a different flow. This includes composite conditions
+	// with a schedule.
 	_, isScheduleCondition := c.Condition.(*ScheduleConditionConfig)
+	if cc, ok := c.Condition.(*CompositeConditionConfig); ok {
+		_, isScheduleCondition = cc.Schedule()
+	}
 	if isScheduleCondition {
 		// disable buffer_period for schedule condition
 		if c.BufferPeriod != nil {
@@ -423,21 +494,27 @@ func (c *TaskConfig) Validate() error {
 		return err
 	}
 
+	switch mode := StringVal(c.ApplyMode); mode {
+	case "", ApplyModeAuto, ApplyModeManual:
+	default:
+		return fmt.Errorf("unsupported apply_mode %q for task %q. apply_mode "+
+			"must be %q or %q", mode, *c.Name, ApplyModeAuto, ApplyModeManual)
+	}
+
 	if c.Module == nil || len(*c.Module) == 0 {
 		return fmt.Errorf("module for the task is required")
 	}
 
 	if c.DeprecatedTFVersion != nil && *c.DeprecatedTFVersion != "" {
-		return fmt.Errorf("unsupported configuration 'terraform_version' for "+
-			"task %q. This option is available for Consul-Terraform-Sync Enterprise "+
-			"when using the Terraform Cloud driver, or configure the Terraform client "+
-			"version within the Terraform driver block", *c.Name)
+		if err := validateTerraformVersion(*c.DeprecatedTFVersion); err != nil {
+			return fmt.Errorf("error validating terraform_version for task "+
+				"%q: %s", *c.Name, err)
+		}
 	}
 
-	if c.TFCWorkspace != nil && !c.TFCWorkspace.IsEmpty() {
-		return fmt.Errorf("unsupported configuration 'terraform_cloud_workspace' for "+
-			"task %q. This option is available for Consul-Terraform-Sync Enterprise "+
-			"when using the Terraform Cloud driver", *c.Name)
+	if err := c.TFCWorkspace.Validate(); err != nil {
+		return fmt.Errorf("error validating terraform_cloud_workspace for "+
+			"task %q: %s", *c.Name, err)
 	}
 
 	// Restrict only one provider instance per task
@@ -460,6 +537,22 @@ func (c *TaskConfig) Validate() error {
 		return err
 	}
 
+	if err := c.EventRetention.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Notification.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Blackout.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.DriftDetection.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -469,8 +562,9 @@ func (c *TaskConfig) Validate() error {
 //   - Validate()
 //   - InheritParentConfig()
 //
-// It is intended to indicate whether a task is safe to be converted into a driver for execution.
-func (c *TaskConfig) ValidateForDriver() error {
+// It is intended to indicate whether a task is safe to be converted into the configured driver
+// for execution.
+func (c *TaskConfig) ValidateForDriver(driver *DriverConfig) error {
 	if err := c.BufferPeriod.Validate(); err != nil {
 		return err
 	}
@@ -481,6 +575,36 @@ func (c *TaskConfig) ValidateForDriver() error {
 	if c.WorkingDir == nil {
 		return fmt.Errorf("missing workingdir configuration on task")
 	}
+	return c.validateDriverOptions(driver)
+}
+
+// validateDriverOptions validates that the driver-specific options of the task
+// are supported by the configured driver.
+func (c *TaskConfig) validateDriverOptions(driver *DriverConfig) error {
+	if driver != nil && driver.Plugin != nil &&
+		c.DriftDetection != nil && BoolVal(c.DriftDetection.Enabled) {
+		return fmt.Errorf("unsupported configuration 'drift_detection' for "+
+			"task %q. This option is not available when using the plugin "+
+			"driver", StringVal(c.Name))
+	}
+
+	if driver != nil && driver.TerraformCloud != nil {
+		return nil
+	}
+
+	if c.DeprecatedTFVersion != nil && *c.DeprecatedTFVersion != "" {
+		return fmt.Errorf("unsupported configuration 'terraform_version' for "+
+			"task %q. This option is available when using the Terraform Cloud "+
+			"driver, or configure the Terraform client version within the "+
+			"Terraform driver block", StringVal(c.Name))
+	}
+
+	if c.TFCWorkspace != nil && !c.TFCWorkspace.IsEmpty() {
+		return fmt.Errorf("unsupported configuration 'terraform_cloud_workspace' for "+
+			"task %q. This option is available when using the Terraform Cloud "+
+			"driver", StringVal(c.Name))
+	}
+
 	return nil
 }
 
@@ -501,7 +625,12 @@ func (c *TaskConfig) GoString() string {
 		"Version:%s, "+
 		"TFVersion: %s, "+
 		"BufferPeriod:%s, "+
+		"EventRetention:%s, "+
+		"Notification:%s, "+
+		"Blackout:%s, "+
+		"DriftDetection:%s, "+
 		"Enabled:%t, "+
+		"ApplyMode:%s, "+
 		"Condition:%s, "+
 		"ModuleInput:%s"+
 		"}",
@@ -514,7 +643,12 @@ func (c *TaskConfig) GoString() string {
 		StringVal(c.Version),
 		StringVal(c.DeprecatedTFVersion),
 		c.BufferPeriod.GoString(),
+		c.EventRetention.GoString(),
+		c.Notification.GoString(),
+		c.Blackout.GoString(),
+		c.DriftDetection.GoString(),
 		BoolVal(c.Enabled),
+		StringVal(c.ApplyMode),
 		c.Condition.GoString(),
 		c.ModuleInputs.GoString(),
 	)
@@ -667,7 +801,18 @@ func (c *TaskConfig) validateCondition() error {
 
 	// Confirm that condition's variable type is not services since task.services
 	// is configured
-	if _, ok := c.Condition.(*ServicesConditionConfig); ok {
+	for _, cond := range Conditions(c.Condition) {
+		if err := c.validateServicesCondition(cond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateServicesCondition validates that a condition does not monitor the
+// services variable type when the task's services list is configured
+func (c *TaskConfig) validateServicesCondition(cond ConditionConfig) error {
+	if _, ok := cond.(*ServicesConditionConfig); ok {
 		err := fmt.Errorf("task's `services` field and `condition " +
 			"'services'` block both monitor \"services\" variable type. only " +
 			"one of these can be configured per task")
@@ -678,6 +823,17 @@ func (c *TaskConfig) validateCondition() error {
 				"task_name", StringVal(c.Name), "error", err)
 		return err
 	}
+	if _, ok := cond.(*HealthChecksConditionConfig); ok {
+		err := fmt.Errorf("task's `services` field and `condition " +
+			"'health-checks'` block both monitor \"services\" variable type. " +
+			"only one of these can be configured per task")
+		logging.Global().Named(logSystemName).Named(taskSubsystemName).
+			Error("list of `services` and `condition 'health-checks'` block "+
+				"cannot both be configured. Consider moving the list into the "+
+				"condition block or creating separate tasks",
+				"task_name", StringVal(c.Name), "error", err)
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// monitorTypes are the types of condition and module_input blocks. The JSON
+// encoding of each monitor implementation nests its fields under one of these
+// types.
+var monitorTypes = []string{
+	noConditionType,
+	catalogServicesType,
+	servicesType,
+	consulKVType,
+	scheduleType,
+	healthChecksType,
+	intentionsType,
+	nodesType,
+	preparedQueryType,
+	gatewaysType,
+	compositeType,
+}
+
+// MarshalTaskConfigJSON returns the JSON encoding of a task configuration using
+// the same format as a task block in a JSON configuration file. The returned
+// bytes can be decoded with UnmarshalTaskConfigJSON.
+func MarshalTaskConfigJSON(tc TaskConfig) ([]byte, error) {
+	b, err := json.Marshal(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	delete(raw, "condition")
+	if !isConditionNil(tc.Condition) {
+		cond, err := monitorToJSONMap(tc.Condition)
+		if err != nil {
+			return nil, err
+		}
+		raw["condition"] = cond
+	}
+
+	inputs, err := moduleInputsToJSONList(tc.ModuleInputs)
+	if err != nil {
+		return nil, err
+	}
+	raw["module_input"] = inputs
+
+	inputs, err = moduleInputsToJSONList(tc.DeprecatedSourceInputs)
+	if err != nil {
+		return nil, err
+	}
+	raw["source_input"] = inputs
+
+	return json.Marshal(raw)
+}
+
+// UnmarshalTaskConfigJSON decodes a task configuration that was encoded with
+// MarshalTaskConfigJSON or written as a task block of a JSON configuration
+// file.
+func UnmarshalTaskConfigJSON(b []byte) (*TaskConfig, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	var tc TaskConfig
+	var md mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       DecodeJsonHook,
+		WeaklyTypedInput: true,
+		ErrorUnused:      false,
+		Metadata:         &md,
+		Result:           &tc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(raw); err != nil {
+		return nil, decodeError(err)
+	}
+
+	if err := processUnusedConfigKeys(md, "task"); err != nil {
+		return nil, err
+	}
+
+	return &tc, nil
+}
+
+// moduleInputsToJSONList converts module inputs into their JSON configuration
+// file format. Returns nil if there are no module inputs.
+func moduleInputsToJSONList(inputs *ModuleInputConfigs) ([]interface{}, error) {
+	if inputs == nil {
+		return nil, nil
+	}
+
+	list := make([]interface{}, 0, inputs.Len())
+	for _, input := range *inputs {
+		if isModuleInputNil(input) {
+			continue
+		}
+		m, err := monitorToJSONMap(input)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, nil
+}
+
+// monitorToJSONMap converts a condition or module_input into its JSON
+// configuration file format, e.g. {"services": {"names": ["api"]}}
+func monitorToJSONMap(m MonitorConfig) (map[string]interface{}, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	// Monitor implementations embed a type-specific monitor configuration
+	// tagged with the monitor type. Flatten the embedded configuration so that
+	// all fields are in the same block.
+	for _, t := range monitorTypes {
+		embedded, ok := fields[t].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delete(fields, t)
+		for k, v := range embedded {
+			fields[k] = v
+		}
+		return map[string]interface{}{t: fields}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported monitor type %T", m)
+}
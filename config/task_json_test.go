@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskConfig_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		conf *TaskConfig
+	}{
+		{
+			"empty",
+			&TaskConfig{},
+		},
+		{
+			"services condition and module inputs",
+			&TaskConfig{
+				Description: String("description"),
+				Name:        String("task"),
+				Providers:   []string{"local"},
+				Module:      String("path"),
+				Version:     String("0.0.0"),
+				Enabled:     Bool(false),
+				Variables:   map[string]string{"key": "value"},
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names:              []string{"api", "web"},
+						Datacenter:         String("dc2"),
+						CTSUserDefinedMeta: map[string]string{"k": "v"},
+					},
+					UseAsModuleInput: Bool(true),
+				},
+				ModuleInputs: &ModuleInputConfigs{
+					&ConsulKVModuleInputConfig{
+						ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+							Path:    String("key/path"),
+							Recurse: Bool(true),
+						},
+					},
+				},
+				BufferPeriod: &BufferPeriodConfig{
+					Enabled: Bool(true),
+					Min:     TimeDuration(5 * time.Second),
+					Max:     TimeDuration(20 * time.Second),
+				},
+				WorkingDir: String("sync-tasks/task"),
+			},
+		},
+		{
+			"catalog-services condition",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &CatalogServicesConditionConfig{
+					CatalogServicesMonitorConfig{
+						Regexp:           String(".*"),
+						UseAsModuleInput: Bool(true),
+						NodeMeta:         map[string]string{"key": "value"},
+					},
+				},
+			},
+		},
+		{
+			"consul-kv condition",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ConsulKVConditionConfig{
+					ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+						Path: String("key"),
+					},
+				},
+			},
+		},
+		{
+			"health-checks condition",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &HealthChecksConditionConfig{
+					HealthChecksMonitorConfig: HealthChecksMonitorConfig{
+						ServicesMonitorConfig: ServicesMonitorConfig{
+							Regexp: String("^web.*"),
+						},
+						CriticalThreshold: Int(25),
+					},
+					UseAsModuleInput: Bool(false),
+				},
+			},
+		},
+		{
+			"intentions condition and module input",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &IntentionsConditionConfig{
+					IntentionsMonitorConfig: IntentionsMonitorConfig{
+						SourceServices: []string{"api"},
+					},
+					UseAsModuleInput: Bool(false),
+				},
+				ModuleInputs: &ModuleInputConfigs{
+					&IntentionsModuleInputConfig{
+						IntentionsMonitorConfig: IntentionsMonitorConfig{
+							Datacenter:          String("dc2"),
+							DestinationServices: []string{"db"},
+						},
+					},
+				},
+			},
+		},
+		{
+			"nodes condition and module input",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &NodesConditionConfig{
+					NodesMonitorConfig: NodesMonitorConfig{
+						NodeMeta: map[string]string{"rack": "rack-1"},
+					},
+					UseAsModuleInput: Bool(true),
+				},
+				ModuleInputs: &ModuleInputConfigs{
+					&NodesModuleInputConfig{
+						NodesMonitorConfig: NodesMonitorConfig{
+							Datacenter: String("dc2"),
+							Filter:     String("Meta.env == prod"),
+						},
+					},
+				},
+			},
+		},
+		{
+			"composite condition",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &CompositeConditionConfig{
+					CompositeMonitorConfig{
+						Operator: String("and"),
+						Conditions: ConditionConfigs{
+							&ConsulKVConditionConfig{
+								ConsulKVMonitorConfig: ConsulKVMonitorConfig{
+									Path: String("key-path"),
+								},
+								UseAsModuleInput: Bool(true),
+							},
+							&ScheduleConditionConfig{
+								ScheduleMonitorConfig{Cron: String("0 2 * * *")},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"prepared-query module input",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ScheduleConditionConfig{
+					ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+				},
+				ModuleInputs: &ModuleInputConfigs{
+					&PreparedQueryModuleInputConfig{
+						PreparedQueryMonitorConfig: PreparedQueryMonitorConfig{
+							Name:       String("api-failover"),
+							Datacenter: String("dc2"),
+						},
+					},
+				},
+			},
+		},
+		{
+			"gateways module input",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ScheduleConditionConfig{
+					ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+				},
+				ModuleInputs: &ModuleInputConfigs{
+					&GatewaysModuleInputConfig{
+						GatewaysMonitorConfig: GatewaysMonitorConfig{
+							Names:      []string{"mesh-gateway"},
+							Datacenter: String("dc2"),
+						},
+					},
+				},
+			},
+		},
+		{
+			"schedule condition",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ScheduleConditionConfig{
+					ScheduleMonitorConfig{Cron: String("* * * * * * *")},
+				},
+			},
+		},
+		{
+			"no condition",
+			&TaskConfig{
+				Name:      String("task"),
+				Condition: EmptyConditionConfig(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := MarshalTaskConfigJSON(*tc.conf)
+			require.NoError(t, err)
+
+			actual, err := UnmarshalTaskConfigJSON(b)
+			require.NoError(t, err)
+			assert.Equal(t, tc.conf, actual)
+		})
+	}
+
+	t.Run("finalized", func(t *testing.T) {
+		conf := &TaskConfig{
+			Name:   String("task"),
+			Module: String("path"),
+			Condition: &ServicesConditionConfig{
+				ServicesMonitorConfig: ServicesMonitorConfig{
+					Regexp: String(".*"),
+				},
+			},
+		}
+		require.NoError(t, conf.Finalize())
+
+		b, err := MarshalTaskConfigJSON(*conf)
+		require.NoError(t, err)
+
+		actual, err := UnmarshalTaskConfigJSON(b)
+		require.NoError(t, err)
+		assert.Equal(t, conf, actual)
+	})
+}
+
+func TestUnmarshalTaskConfigJSON_Error(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{
+			"invalid json",
+			`{"name":`,
+		},
+		{
+			"unsupported condition",
+			`{"name": "task", "condition": {"unsupported": {}}}`,
+		},
+		{
+			"invalid key",
+			`{"name": "task", "invalid": true}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalTaskConfigJSON([]byte(tc.input))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -534,6 +534,7 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        nil,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition:           EmptyConditionConfig(),
 				WorkingDir:          nil,
 				ModuleInputs:        DefaultModuleInputConfigs(),
@@ -557,6 +558,7 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        nil,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition:           EmptyConditionConfig(),
 				WorkingDir:          nil,
 				ModuleInputs:        DefaultModuleInputConfigs(),
@@ -581,9 +583,54 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        emptyBufferPeriodConfig,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition: &ScheduleConditionConfig{
 					ScheduleMonitorConfig: ScheduleMonitorConfig{
 						String(""),
+						String(DefaultScheduleTimeZone),
+					},
+				},
+				WorkingDir:   nil,
+				ModuleInputs: DefaultModuleInputConfigs(),
+			},
+		},
+		{
+			name: "with_composite_schedule_condition",
+			i: &TaskConfig{
+				Name: String("task"),
+				Condition: &CompositeConditionConfig{
+					CompositeMonitorConfig{
+						Conditions: ConditionConfigs{
+							&ScheduleConditionConfig{},
+						},
+					},
+				},
+			},
+			r: &TaskConfig{
+				Description:         String(""),
+				Name:                String("task"),
+				Providers:           []string{},
+				DeprecatedServices:  []string{},
+				Module:              String(""),
+				VarFiles:            []string{},
+				Variables:           map[string]string{},
+				Version:             String(""),
+				DeprecatedTFVersion: String(""),
+				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
+				BufferPeriod:        emptyBufferPeriodConfig,
+				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
+				Condition: &CompositeConditionConfig{
+					CompositeMonitorConfig{
+						Operator: String("and"),
+						Conditions: ConditionConfigs{
+							&ScheduleConditionConfig{
+								ScheduleMonitorConfig: ScheduleMonitorConfig{
+									String(""),
+									String(DefaultScheduleTimeZone),
+								},
+							},
+						},
 					},
 				},
 				WorkingDir:   nil,
@@ -613,9 +660,11 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        emptyBufferPeriodConfig,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition: &ScheduleConditionConfig{
 					ScheduleMonitorConfig: ScheduleMonitorConfig{
 						String(""),
+						String(DefaultScheduleTimeZone),
 					},
 				},
 				WorkingDir: nil,
@@ -654,6 +703,7 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        nil,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition:           EmptyConditionConfig(),
 				WorkingDir:          nil,
 				ModuleInputs:        DefaultModuleInputConfigs(),
@@ -696,6 +746,7 @@ func TestTaskConfig_Finalize(t *testing.T) {
 				TFCWorkspace:        DefaultTerraformCloudWorkspaceConfig(),
 				BufferPeriod:        nil,
 				Enabled:             Bool(true),
+				ApplyMode:           String(ApplyModeAuto),
 				Condition:           EmptyConditionConfig(),
 				WorkingDir:          nil,
 				ModuleInputs:        DefaultModuleInputConfigs(),
@@ -926,7 +977,7 @@ func TestTaskConfig_Validate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid: TF version: unsupported version",
+			"invalid: TFC workspace: unsupported execution mode",
 			&TaskConfig{
 				Name: String("task"),
 				Condition: &ServicesConditionConfig{
@@ -934,13 +985,15 @@ func TestTaskConfig_Validate(t *testing.T) {
 						Names: []string{"api"},
 					},
 				},
-				Module:              String("path"),
-				DeprecatedTFVersion: String("0.15.0"),
+				Module: String("path"),
+				TFCWorkspace: &TerraformCloudWorkspaceConfig{
+					ExecutionMode: String("local"),
+				},
 			},
 			false,
 		},
 		{
-			"invalid: TFC workspace unsupported",
+			"valid: empty TFC workspace",
 			&TaskConfig{
 				Name: String("task"),
 				Condition: &ServicesConditionConfig{
@@ -948,15 +1001,13 @@ func TestTaskConfig_Validate(t *testing.T) {
 						Names: []string{"api"},
 					},
 				},
-				Module: String("path"),
-				TFCWorkspace: &TerraformCloudWorkspaceConfig{
-					ExecutionMode: String("remote"),
-				},
+				Module:       String("path"),
+				TFCWorkspace: DefaultTerraformCloudWorkspaceConfig(),
 			},
-			false,
+			true,
 		},
 		{
-			"valid: empty TFC workspace",
+			"valid: manual apply mode",
 			&TaskConfig{
 				Name: String("task"),
 				Condition: &ServicesConditionConfig{
@@ -964,11 +1015,25 @@ func TestTaskConfig_Validate(t *testing.T) {
 						Names: []string{"api"},
 					},
 				},
-				Module:       String("path"),
-				TFCWorkspace: DefaultTerraformCloudWorkspaceConfig(),
+				Module:    String("path"),
+				ApplyMode: String(ApplyModeManual),
 			},
 			true,
 		},
+		{
+			"invalid: apply mode: unsupported",
+			&TaskConfig{
+				Name: String("task"),
+				Condition: &ServicesConditionConfig{
+					ServicesMonitorConfig: ServicesMonitorConfig{
+						Names: []string{"api"},
+					},
+				},
+				Module:    String("path"),
+				ApplyMode: String("approve"),
+			},
+			false,
+		},
 		{
 			"invalid: provider: duplicate",
 			&TaskConfig{
@@ -1228,7 +1293,7 @@ func TestTasksConfig_Validate(t *testing.T) {
 						},
 					},
 					Module:              String("path"),
-					DeprecatedTFVersion: String("0.15.0"),
+					DeprecatedTFVersion: String("0.12.0"),
 				},
 			},
 			isValid: false,
@@ -1290,6 +1355,44 @@ func TestTaskConfig_validateCondition(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid: services & health-checks cond-block configured",
+			&TaskConfig{
+				DeprecatedServices: []string{"api"},
+				Condition:          &HealthChecksConditionConfig{},
+			},
+			false,
+		},
+		{
+			"valid: services & composite cond-block configured",
+			&TaskConfig{
+				DeprecatedServices: []string{"api"},
+				Condition: &CompositeConditionConfig{
+					CompositeMonitorConfig{
+						Conditions: ConditionConfigs{
+							&ConsulKVConditionConfig{},
+							&ScheduleConditionConfig{},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid: services & composite services cond-block configured",
+			&TaskConfig{
+				DeprecatedServices: []string{"api"},
+				Condition: &CompositeConditionConfig{
+					CompositeMonitorConfig{
+						Conditions: ConditionConfigs{
+							&ConsulKVConditionConfig{},
+							&ServicesConditionConfig{},
+						},
+					},
+				},
+			},
+			false,
+		},
 	}
 
 	for i, tc := range cases {
@@ -1311,9 +1414,14 @@ func TestTaskConfig_validateCondition(t *testing.T) {
 func TestTaskConfig_ValidateForDriver(t *testing.T) {
 	t.Parallel()
 
+	tfDriver := &DriverConfig{Terraform: &TerraformConfig{}}
+	tfcDriver := &DriverConfig{TerraformCloud: &TerraformCloudConfig{}}
+	pluginDriver := &DriverConfig{Plugin: &PluginConfig{}}
+
 	cases := []struct {
 		name    string
 		c       *TaskConfig
+		driver  *DriverConfig
 		isValid bool
 	}{
 		{
@@ -1322,6 +1430,7 @@ func TestTaskConfig_ValidateForDriver(t *testing.T) {
 				WorkingDir:   String("not-nil"),
 				BufferPeriod: DefaultBufferPeriodConfig(),
 			},
+			tfDriver,
 			true,
 		},
 		{
@@ -1329,6 +1438,7 @@ func TestTaskConfig_ValidateForDriver(t *testing.T) {
 			&TaskConfig{
 				BufferPeriod: DefaultBufferPeriodConfig(),
 			},
+			tfDriver,
 			false,
 		},
 		{
@@ -1336,6 +1446,7 @@ func TestTaskConfig_ValidateForDriver(t *testing.T) {
 			&TaskConfig{
 				WorkingDir: String("not-nil"),
 			},
+			tfDriver,
 			false,
 		},
 		{
@@ -1348,13 +1459,89 @@ func TestTaskConfig_ValidateForDriver(t *testing.T) {
 					Max:     TimeDuration(1 * time.Second),
 				},
 			},
+			tfDriver,
+			false,
+		},
+		{
+			"tf_version_unsupported_by_terraform_driver",
+			&TaskConfig{
+				WorkingDir:          String("not-nil"),
+				BufferPeriod:        DefaultBufferPeriodConfig(),
+				DeprecatedTFVersion: String("0.15.0"),
+			},
+			tfDriver,
+			false,
+		},
+		{
+			"tfc_workspace_unsupported_by_terraform_driver",
+			&TaskConfig{
+				WorkingDir:   String("not-nil"),
+				BufferPeriod: DefaultBufferPeriodConfig(),
+				TFCWorkspace: &TerraformCloudWorkspaceConfig{
+					ExecutionMode: String("remote"),
+				},
+			},
+			tfDriver,
 			false,
 		},
+		{
+			"empty_tfc_workspace_terraform_driver",
+			&TaskConfig{
+				WorkingDir:   String("not-nil"),
+				BufferPeriod: DefaultBufferPeriodConfig(),
+				TFCWorkspace: DefaultTerraformCloudWorkspaceConfig(),
+			},
+			tfDriver,
+			true,
+		},
+		{
+			"tfc_workspace_terraform_cloud_driver",
+			&TaskConfig{
+				WorkingDir:          String("not-nil"),
+				BufferPeriod:        DefaultBufferPeriodConfig(),
+				DeprecatedTFVersion: String("0.15.0"),
+				TFCWorkspace: &TerraformCloudWorkspaceConfig{
+					ExecutionMode: String("remote"),
+				},
+			},
+			tfcDriver,
+			true,
+		},
+		{
+			"drift_detection_unsupported_by_plugin_driver",
+			&TaskConfig{
+				WorkingDir:     String("not-nil"),
+				BufferPeriod:   DefaultBufferPeriodConfig(),
+				DriftDetection: &DriftDetectionConfig{Enabled: Bool(true)},
+			},
+			pluginDriver,
+			false,
+		},
+		{
+			"disabled_drift_detection_plugin_driver",
+			&TaskConfig{
+				WorkingDir:     String("not-nil"),
+				BufferPeriod:   DefaultBufferPeriodConfig(),
+				DriftDetection: &DriftDetectionConfig{Enabled: Bool(false)},
+			},
+			pluginDriver,
+			true,
+		},
+		{
+			"drift_detection_terraform_driver",
+			&TaskConfig{
+				WorkingDir:     String("not-nil"),
+				BufferPeriod:   DefaultBufferPeriodConfig(),
+				DriftDetection: &DriftDetectionConfig{Enabled: Bool(true)},
+			},
+			tfDriver,
+			true,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := tc.c.ValidateForDriver()
+			err := tc.c.ValidateForDriver(tc.driver)
 			if tc.isValid {
 				assert.NoError(t, err)
 			} else {
@@ -21,8 +21,21 @@ const (
 	logSystemName          = "config"
 )
 
-// TerraformConfig is the configuration for the Terraform driver.
+const (
+	// ProductTerraform is the product of the Terraform driver
+	ProductTerraform = "terraform"
+
+	// ProductOpenTofu is the product of the OpenTofu driver
+	ProductOpenTofu = "opentofu"
+)
+
+// TerraformConfig is the configuration for the Terraform driver. The
+// configuration is shared with the OpenTofu driver.
 type TerraformConfig struct {
+	// Product is the CLI that executes tasks, either Terraform or OpenTofu. It
+	// is not configurable and is set by the driver block of the configuration.
+	Product *string `mapstructure:"-"`
+
 	Version           *string                `mapstructure:"version"`
 	Log               *bool                  `mapstructure:"log"`
 	PersistLog        *bool                  `mapstructure:"persist_log"`
@@ -41,6 +54,7 @@ func DefaultTerraformConfig() *TerraformConfig {
 	}
 
 	return &TerraformConfig{
+		Product:           String(ProductTerraform),
 		Log:               Bool(false),
 		PersistLog:        Bool(false),
 		Path:              String(wd),
@@ -100,6 +114,10 @@ func (c *TerraformConfig) Copy() *TerraformConfig {
 
 	var o TerraformConfig
 
+	if c.Product != nil {
+		o.Product = StringCopy(c.Product)
+	}
+
 	if c.Version != nil {
 		o.Version = StringCopy(c.Version)
 	}
@@ -151,6 +169,10 @@ func (c *TerraformConfig) Merge(o *TerraformConfig) *TerraformConfig {
 
 	r := c.Copy()
 
+	if o.Product != nil {
+		r.Product = StringCopy(o.Product)
+	}
+
 	if o.Version != nil {
 		r.Version = StringCopy(o.Version)
 	}
@@ -205,6 +227,10 @@ func (c *TerraformConfig) Finalize(consul *ConsulConfig) {
 		panic(err)
 	}
 
+	if c.Product == nil || *c.Product == "" {
+		c.Product = String(ProductTerraform)
+	}
+
 	if c.Version == nil {
 		c.Version = String("")
 	}
@@ -246,20 +272,16 @@ func (c *TerraformConfig) Validate() error {
 	}
 
 	if c.Version != nil && *c.Version != "" {
-		v, err := goVersion.NewSemver(*c.Version)
+		var err error
+		switch StringVal(c.Product) {
+		case ProductOpenTofu:
+			err = validateOpenTofuVersion(*c.Version)
+		default:
+			err = validateTerraformVersion(*c.Version)
+		}
 		if err != nil {
 			return err
 		}
-
-		if len(strings.Split(*c.Version, ".")) < 3 {
-			return fmt.Errorf("provide the exact Terraform version to install: %s", *c.Version)
-		}
-
-		if !ctsVersion.TerraformConstraint.Check(v) {
-			return fmt.Errorf("Terraform version is not supported by Consul-"+
-				"Terraform-Sync, try updating to a different version (%s): %s",
-				ctsVersion.CompatibleTerraformVersionConstraint, *c.Version)
-		}
 	}
 
 	if c.Backend == nil {
@@ -295,6 +317,7 @@ func (c *TerraformConfig) GoString() string {
 	}
 
 	return fmt.Sprintf("&TerraformConfig{"+
+		"Product:%s, "+
 		"Version:%s, "+
 		"Log:%v, "+
 		"PersistLog:%v, "+
@@ -302,6 +325,7 @@ func (c *TerraformConfig) GoString() string {
 		"Backend:%+v, "+
 		"RequiredProviders:%+v"+
 		"}",
+		StringVal(c.Product),
 		StringVal(c.Version),
 		BoolVal(c.Log),
 		BoolVal(c.PersistLog),
@@ -311,13 +335,53 @@ func (c *TerraformConfig) GoString() string {
 	)
 }
 
+// validateTerraformVersion validates that the version is an exact version of
+// Terraform that is supported by CTS.
+func validateTerraformVersion(version string) error {
+	return validateCLIVersion("Terraform", version, ctsVersion.TerraformConstraint,
+		ctsVersion.CompatibleTerraformVersionConstraint)
+}
+
+// validateOpenTofuVersion validates that the version is an exact version of
+// OpenTofu that is supported by CTS.
+func validateOpenTofuVersion(version string) error {
+	return validateCLIVersion("OpenTofu", version, ctsVersion.OpenTofuConstraint,
+		ctsVersion.CompatibleOpenTofuVersionConstraint)
+}
+
+func validateCLIVersion(name, version string, constraint goVersion.Constraints,
+	constraintStr string) error {
+	v, err := goVersion.NewSemver(version)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.Split(version, ".")) < 3 {
+		return fmt.Errorf("provide the exact %s version to install: %s", name, version)
+	}
+
+	if !constraint.Check(v) {
+		return fmt.Errorf("%s version is not supported by Consul-"+
+			"Terraform-Sync, try updating to a different version (%s): %s",
+			name, constraintStr, version)
+	}
+
+	return nil
+}
+
 // IsConsulBackend returns if the Terraform backend is using Consul KV for
 // remote state store.
 func (c *TerraformConfig) IsConsulBackend() bool {
-	if c.Backend == nil {
+	if c == nil || c.Backend == nil {
 		return false
 	}
 
 	_, ok := c.Backend["consul"]
 	return ok
 }
+
+// IsOpenTofu returns if the driver executes tasks with OpenTofu instead of
+// Terraform.
+func (c *TerraformConfig) IsOpenTofu() bool {
+	return c != nil && StringVal(c.Product) == ProductOpenTofu
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultTFCHostname is the hostname of Terraform Cloud
+	DefaultTFCHostname = "app.terraform.io"
+
+	// DefaultTFCWorkspacePrefix is the prefix prepended to the task name for
+	// the name of the Terraform Cloud workspace of a task
+	DefaultTFCWorkspacePrefix = "cts-"
+)
+
+// TerraformCloudConfig is the configuration for the Terraform Cloud driver.
+// Tasks are executed remotely as runs in Terraform Cloud or Terraform
+// Enterprise workspaces, one workspace per task.
+type TerraformCloudConfig struct {
+	// Hostname is the hostname of the Terraform Cloud or Terraform Enterprise
+	// instance.
+	Hostname *string `mapstructure:"hostname"`
+
+	// Organization is the name of the organization of the task workspaces.
+	Organization *string `mapstructure:"organization"`
+
+	// Token is the API token used to authenticate with Terraform Cloud. The
+	// token is read from the TFE_TOKEN environment variable if not
+	// configured.
+	Token *string `mapstructure:"token"`
+
+	// WorkspacePrefix is prepended to the task name for the name of the
+	// workspace of a task.
+	WorkspacePrefix *string `mapstructure:"workspace_prefix"`
+
+	// WorkspaceTags are added to the workspaces of all tasks.
+	WorkspaceTags []string `mapstructure:"workspace_tags"`
+
+	RequiredProviders map[string]interface{} `mapstructure:"required_providers"`
+}
+
+// DefaultTerraformCloudConfig returns the default configuration struct.
+func DefaultTerraformCloudConfig() *TerraformCloudConfig {
+	return &TerraformCloudConfig{
+		Hostname:          String(DefaultTFCHostname),
+		Organization:      String(""),
+		Token:             String(""),
+		WorkspacePrefix:   String(DefaultTFCWorkspacePrefix),
+		WorkspaceTags:     []string{},
+		RequiredProviders: make(map[string]interface{}),
+	}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *TerraformCloudConfig) Copy() *TerraformCloudConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o TerraformCloudConfig
+	o.Hostname = StringCopy(c.Hostname)
+	o.Organization = StringCopy(c.Organization)
+	o.Token = StringCopy(c.Token)
+	o.WorkspacePrefix = StringCopy(c.WorkspacePrefix)
+
+	if c.WorkspaceTags != nil {
+		o.WorkspaceTags = make([]string, len(c.WorkspaceTags))
+		copy(o.WorkspaceTags, c.WorkspaceTags)
+	}
+
+	if c.RequiredProviders != nil {
+		o.RequiredProviders = make(map[string]interface{})
+		for k, v := range c.RequiredProviders {
+			o.RequiredProviders[k] = v
+		}
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *TerraformCloudConfig) Merge(o *TerraformCloudConfig) *TerraformCloudConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Hostname != nil {
+		r.Hostname = StringCopy(o.Hostname)
+	}
+
+	if o.Organization != nil {
+		r.Organization = StringCopy(o.Organization)
+	}
+
+	if o.Token != nil {
+		r.Token = StringCopy(o.Token)
+	}
+
+	if o.WorkspacePrefix != nil {
+		r.WorkspacePrefix = StringCopy(o.WorkspacePrefix)
+	}
+
+	r.WorkspaceTags = mergeSlices(r.WorkspaceTags, o.WorkspaceTags)
+
+	if o.RequiredProviders != nil {
+		for k, v := range o.RequiredProviders {
+			if r.RequiredProviders == nil {
+				r.RequiredProviders = make(map[string]interface{})
+			}
+			r.RequiredProviders[k] = v
+		}
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *TerraformCloudConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Hostname == nil || *c.Hostname == "" {
+		c.Hostname = String(DefaultTFCHostname)
+	}
+
+	if c.Organization == nil {
+		c.Organization = String("")
+	}
+
+	if c.Token == nil || *c.Token == "" {
+		c.Token = stringFromEnv([]string{"TFE_TOKEN"}, "")
+	}
+
+	if c.WorkspacePrefix == nil {
+		c.WorkspacePrefix = String(DefaultTFCWorkspacePrefix)
+	}
+
+	if c.WorkspaceTags == nil {
+		c.WorkspaceTags = []string{}
+	}
+
+	if c.RequiredProviders == nil {
+		c.RequiredProviders = make(map[string]interface{})
+	}
+}
+
+// Validate validates the values and nested values of the configuration struct
+func (c *TerraformCloudConfig) Validate() error {
+	if c == nil {
+		return fmt.Errorf("missing Terraform Cloud driver configuration")
+	}
+
+	if hostname := StringVal(c.Hostname); strings.Contains(hostname, "/") {
+		return fmt.Errorf("terraform-cloud: hostname must not include a "+
+			"scheme or path: %q", hostname)
+	}
+
+	if StringVal(c.Organization) == "" {
+		return fmt.Errorf("terraform-cloud: organization is required")
+	}
+
+	if StringVal(c.Token) == "" {
+		return fmt.Errorf("terraform-cloud: token is required. configure the " +
+			"token or set the TFE_TOKEN environment variable")
+	}
+
+	for _, tag := range c.WorkspaceTags {
+		if tag == "" {
+			return fmt.Errorf("terraform-cloud: workspace_tags must not " +
+				"contain empty tags")
+		}
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+// Sensitive information is redacted.
+func (c *TerraformCloudConfig) GoString() string {
+	if c == nil {
+		return "(*TerraformCloudConfig)(nil)"
+	}
+
+	return fmt.Sprintf("&TerraformCloudConfig{"+
+		"Hostname:%s, "+
+		"Organization:%s, "+
+		"Token:%s, "+
+		"WorkspacePrefix:%s, "+
+		"WorkspaceTags:%v, "+
+		"RequiredProviders:%+v"+
+		"}",
+		StringVal(c.Hostname),
+		StringVal(c.Organization),
+		sensitiveGoString(c.Token),
+		StringVal(c.WorkspacePrefix),
+		c.WorkspaceTags,
+		c.RequiredProviders,
+	)
+}
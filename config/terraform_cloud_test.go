@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformCloudConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &TerraformCloudConfig{Token: String("token")}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *TerraformCloudConfig
+	}{
+		{
+			"nil",
+			nil,
+		}, {
+			"empty",
+			&TerraformCloudConfig{},
+		}, {
+			"finalized",
+			finalizedConf,
+		}, {
+			"fully_configured",
+			&TerraformCloudConfig{
+				Hostname:        String("tfe.example.com"),
+				Organization:    String("org"),
+				Token:           String("token"),
+				WorkspacePrefix: String("prefix-"),
+				WorkspaceTags:   []string{"cts", "prod"},
+				RequiredProviders: map[string]interface{}{
+					"pName": map[string]string{
+						"version": "v0.0.0",
+						"source":  "namespace/pName",
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *TerraformCloudConfig
+		b    *TerraformCloudConfig
+		r    *TerraformCloudConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{},
+		},
+		{
+			"nil_b",
+			&TerraformCloudConfig{},
+			nil,
+			&TerraformCloudConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{},
+		},
+		{
+			"hostname_overrides",
+			&TerraformCloudConfig{Hostname: String("app.terraform.io")},
+			&TerraformCloudConfig{Hostname: String("tfe.example.com")},
+			&TerraformCloudConfig{Hostname: String("tfe.example.com")},
+		},
+		{
+			"organization_empty_two",
+			&TerraformCloudConfig{Organization: String("org")},
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{Organization: String("org")},
+		},
+		{
+			"token_empty_one",
+			&TerraformCloudConfig{},
+			&TerraformCloudConfig{Token: String("token")},
+			&TerraformCloudConfig{Token: String("token")},
+		},
+		{
+			"workspace_prefix_overrides",
+			&TerraformCloudConfig{WorkspacePrefix: String("cts-")},
+			&TerraformCloudConfig{WorkspacePrefix: String("")},
+			&TerraformCloudConfig{WorkspacePrefix: String("")},
+		},
+		{
+			"workspace_tags_merge",
+			&TerraformCloudConfig{WorkspaceTags: []string{"a", "b"}},
+			&TerraformCloudConfig{WorkspaceTags: []string{"b", "c"}},
+			&TerraformCloudConfig{WorkspaceTags: []string{"a", "b", "c"}},
+		},
+		{
+			"required_providers_merge",
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{
+				"a": "v0.1.0",
+			}},
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{
+				"b": "v0.2.0",
+			}},
+			&TerraformCloudConfig{RequiredProviders: map[string]interface{}{
+				"a": "v0.1.0",
+				"b": "v0.2.0",
+			}},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *TerraformCloudConfig
+		r    *TerraformCloudConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"defaults",
+			&TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String("token"),
+			},
+			&TerraformCloudConfig{
+				Hostname:          String(DefaultTFCHostname),
+				Organization:      String("org"),
+				Token:             String("token"),
+				WorkspacePrefix:   String(DefaultTFCWorkspacePrefix),
+				WorkspaceTags:     []string{},
+				RequiredProviders: map[string]interface{}{},
+			},
+		},
+		{
+			"configured",
+			&TerraformCloudConfig{
+				Hostname:        String("tfe.example.com"),
+				Organization:    String("org"),
+				Token:           String("token"),
+				WorkspacePrefix: String(""),
+				WorkspaceTags:   []string{"cts"},
+			},
+			&TerraformCloudConfig{
+				Hostname:          String("tfe.example.com"),
+				Organization:      String("org"),
+				Token:             String("token"),
+				WorkspacePrefix:   String(""),
+				WorkspaceTags:     []string{"cts"},
+				RequiredProviders: map[string]interface{}{},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestTerraformCloudConfig_Finalize_TokenEnv(t *testing.T) {
+	t.Setenv("TFE_TOKEN", "env-token")
+
+	c := &TerraformCloudConfig{}
+	c.Finalize()
+	assert.Equal(t, "env-token", StringVal(c.Token))
+
+	c = &TerraformCloudConfig{Token: String("token")}
+	c.Finalize()
+	assert.Equal(t, "token", StringVal(c.Token))
+}
+
+func TestTerraformCloudConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *TerraformCloudConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			false,
+		},
+		{
+			"valid",
+			&TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String("token"),
+			},
+			true,
+		},
+		{
+			"hostname_with_scheme",
+			&TerraformCloudConfig{
+				Hostname:     String("https://app.terraform.io"),
+				Organization: String("org"),
+				Token:        String("token"),
+			},
+			false,
+		},
+		{
+			"missing_organization",
+			&TerraformCloudConfig{
+				Token: String("token"),
+			},
+			false,
+		},
+		{
+			"missing_token",
+			&TerraformCloudConfig{
+				Organization: String("org"),
+				Token:        String(""),
+			},
+			false,
+		},
+		{
+			"empty_workspace_tag",
+			&TerraformCloudConfig{
+				Organization:  String("org"),
+				Token:         String("token"),
+				WorkspaceTags: []string{""},
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTerraformCloudConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &TerraformCloudConfig{
+		Hostname:          String("app.terraform.io"),
+		Organization:      String("org"),
+		Token:             String("token"),
+		WorkspacePrefix:   String("cts-"),
+		WorkspaceTags:     []string{"cts"},
+		RequiredProviders: map[string]interface{}{},
+	}
+	expected := "&TerraformCloudConfig{Hostname:app.terraform.io, " +
+		"Organization:org, Token:(redacted), WorkspacePrefix:cts-, " +
+		"WorkspaceTags:[cts], RequiredProviders:map[]}"
+	assert.Equal(t, expected, c.GoString())
+}
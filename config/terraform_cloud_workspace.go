@@ -7,15 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
-	ctsVersion "github.com/hashicorp/consul-terraform-sync/version"
-	goVersion "github.com/hashicorp/go-version"
 )
 
-// TerraformCloudWorkspaceConfig is an enterprise-only configuration that controls
-// workspace attributes that are specific to a task.
+// TerraformCloudWorkspaceConfig is a configuration for the Terraform Cloud
+// driver that controls workspace attributes that are specific to a task.
 type TerraformCloudWorkspaceConfig struct {
 	ExecutionMode    *string `mapstructure:"execution_mode" json:"execution_mode"`
 	AgentPoolID      *string `mapstructure:"agent_pool_id" json:"agent_pool_id"`
@@ -140,20 +137,9 @@ func (c *TerraformCloudWorkspaceConfig) Validate() error {
 	}
 
 	if c.TerraformVersion != nil && *c.TerraformVersion != "" {
-		v, err := goVersion.NewSemver(*c.TerraformVersion)
-		if err != nil {
+		if err := validateTerraformVersion(*c.TerraformVersion); err != nil {
 			return err
 		}
-
-		if len(strings.Split(*c.TerraformVersion, ".")) < 3 {
-			return fmt.Errorf("provide the exact Terraform version to install: %s", *c.TerraformVersion)
-		}
-
-		if !ctsVersion.TerraformConstraint.Check(v) {
-			return fmt.Errorf("Terraform version is not supported by Consul-"+
-				"Terraform-Sync, try updating to a different version (%s): %s",
-				ctsVersion.CompatibleTerraformVersionConstraint, *c.TerraformVersion)
-		}
 	}
 
 	return nil
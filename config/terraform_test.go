@@ -36,8 +36,9 @@ func TestTerraformConfig_Copy(t *testing.T) {
 		}, {
 			"same_enabled",
 			&TerraformConfig{
-				Log:  Bool(true),
-				Path: String("path"),
+				Product: String(ProductOpenTofu),
+				Log:     Bool(true),
+				Path:    String("path"),
 				Backend: map[string]interface{}{"consul": map[string]interface{}{
 					"path": "consul-terraform-sync/terraform",
 				}},
@@ -403,6 +404,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 			&TerraformConfig{},
 			nil,
 			&TerraformConfig{
+				Product:           String(ProductTerraform),
 				Version:           String(""),
 				Log:               Bool(false),
 				PersistLog:        Bool(false),
@@ -416,6 +418,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 			&TerraformConfig{},
 			consul,
 			&TerraformConfig{
+				Product:    String(ProductTerraform),
 				Version:    String(""),
 				Log:        Bool(false),
 				PersistLog: Bool(false),
@@ -441,6 +444,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 				},
 			},
 			&TerraformConfig{
+				Product:    String(ProductTerraform),
 				Version:    String(""),
 				Log:        Bool(false),
 				PersistLog: Bool(false),
@@ -467,6 +471,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 				KVPath:  String("custom-path"),
 			},
 			&TerraformConfig{
+				Product:    String(ProductTerraform),
 				Version:    String(""),
 				Log:        Bool(false),
 				PersistLog: Bool(false),
@@ -484,6 +489,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 		{
 			"terraform path empty string",
 			&TerraformConfig{
+				Product:    String(ProductTerraform),
 				Version:    String(""),
 				Log:        Bool(false),
 				PersistLog: Bool(false),
@@ -491,6 +497,7 @@ func TestTerraformConfig_Finalize(t *testing.T) {
 			},
 			nil,
 			&TerraformConfig{
+				Product:           String(ProductTerraform),
 				Version:           String(""),
 				Log:               Bool(false),
 				PersistLog:        Bool(false),
@@ -545,6 +552,36 @@ func TestTerraformConfig_Validate(t *testing.T) {
 			"backend_invalid",
 			&TerraformConfig{Backend: map[string]interface{}{"unsupported": nil}},
 			false,
+		}, {
+			"terraform version",
+			&TerraformConfig{
+				Version: String("1.1.8"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"terraform version unsupported",
+			&TerraformConfig{
+				Version: String("1.6.0-alpha"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
+		}, {
+			"opentofu version",
+			&TerraformConfig{
+				Product: String(ProductOpenTofu),
+				Version: String("1.8.8"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			true,
+		}, {
+			"opentofu version unsupported",
+			&TerraformConfig{
+				Product: String(ProductOpenTofu),
+				Version: String("1.1.8"),
+				Backend: map[string]interface{}{"local": nil},
+			},
+			false,
 		},
 	}
 
@@ -32,6 +32,7 @@ consul {
     password = "password"
   }
   kv_path = "kv_path"
+  persist_state = true
   tls {
     ca_cert = "ca_cert"
     ca_path = "ca_path"
@@ -113,4 +114,61 @@ task {
     datacenter = "dc2"
     namespace = "ns2"
   }
+  event_retention {
+    count = 3
+  }
+  notification {
+    urls = ["https://hooks.example.com/task"]
+    failures_only = true
+  }
+  blackout {
+    enabled = false
+  }
+  drift_detection {
+    interval = "30m"
+    remediate = true
+  }
+}
+
+local_state {
+  enabled = false
+  path = "state.db"
+}
+
+high_availability {
+  enabled = true
+  cluster_name = "cts-cluster"
+  session_ttl = "30s"
+}
+
+sharding {
+  enabled = false
+  session_ttl = "20s"
+}
+
+event_retention {
+  count = 10
+  max_age = "168h"
+}
+
+tracing {
+  enabled = true
+  endpoint = "otel-collector:4318"
+  insecure = true
+  headers = {
+    x-api-key = "abcd"
+  }
+}
+
+notification {
+  urls = ["https://hooks.example.com/cts"]
+  secret = "secret"
+}
+
+blackout {
+  window {
+    start = "0 0 18 * * FRI *"
+    duration = "62h"
+    time_zone = "America/New_York"
+  }
 }
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefaultTracingEndpoint is the default address of the OTLP/HTTP collector
+	// that traces are exported to
+	DefaultTracingEndpoint = "localhost:4318"
+)
+
+// TracingConfig configures exporting OpenTelemetry traces of task executions
+// to a collector over OTLP/HTTP.
+type TracingConfig struct {
+	// Enabled determines if traces are exported.
+	Enabled *bool `mapstructure:"enabled"`
+
+	// Endpoint is the host and port of the OTLP/HTTP collector.
+	Endpoint *string `mapstructure:"endpoint"`
+
+	// Insecure disables TLS when exporting traces to the collector.
+	Insecure *bool `mapstructure:"insecure"`
+
+	// Headers are additional HTTP headers sent to the collector with each
+	// export, e.g. for authentication.
+	Headers map[string]string `mapstructure:"headers"`
+}
+
+// DefaultTracingConfig returns the default configuration struct.
+func DefaultTracingConfig() *TracingConfig {
+	return &TracingConfig{}
+}
+
+// Copy returns a deep copy of this configuration.
+func (c *TracingConfig) Copy() *TracingConfig {
+	if c == nil {
+		return nil
+	}
+
+	var o TracingConfig
+	o.Enabled = BoolCopy(c.Enabled)
+	o.Endpoint = StringCopy(c.Endpoint)
+	o.Insecure = BoolCopy(c.Insecure)
+
+	if c.Headers != nil {
+		o.Headers = make(map[string]string, len(c.Headers))
+		for k, v := range c.Headers {
+			o.Headers[k] = v
+		}
+	}
+
+	return &o
+}
+
+// Merge combines all values in this configuration with the values in the other
+// configuration, with values in the other configuration taking precedence.
+// Maps and slices are merged, most other values are overwritten. Complex
+// structs define their own merge functionality.
+func (c *TracingConfig) Merge(o *TracingConfig) *TracingConfig {
+	if c == nil {
+		if o == nil {
+			return nil
+		}
+		return o.Copy()
+	}
+
+	if o == nil {
+		return c.Copy()
+	}
+
+	r := c.Copy()
+
+	if o.Enabled != nil {
+		r.Enabled = BoolCopy(o.Enabled)
+	}
+
+	if o.Endpoint != nil {
+		r.Endpoint = StringCopy(o.Endpoint)
+	}
+
+	if o.Insecure != nil {
+		r.Insecure = BoolCopy(o.Insecure)
+	}
+
+	if o.Headers != nil {
+		if r.Headers == nil {
+			r.Headers = make(map[string]string, len(o.Headers))
+		}
+		for k, v := range o.Headers {
+			r.Headers[k] = v
+		}
+	}
+
+	return r
+}
+
+// Finalize ensures there no nil pointers.
+func (c *TracingConfig) Finalize() {
+	if c == nil {
+		return
+	}
+
+	if c.Enabled == nil {
+		c.Enabled = Bool(false)
+	}
+
+	if c.Endpoint == nil {
+		c.Endpoint = String(DefaultTracingEndpoint)
+	}
+
+	if c.Insecure == nil {
+		c.Insecure = Bool(false)
+	}
+
+	if c.Headers == nil {
+		c.Headers = make(map[string]string)
+	}
+}
+
+// Validate validates the values and required options. This method is recommended
+// to run after Finalize() to ensure the configuration is safe to proceed.
+func (c *TracingConfig) Validate() error {
+	if c == nil || !BoolVal(c.Enabled) {
+		return nil
+	}
+
+	endpoint := StringVal(c.Endpoint)
+	if strings.Contains(endpoint, "://") {
+		return fmt.Errorf("tracing.endpoint must be a host and port without "+
+			"a scheme, got %q. use tracing.insecure to disable TLS", endpoint)
+	}
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		return fmt.Errorf("tracing.endpoint must be a host and port: %s", err)
+	}
+
+	return nil
+}
+
+// GoString defines the printable version of this struct.
+func (c *TracingConfig) GoString() string {
+	if c == nil {
+		return "(*TracingConfig)(nil)"
+	}
+
+	// Header values may contain credentials, so only keys are printed
+	keys := make([]string, 0, len(c.Headers))
+	for k := range c.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return fmt.Sprintf("&TracingConfig{"+
+		"Enabled:%t, "+
+		"Endpoint:%s, "+
+		"Insecure:%t, "+
+		"Headers:%s"+
+		"}",
+		BoolVal(c.Enabled),
+		StringVal(c.Endpoint),
+		BoolVal(c.Insecure),
+		keys,
+	)
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingConfig_Copy(t *testing.T) {
+	t.Parallel()
+
+	finalizedConf := &TracingConfig{}
+	finalizedConf.Finalize()
+
+	cases := []struct {
+		name string
+		a    *TracingConfig
+	}{
+		{
+			"nil",
+			nil,
+		},
+		{
+			"empty",
+			&TracingConfig{},
+		},
+		{
+			"finalized",
+			finalizedConf,
+		},
+		{
+			"fully_configured",
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("otel-collector:4318"),
+				Insecure: Bool(true),
+				Headers:  map[string]string{"x-api-key": "abcd"},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Copy()
+			assert.Equal(t, tc.a, r)
+		})
+	}
+}
+
+func TestTracingConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *TracingConfig
+		b    *TracingConfig
+		r    *TracingConfig
+	}{
+		{
+			"nil_a",
+			nil,
+			&TracingConfig{},
+			&TracingConfig{},
+		},
+		{
+			"nil_b",
+			&TracingConfig{},
+			nil,
+			&TracingConfig{},
+		},
+		{
+			"nil_both",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"enabled_overrides",
+			&TracingConfig{Enabled: Bool(true)},
+			&TracingConfig{Enabled: Bool(false)},
+			&TracingConfig{Enabled: Bool(false)},
+		},
+		{
+			"endpoint_overrides",
+			&TracingConfig{Endpoint: String("a:4318")},
+			&TracingConfig{Endpoint: String("b:4318")},
+			&TracingConfig{Endpoint: String("b:4318")},
+		},
+		{
+			"insecure_empty_two",
+			&TracingConfig{Insecure: Bool(true)},
+			&TracingConfig{},
+			&TracingConfig{Insecure: Bool(true)},
+		},
+		{
+			"headers_merged",
+			&TracingConfig{Headers: map[string]string{"a": "1", "b": "2"}},
+			&TracingConfig{Headers: map[string]string{"b": "3", "c": "4"}},
+			&TracingConfig{Headers: map[string]string{"a": "1", "b": "3", "c": "4"}},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			r := tc.a.Merge(tc.b)
+			assert.Equal(t, tc.r, r)
+		})
+	}
+}
+
+func TestTracingConfig_Finalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    *TracingConfig
+		r    *TracingConfig
+	}{
+		{
+			"nil",
+			nil,
+			nil,
+		},
+		{
+			"empty",
+			&TracingConfig{},
+			&TracingConfig{
+				Enabled:  Bool(false),
+				Endpoint: String(DefaultTracingEndpoint),
+				Insecure: Bool(false),
+				Headers:  map[string]string{},
+			},
+		},
+		{
+			"enabled",
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("otel-collector:4318"),
+			},
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("otel-collector:4318"),
+				Insecure: Bool(false),
+				Headers:  map[string]string{},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			tc.i.Finalize()
+			assert.Equal(t, tc.r, tc.i)
+		})
+	}
+}
+
+func TestTracingConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		i       *TracingConfig
+		isValid bool
+	}{
+		{
+			"nil",
+			nil,
+			true,
+		},
+		{
+			"disabled",
+			&TracingConfig{
+				Enabled:  Bool(false),
+				Endpoint: String("invalid"),
+			},
+			true,
+		},
+		{
+			"valid",
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("localhost:4318"),
+			},
+			true,
+		},
+		{
+			"scheme",
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("http://localhost:4318"),
+			},
+			false,
+		},
+		{
+			"missing_port",
+			&TracingConfig{
+				Enabled:  Bool(true),
+				Endpoint: String("localhost"),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			err := tc.i.Validate()
+			if tc.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTracingConfig_GoString(t *testing.T) {
+	t.Parallel()
+
+	c := &TracingConfig{
+		Enabled:  Bool(true),
+		Endpoint: String("localhost:4318"),
+		Insecure: Bool(true),
+		Headers:  map[string]string{"x-api-key": "secret"},
+	}
+	expected := "&TracingConfig{Enabled:true, Endpoint:localhost:4318, " +
+		"Insecure:true, Headers:[x-api-key]}"
+	assert.Equal(t, expected, c.GoString())
+	assert.NotContains(t, c.GoString(), "secret")
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
+	"github.com/hashicorp/go-uuid"
+)
+
+var _ api.PlanApprover = (*TasksManager)(nil)
+
+// pendingPlans tracks the plans of tasks with a manual apply mode that are
+// pending approval. A task has at most one pending plan, which is the plan
+// saved in the task's working directory.
+type pendingPlans struct {
+	mu sync.Mutex
+
+	// plans is the pending plan by task name
+	plans map[string]api.PendingPlan
+}
+
+func newPendingPlans() *pendingPlans {
+	return &pendingPlans{
+		plans: make(map[string]api.PendingPlan),
+	}
+}
+
+// set sets the pending plan of the task
+func (p *pendingPlans) set(plan api.PendingPlan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.plans[plan.TaskName] = plan
+}
+
+// get returns the pending plan of the task
+func (p *pendingPlans) get(taskName string) (api.PendingPlan, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[taskName]
+	return plan, ok
+}
+
+// take returns and clears the pending plan of the task if its ID matches
+func (p *pendingPlans) take(taskName, planID string) (api.PendingPlan, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[taskName]
+	if !ok || plan.ID != planID {
+		return api.PendingPlan{}, false
+	}
+	delete(p.plans, taskName)
+	return plan, true
+}
+
+// delete clears the pending plan of the task. Returns the cleared plan, if any.
+func (p *pendingPlans) delete(taskName string) (api.PendingPlan, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[taskName]
+	delete(p.plans, taskName)
+	return plan, ok
+}
+
+// TaskPendingPlans returns the plans of the task that are pending approval
+func (tm *TasksManager) TaskPendingPlans(taskName string) []api.PendingPlan {
+	if tm.pendingPlans == nil {
+		return nil
+	}
+	if plan, ok := tm.pendingPlans.get(taskName); ok {
+		return []api.PendingPlan{plan}
+	}
+	return nil
+}
+
+// TaskApprovePlan applies the changes of the pending plan of a task with a
+// manual apply mode and stores an event for the run
+func (tm *TasksManager) TaskApprovePlan(ctx context.Context, taskName, planID string) (err error) {
+	logger := tm.logger.With(taskNameLogKey, taskName, "plan_id", planID)
+
+	ctx, span := tracing.Start(ctx, "task.run", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
+	d, ok := tm.drivers.Get(taskName)
+	if !ok || tm.drivers.IsMarkedForDeletion(taskName) {
+		return fmt.Errorf("task '%s' does not exist", taskName)
+	}
+
+	if err := tm.waitForTaskInactive(ctx, taskName); err != nil {
+		return err
+	}
+	tm.drivers.SetActive(taskName)
+	defer tm.drivers.SetInactive(taskName)
+
+	// take the plan only once the task is inactive, so that the plan is not
+	// superseded by a run of the task while it is being applied
+	plan, ok := tm.takePendingPlan(taskName, planID)
+	if !ok {
+		return fmt.Errorf("plan '%s' is not pending for task '%s'. the plan "+
+			"may have been superseded by newer changes", planID, taskName)
+	}
+
+	task := d.Task()
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderIDs(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating event for task %s: %s",
+			taskName, err)
+	}
+	ev.Start()
+
+	logger.Info("plan approved, applying changes")
+	err = d.ApplyPlannedTask(ctx)
+	ev.Plan = plan.Plan
+	ev.End(err)
+	metrics.ObserveTaskRun(taskName, err, ev.EndTime.Sub(ev.StartTime))
+	logger.Trace("adding event", "event", ev.GoString())
+	if err := tm.state.AddTaskEvent(*ev); err != nil {
+		logger.Error("error storing event", "event", ev.GoString())
+	}
+	tm.notify(*ev)
+	if err != nil {
+		return fmt.Errorf("could not apply changes for task %s: %s",
+			taskName, err)
+	}
+
+	logger.Info("task completed")
+	if tm.ranTaskNotify != nil {
+		tm.ranTaskNotify <- taskName
+	}
+	return nil
+}
+
+// TaskRejectPlan discards the pending plan of a task with a manual apply mode
+// without applying its changes
+func (tm *TasksManager) TaskRejectPlan(_ context.Context, taskName, planID string) error {
+	if _, ok := tm.takePendingPlan(taskName, planID); !ok {
+		return fmt.Errorf("plan '%s' is not pending for task '%s'. the plan "+
+			"may have been superseded by newer changes", planID, taskName)
+	}
+
+	tm.logger.Info("plan rejected", taskNameLogKey, taskName, "plan_id", planID)
+	return nil
+}
+
+// takePendingPlan returns and clears the pending plan of the task by its ID
+func (tm *TasksManager) takePendingPlan(taskName, planID string) (api.PendingPlan, bool) {
+	if tm.pendingPlans == nil {
+		return api.PendingPlan{}, false
+	}
+	return tm.pendingPlans.take(taskName, planID)
+}
+
+// discardPendingPlan discards the pending plan of the task, if any
+func (tm *TasksManager) discardPendingPlan(taskName string) {
+	if tm.pendingPlans == nil {
+		return
+	}
+	if plan, ok := tm.pendingPlans.delete(taskName); ok {
+		tm.logger.Info("discarding stale plan pending approval",
+			taskNameLogKey, taskName, "plan_id", plan.ID)
+	}
+}
+
+// planForApproval plans the changes of a task with a manual apply mode and
+// stores the plan pending approval instead of applying it. A previously
+// pending plan of the task is stale and is discarded.
+func (tm *TasksManager) planForApproval(ctx context.Context, d driver.Driver) error {
+	taskName := d.Task().Name()
+	logger := tm.logger.With(taskNameLogKey, taskName)
+
+	tm.discardPendingPlan(taskName)
+
+	logger.Info("planning task changes for approval")
+	var changes bool
+	desc := fmt.Sprintf("PlanTask %s", taskName)
+	err := tm.retry.Do(ctx, func(ctx context.Context) error {
+		var err error
+		changes, err = d.PlanTask(ctx)
+		return err
+	}, desc)
+	if err != nil {
+		return err
+	}
+
+	if !changes {
+		logger.Info("task plan has no changes to approve")
+		return nil
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("error creating plan ID for task %s: %s",
+			taskName, err)
+	}
+	plan := api.PendingPlan{
+		ID:        id,
+		TaskName:  taskName,
+		CreatedAt: time.Now(),
+		Plan:      lastPlan(d),
+	}
+	if tm.pendingPlans != nil {
+		tm.pendingPlans.set(plan)
+	}
+
+	logger.Info("task plan pending approval", "plan_id", id)
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/api"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pendingPlans(t *testing.T) {
+	t.Parallel()
+
+	p := newPendingPlans()
+
+	_, ok := p.get("task_a")
+	assert.False(t, ok)
+
+	p.set(api.PendingPlan{ID: "plan-1", TaskName: "task_a"})
+	p.set(api.PendingPlan{ID: "plan-2", TaskName: "task_a"})
+	plan, ok := p.get("task_a")
+	assert.True(t, ok)
+	assert.Equal(t, "plan-2", plan.ID, "newer plan supersedes the pending plan")
+
+	_, ok = p.take("task_a", "plan-1")
+	assert.False(t, ok, "superseded plan cannot be taken")
+	plan, ok = p.take("task_a", "plan-2")
+	assert.True(t, ok)
+	assert.Equal(t, "plan-2", plan.ID)
+	_, ok = p.get("task_a")
+	assert.False(t, ok)
+
+	p.set(api.PendingPlan{ID: "plan-3", TaskName: "task_a"})
+	_, ok = p.delete("task_a")
+	assert.True(t, ok)
+	_, ok = p.delete("task_a")
+	assert.False(t, ok)
+}
+
+func manualTestTask(tb testing.TB, name string) *driver.Task {
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:      name,
+		Enabled:   true,
+		ApplyMode: config.ApplyModeManual,
+	})
+	require.NoError(tb, err)
+	return task
+}
+
+func Test_TasksManager_TaskRunNow_ManualApply(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		changes bool
+		planErr error
+		pending bool
+	}{
+		{
+			"changes",
+			true,
+			nil,
+			true,
+		},
+		{
+			"no_changes",
+			false,
+			nil,
+			false,
+		},
+		{
+			"plan_error",
+			false,
+			errors.New("error tf-plan"),
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := new(mocksD.Driver)
+			d.On("Task").Return(manualTestTask(t, "task_a"))
+			d.On("TemplateIDs").Return(nil)
+			d.On("RenderTemplate", mock.Anything).Return(true, nil)
+			d.On("PlanTask", mock.Anything).Return(tc.changes, tc.planErr)
+
+			tm := newTestTasksManager()
+			tm.retry = retry.NewTestRetry(0)
+			tm.drivers.Add("task_a", d)
+
+			// a plan pending from previous changes is stale
+			tm.pendingPlans.set(api.PendingPlan{ID: "stale", TaskName: "task_a"})
+
+			err := tm.TaskRunNow(context.Background(), "task_a")
+			if tc.planErr != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+			plans := tm.TaskPendingPlans("task_a")
+			if tc.pending {
+				require.Len(t, plans, 1)
+				assert.NotEqual(t, "stale", plans[0].ID)
+				assert.Equal(t, "task_a", plans[0].TaskName)
+			} else {
+				assert.Empty(t, plans)
+			}
+
+			events := tm.state.GetTaskEvents("task_a")["task_a"]
+			if tc.planErr != nil {
+				require.Len(t, events, 1)
+				assert.False(t, events[0].Success)
+			} else {
+				assert.Empty(t, events, "no event until the plan is applied")
+			}
+		})
+	}
+}
+
+func Test_TasksManager_TaskApprovePlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("approved", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("Task").Return(manualTestTask(t, "task_a"))
+		d.On("TemplateIDs").Return(nil)
+		d.On("ApplyPlannedTask", mock.Anything).Return(nil).Once()
+
+		tm := newTestTasksManager()
+		tm.drivers.Add("task_a", d)
+		tm.pendingPlans.set(api.PendingPlan{ID: "plan-1", TaskName: "task_a"})
+
+		require.NoError(t, tm.TaskApprovePlan(context.Background(), "task_a", "plan-1"))
+		d.AssertExpectations(t)
+		assert.Empty(t, tm.TaskPendingPlans("task_a"))
+
+		events := tm.state.GetTaskEvents("task_a")["task_a"]
+		require.Len(t, events, 1)
+		assert.True(t, events[0].Success)
+
+		// the plan can only be applied once
+		assert.Error(t, tm.TaskApprovePlan(context.Background(), "task_a", "plan-1"))
+	})
+
+	t.Run("superseded", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("Task").Return(manualTestTask(t, "task_a"))
+		d.On("TemplateIDs").Return(nil)
+
+		tm := newTestTasksManager()
+		tm.drivers.Add("task_a", d)
+		tm.pendingPlans.set(api.PendingPlan{ID: "plan-2", TaskName: "task_a"})
+
+		assert.Error(t, tm.TaskApprovePlan(context.Background(), "task_a", "plan-1"))
+		d.AssertNotCalled(t, "ApplyPlannedTask", mock.Anything)
+		assert.Len(t, tm.TaskPendingPlans("task_a"), 1)
+	})
+
+	t.Run("apply_error", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("Task").Return(manualTestTask(t, "task_a"))
+		d.On("TemplateIDs").Return(nil)
+		d.On("ApplyPlannedTask", mock.Anything).Return(errors.New("error tf-apply"))
+
+		tm := newTestTasksManager()
+		tm.drivers.Add("task_a", d)
+		tm.pendingPlans.set(api.PendingPlan{ID: "plan-1", TaskName: "task_a"})
+
+		assert.Error(t, tm.TaskApprovePlan(context.Background(), "task_a", "plan-1"))
+		events := tm.state.GetTaskEvents("task_a")["task_a"]
+		require.Len(t, events, 1)
+		assert.False(t, events[0].Success)
+	})
+
+	t.Run("task_not_found", func(t *testing.T) {
+		tm := newTestTasksManager()
+		assert.Error(t, tm.TaskApprovePlan(context.Background(), "task_a", "plan-1"))
+	})
+}
+
+func Test_TasksManager_TaskRejectPlan(t *testing.T) {
+	t.Parallel()
+
+	tm := newTestTasksManager()
+	tm.pendingPlans.set(api.PendingPlan{ID: "plan-1", TaskName: "task_a"})
+
+	assert.Error(t, tm.TaskRejectPlan(context.Background(), "task_a", "plan-0"))
+	assert.Len(t, tm.TaskPendingPlans("task_a"), 1)
+
+	assert.NoError(t, tm.TaskRejectPlan(context.Background(), "task_a", "plan-1"))
+	assert.Empty(t, tm.TaskPendingPlans("task_a"))
+}
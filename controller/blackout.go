@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api"
+)
+
+var _ api.BlackoutReporter = (*TasksManager)(nil)
+
+// blackouts tracks the tasks with changes that are deferred until the end of a
+// blackout window
+type blackouts struct {
+	mu sync.Mutex
+
+	// pending is the end of the blackout window by task name
+	pending map[string]time.Time
+}
+
+func newBlackouts() *blackouts {
+	return &blackouts{
+		pending: make(map[string]time.Time),
+	}
+}
+
+// setPending marks the task as pending until the end of the blackout window.
+// Returns false if the task was already pending.
+func (b *blackouts) setPending(taskName string, end time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.pending[taskName]
+	b.pending[taskName] = end
+	return !ok
+}
+
+// update updates the end of the blackout window of a pending task. Returns
+// false if the task is not pending.
+func (b *blackouts) update(taskName string, end time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.pending[taskName]; !ok {
+		return false
+	}
+	b.pending[taskName] = end
+	return true
+}
+
+// get returns the end of the blackout window of a pending task
+func (b *blackouts) get(taskName string) (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	end, ok := b.pending[taskName]
+	return end, ok
+}
+
+// delete clears the pending state of the task
+func (b *blackouts) delete(taskName string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.pending, taskName)
+}
+
+// TaskBlackout returns whether the task has changes that are deferred until
+// the end of a blackout window and when the window ends
+func (tm *TasksManager) TaskBlackout(taskName string) (time.Time, bool) {
+	if tm.blackouts == nil {
+		return time.Time{}, false
+	}
+	return tm.blackouts.get(taskName)
+}
+
+// activeBlackout returns whether the task is within one of its blackout windows
+// or the global blackout windows and, if so, when the blackout ends
+func (tm *TasksManager) activeBlackout(taskName string) (time.Time, bool) {
+	conf := tm.state.GetConfig().Blackout
+	if tc, ok := tm.state.GetTask(taskName); ok && tc.Blackout != nil {
+		conf = conf.Merge(tc.Blackout)
+	}
+	// the task's blackout configuration is not finalized
+	conf.Finalize()
+	return conf.Active(time.Now())
+}
+
+// deferApply returns true if the task is within a blackout window, in which
+// case applying the task's changes is deferred until the window ends. Changes
+// of the task during the window are coalesced into a single apply.
+func (tm *TasksManager) deferApply(ctx context.Context, taskName string) bool {
+	if tm.blackouts == nil {
+		return false
+	}
+
+	end, ok := tm.activeBlackout(taskName)
+	if !ok {
+		return false
+	}
+
+	tm.logger.Info("task is in a blackout window, deferring changes until "+
+		"the window ends", taskNameLogKey, taskName, "ends_at", end)
+	if tm.blackouts.setPending(taskName, end) {
+		go tm.applyAfterBlackout(ctx, taskName)
+	}
+	return true
+}
+
+// applyAfterBlackout waits until the blackout window of a pending task ends and
+// then applies the task with its currently rendered template
+func (tm *TasksManager) applyAfterBlackout(ctx context.Context, taskName string) {
+	logger := tm.logger.With(taskNameLogKey, taskName)
+
+	for {
+		end, ok := tm.blackouts.get(taskName)
+		if !ok {
+			return
+		}
+
+		select {
+		case <-time.After(time.Until(end)):
+		case <-ctx.Done():
+			tm.blackouts.delete(taskName)
+			return
+		}
+
+		// the blackout can be extended, e.g. by overlapping windows of an
+		// updated configuration, in which case wait for the new end
+		end, ok = tm.activeBlackout(taskName)
+		if !ok {
+			break
+		}
+		if !tm.blackouts.update(taskName, end) {
+			// the task is no longer pending, e.g. the task was deleted
+			return
+		}
+	}
+
+	tm.blackouts.delete(taskName)
+	if tm.cluster != nil && tm.cluster.skipApply(taskName) {
+		logger.Debug("task is executed by another instance, skipping")
+		return
+	}
+
+	logger.Info("blackout window ended, applying deferred changes")
+	if err := tm.applyTask(ctx, taskName); err != nil {
+		logger.Error("error applying task after blackout window", "error", err)
+	}
+}
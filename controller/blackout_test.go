@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_blackouts(t *testing.T) {
+	t.Parallel()
+
+	b := newBlackouts()
+	end := time.Now().Add(time.Hour)
+
+	_, ok := b.get("task_a")
+	assert.False(t, ok)
+	assert.False(t, b.update("task_a", end), "task is not pending")
+
+	assert.True(t, b.setPending("task_a", end))
+	assert.False(t, b.setPending("task_a", end), "task is already pending")
+
+	later := end.Add(time.Hour)
+	assert.True(t, b.update("task_a", later))
+	actual, ok := b.get("task_a")
+	assert.True(t, ok)
+	assert.Equal(t, later, actual)
+
+	b.delete("task_a")
+	_, ok = b.get("task_a")
+	assert.False(t, ok)
+}
+
+func Test_TasksManager_TaskRunNow_Blackout(t *testing.T) {
+	t.Parallel()
+
+	// blackout window that is always active
+	blackout := &config.BlackoutConfig{
+		Windows: []*config.BlackoutWindowConfig{
+			{
+				Start:    config.String("* * * * * * *"),
+				Duration: config.TimeDuration(time.Hour),
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		global   *config.BlackoutConfig
+		task     *config.BlackoutConfig
+		deferred bool
+	}{
+		{
+			"no_blackout",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"global_blackout",
+			blackout,
+			nil,
+			true,
+		},
+		{
+			"task_blackout",
+			nil,
+			blackout,
+			true,
+		},
+		{
+			"task_exempt",
+			blackout,
+			&config.BlackoutConfig{Enabled: config.Bool(false)},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := new(mocksD.Driver)
+			d.On("Task").Return(enabledTestTask(t, "task_a"))
+			d.On("TemplateIDs").Return(nil)
+			d.On("RenderTemplate", mock.Anything).Return(true, nil)
+			d.On("ApplyTask", mock.Anything).Return(nil)
+
+			conf := &config.Config{
+				Tasks:    &config.TaskConfigs{},
+				Blackout: tc.global.Copy(),
+			}
+			conf.Blackout.Finalize()
+
+			tm := newTestTasksManager()
+			tm.retry = retry.NewTestRetry(0)
+			tm.state = state.NewInMemoryStore(conf)
+			require.NoError(t, tm.state.SetTask(config.TaskConfig{
+				Name:     config.String("task_a"),
+				Blackout: tc.task,
+			}))
+			tm.drivers.Add("task_a", d)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			require.NoError(t, tm.TaskRunNow(ctx, "task_a"))
+
+			end, pending := tm.TaskBlackout("task_a")
+			assert.Equal(t, tc.deferred, pending)
+			if tc.deferred {
+				assert.True(t, end.After(time.Now()))
+				d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+			} else {
+				d.AssertCalled(t, "ApplyTask", mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_TasksManager_applyAfterBlackout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("window_ended", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("Task").Return(enabledTestTask(t, "task_a"))
+		d.On("TemplateIDs").Return(nil)
+		d.On("ApplyTask", mock.Anything).Return(nil).Once()
+
+		tm := newTestTasksManager()
+		tm.retry = retry.NewTestRetry(0)
+		tm.drivers.Add("task_a", d)
+		tm.blackouts.setPending("task_a", time.Now())
+
+		tm.applyAfterBlackout(context.Background(), "task_a")
+
+		d.AssertExpectations(t)
+		_, pending := tm.TaskBlackout("task_a")
+		assert.False(t, pending)
+	})
+
+	t.Run("ctx_cancelled", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("TemplateIDs").Return(nil)
+
+		tm := newTestTasksManager()
+		tm.drivers.Add("task_a", d)
+		tm.blackouts.setPending("task_a", time.Now().Add(time.Hour))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		tm.applyAfterBlackout(ctx, "task_a")
+
+		d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+		_, pending := tm.TaskBlackout("task_a")
+		assert.False(t, pending)
+	})
+
+	t.Run("not_pending", func(t *testing.T) {
+		d := new(mocksD.Driver)
+		d.On("TemplateIDs").Return(nil)
+
+		tm := newTestTasksManager()
+		tm.drivers.Add("task_a", d)
+
+		tm.applyAfterBlackout(context.Background(), "task_a")
+		d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+	})
+}
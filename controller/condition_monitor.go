@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/hashicorp/cronexpr"
 )
 
@@ -28,6 +29,10 @@ type ConditionMonitor struct {
 
 	// scheduleStopChs is a map of channels used to stop scheduled tasks
 	scheduleStopChs map[string](chan struct{})
+
+	// driftStopChs is a map of channels used to stop the drift detection of
+	// tasks
+	driftStopChs map[string](chan struct{})
 }
 
 // NewConditionMonitor configures a new condition monitor
@@ -39,6 +44,7 @@ func NewConditionMonitor(tm *TasksManager, w templates.Watcher) *ConditionMonito
 		watcher:         w,
 		tasksManager:    tm,
 		scheduleStopChs: make(map[string](chan struct{})),
+		driftStopChs:    make(map[string](chan struct{})),
 	}
 }
 
@@ -68,7 +74,8 @@ func (cm *ConditionMonitor) WatchDep(ctx context.Context) error {
 //
 // The blocking call runs the main Consul monitoring loop, which identifies triggers
 // for dynamic tasks. Scheduled tasks use their own go routine to trigger on
-// schedule.
+// schedule, and tasks with drift detection enabled use their own go routine to
+// detect drift on an interval.
 func (cm *ConditionMonitor) Run(ctx context.Context) error {
 	// Assumes buffer_period was set by tasksManager when adding task to CTS
 
@@ -81,6 +88,9 @@ func (cm *ConditionMonitor) Run(ctx context.Context) error {
 	if cm.scheduleStopChs == nil {
 		cm.scheduleStopChs = make(map[string](chan struct{}))
 	}
+	if cm.driftStopChs == nil {
+		cm.driftStopChs = make(map[string](chan struct{}))
+	}
 
 	// This wait prevents timing issues where repeated calls to Run()
 	// and cancelling a context would have overlapping Watch calls.
@@ -128,6 +138,23 @@ func (cm *ConditionMonitor) Run(ctx context.Context) error {
 			}
 			delete(cm.scheduleStopChs, taskName)
 
+		case taskName := <-cm.tasksManager.WatchCreatedDriftTasks():
+			// Cancel existing drift detection before starting the new one.
+			if stopCh, ok := cm.driftStopChs[taskName]; ok && stopCh != nil {
+				stopCh <- struct{}{}
+			}
+			stopCh := make(chan struct{}, 1)
+			cm.driftStopChs[taskName] = stopCh
+			go cm.runDriftDetection(ctx, taskName, stopCh)
+
+		case taskName := <-cm.tasksManager.WatchDeletedDriftTask():
+			// Stop drift detection of deleted tasks
+			stopCh := cm.driftStopChs[taskName]
+			if stopCh != nil {
+				stopCh <- struct{}{}
+			}
+			delete(cm.driftStopChs, taskName)
+
 		case <-ctx.Done():
 			cm.logger.Info("stop monitoring tasks")
 			waitForWatchCancel.Wait()
@@ -136,6 +163,9 @@ func (cm *ConditionMonitor) Run(ctx context.Context) error {
 			for taskName := range cm.scheduleStopChs {
 				delete(cm.scheduleStopChs, taskName)
 			}
+			for taskName := range cm.driftStopChs {
+				delete(cm.driftStopChs, taskName)
+			}
 			return ctx.Err()
 		}
 
@@ -144,9 +174,13 @@ func (cm *ConditionMonitor) Run(ctx context.Context) error {
 }
 
 // runDynamicTask will execute the task as necessary
-func (cm *ConditionMonitor) runDynamicTask(ctx context.Context, taskName string) error {
+func (cm *ConditionMonitor) runDynamicTask(ctx context.Context, taskName string) (err error) {
 	logger := cm.logger.With(taskNameLogKey, taskName)
 
+	ctx, span := tracing.Start(ctx, "condition_monitor.notify",
+		tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
 	task, err := cm.tasksManager.Task(ctx, taskName)
 	if err != nil {
 		logger.Warn("dynamic task cannot be run. task may have been deleted",
@@ -184,6 +218,9 @@ func (cm *ConditionMonitor) runScheduledTask(ctx context.Context, taskName strin
 	}
 
 	cond, ok := task.Condition.(*config.ScheduleConditionConfig)
+	if composite, isComposite := task.Condition.(*config.CompositeConditionConfig); isComposite {
+		cond, ok = composite.Schedule()
+	}
 	if !ok {
 		logger.Error("unexpected condition while running a scheduled "+
 			"condition", "condition_type", fmt.Sprintf("%T", task.Condition))
@@ -196,9 +233,17 @@ func (cm *ConditionMonitor) runScheduledTask(ctx context.Context, taskName strin
 		logger.Error("error parsing task cron", "cron", *cond.Cron, "error", err)
 		return err
 	}
+	loc, err := cond.Location()
+	if err != nil {
+		logger.Error("error loading task schedule time zone", "time_zone",
+			config.StringVal(cond.TimeZone), "error", err)
+		return err
+	}
+	defer cm.tasksManager.clearNextRun(taskName)
 
-	nextTime := expr.Next(time.Now())
+	nextTime := expr.Next(time.Now().In(loc))
 	waitTime := time.Until(nextTime)
+	cm.tasksManager.setNextRun(taskName, nextTime)
 	logger.Info("scheduled task next run time", "wait_time", waitTime,
 		"next_runtime", nextTime)
 
@@ -214,13 +259,23 @@ func (cm *ConditionMonitor) runScheduledTask(ctx context.Context, taskName strin
 				return nil
 			}
 
-			if err := cm.tasksManager.TaskRunNow(ctx, taskName); err != nil {
-				// print error but continue
-				logger.Error("error running task", "error", err)
+			if cm.tasksManager.takeConditionsMet(taskName) {
+				runCtx, span := tracing.Start(ctx, "condition_monitor.schedule",
+					tracing.TaskName(taskName))
+				err := cm.tasksManager.TaskRunNow(runCtx, taskName)
+				tracing.End(span, err)
+				if err != nil {
+					// print error but continue
+					logger.Error("error running task", "error", err)
+				}
+			} else {
+				logger.Info("scheduled task triggered but not all of its " +
+					"conditions were met, skipping")
 			}
 
-			nextTime := expr.Next(time.Now())
+			nextTime := expr.Next(time.Now().In(loc))
 			waitTime = time.Until(nextTime)
+			cm.tasksManager.setNextRun(taskName, nextTime)
 			logger.Info("scheduled task next run time", "wait_time", waitTime,
 				"next_runtime", nextTime)
 		case <-stopCh:
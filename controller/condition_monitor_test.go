@@ -46,7 +46,7 @@ func Test_ConditionMonitor_runDynamicTask(t *testing.T) {
 		d.On("Task").Return(enabledTestTask(t, validTaskName)).
 			On("TemplateIDs").Return(nil).
 			On("RenderTemplate", mock.Anything).Return(true, nil).
-			On("ApplyTask", ctx).Return(nil)
+			On("ApplyTask", mock.Anything).Return(nil)
 		tm.drivers.Add(validTaskName, d)
 
 		cm := newTestConditionMonitor(tm)
@@ -116,6 +116,9 @@ func Test_ConditionMonitor_runScheduledTask(t *testing.T) {
 			}
 		}()
 		time.Sleep(3 * time.Second)
+		next, ok := tm.TaskNextRun(schedTaskName)
+		assert.True(t, ok, "expected next run time while scheduled")
+		assert.True(t, next.After(time.Now().Add(-time.Second)))
 		cancel()
 
 		select {
@@ -126,6 +129,50 @@ func Test_ConditionMonitor_runScheduledTask(t *testing.T) {
 		}
 
 		d.AssertExpectations(t)
+		_, ok = tm.TaskNextRun(schedTaskName)
+		assert.False(t, ok, "expected next run time to be cleared once stopped")
+	})
+
+	t.Run("time-zone", func(t *testing.T) {
+		// Tests that the next run time is computed in the schedule's time zone
+		conf := *schedTaskConf.Copy()
+		conf.Condition = &config.ScheduleConditionConfig{
+			ScheduleMonitorConfig: config.ScheduleMonitorConfig{
+				Cron:     config.String("0 0 0 * * * *"),
+				TimeZone: config.String("Asia/Tokyo"),
+			},
+		}
+		tm := newTestTasksManager()
+		err := tm.state.SetTask(conf)
+		require.NoError(t, err, "unexpected error while setting task state")
+
+		cm := newTestConditionMonitor(tm)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- cm.runScheduledTask(ctx, schedTaskName, make(chan struct{}))
+		}()
+
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			next, ok := tm.TaskNextRun(schedTaskName)
+			if !ok {
+				return false
+			}
+			next = next.In(tokyo)
+			return next.Hour() == 0 && next.Minute() == 0 && next.Second() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		select {
+		case err := <-errCh:
+			assert.Equal(t, context.Canceled, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("runScheduledTask did not exit properly from cancelling context")
+		}
 	})
 
 	t.Run("dynamic-task-errors", func(t *testing.T) {
@@ -592,6 +639,7 @@ func newTestConditionMonitor(tm *TasksManager) *ConditionMonitor {
 		logger:          logging.NewNullLogger(),
 		tasksManager:    newTestTasksManager(),
 		scheduleStopChs: make(map[string](chan struct{})),
+		driftStopChs:    make(map[string](chan struct{})),
 	}
 	if tm != nil {
 		cm.tasksManager = tm
@@ -32,8 +32,15 @@ type Controller interface {
 
 // InstallDriver installs necessary drivers based on user configuration.
 func InstallDriver(ctx context.Context, conf *config.Config) error {
-	if conf.Driver.Terraform != nil {
-		return driver.InstallTerraform(ctx, conf.Driver.Terraform)
+	if conf.Driver.TerraformCloud != nil {
+		// Terraform is executed remotely, nothing to install
+		return nil
+	}
+	if conf.Driver.Plugin != nil {
+		return driver.VerifyPlugin(*conf.Driver.Plugin.Path)
+	}
+	if tfConf := conf.Driver.TerraformCLI(); tfConf != nil {
+		return driver.InstallTerraform(ctx, tfConf)
 	}
 	return errors.New("unsupported driver")
 }
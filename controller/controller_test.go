@@ -53,6 +53,53 @@ func TestNewControllers(t *testing.T) {
 				return singleTaskConfig(t)
 			},
 		},
+		{
+			"terraform cloud driver",
+			false,
+			func() *config.Config {
+				conf := singleTaskConfig(t)
+				conf.Consul.Address = &addr
+				conf.Driver = &config.DriverConfig{
+					TerraformCloud: &config.TerraformCloudConfig{
+						Organization: config.String("org"),
+						Token:        config.String("token"),
+					},
+				}
+				err = conf.Finalize()
+				require.NoError(t, err)
+				return conf
+			},
+		},
+		{
+			"opentofu driver",
+			false,
+			func() *config.Config {
+				conf := singleTaskConfig(t)
+				conf.Consul.Address = &addr
+				conf.Driver = &config.DriverConfig{
+					OpenTofu: &config.TerraformConfig{},
+				}
+				err = conf.Finalize()
+				require.NoError(t, err)
+				return conf
+			},
+		},
+		{
+			"plugin driver",
+			false,
+			func() *config.Config {
+				conf := singleTaskConfig(t)
+				conf.Consul.Address = &addr
+				conf.Driver = &config.DriverConfig{
+					Plugin: &config.PluginConfig{
+						Path: config.String("/opt/cts/plugin"),
+					},
+				}
+				err = conf.Finalize()
+				require.NoError(t, err)
+				return conf
+			},
+		},
 		{
 			"unsupported driver error",
 			true,
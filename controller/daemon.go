@@ -5,15 +5,18 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/api"
 	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/health"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
 	"github.com/hashicorp/consul-terraform-sync/registration"
 	"github.com/hashicorp/consul-terraform-sync/state"
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 )
 
 var (
@@ -21,6 +24,9 @@ var (
 
 	// Number of times to retry attempts
 	defaultRetry = 2
+
+	// Time to wait for the remaining spans to be exported when stopping
+	tracingShutdownTimeout = 5 * time.Second
 )
 
 // Daemon is the controller to run CTS as a daemon. It executes the tasks once
@@ -36,8 +42,17 @@ type Daemon struct {
 
 	consulClient client.ConsulClientInterface
 
+	// election is only initialized when high availability is configured
+	election *leaderElection
+
+	// shards is only initialized when sharding is configured
+	shards *shardManager
+
 	// indicates whether the tasks have gone through once-mode or not
 	once bool
+
+	// stopTracing flushes the remaining spans and stops exporting traces
+	stopTracing func(context.Context) error
 }
 
 // NewDaemon configures and initializes a new Daemon controller
@@ -45,18 +60,78 @@ func NewDaemon(conf *config.Config) (*Daemon, error) {
 	logger := logging.Global().Named(ctrlSystemName)
 	logger.Info("setting up controller", "type", "daemon")
 
-	s := state.NewInMemoryStore(conf)
-
 	logger.Info("initializing Consul client and testing connection")
 	watcher, err := newWatcher(conf, client.ConsulDefaultMaxRetry)
 	if err != nil {
 		return nil, err
 	}
 
+	var s state.Store
+	var consulClient client.ConsulClientInterface
+	if config.BoolVal(conf.Consul.PersistState) {
+		logger.Info("persisting state in Consul KV",
+			"kv_path", config.StringVal(conf.Consul.KVPath))
+		c, err := client.NewConsulClient(conf.Consul, client.ConsulDefaultMaxRetry)
+		if err != nil {
+			logger.Error("error setting up Consul client", "error", err)
+			return nil, err
+		}
+		consulClient = c
+		s = state.NewConsulKVStore(conf, c)
+	} else if conf.LocalState != nil && config.BoolVal(conf.LocalState.Enabled) {
+		path := conf.LocalState.ResolvePath(config.StringVal(conf.WorkingDir))
+		logger.Info("persisting state in local file", "path", path)
+		s = state.NewLocalFileStore(conf, path)
+	} else {
+		s = state.NewInMemoryStore(conf)
+	}
+
 	tm, err := NewTasksManager(conf, s, watcher)
 	if err != nil {
 		return nil, err
 	}
+	metrics.SetTaskCounter(tm.countTasks)
+
+	if conf.Tracing != nil && config.BoolVal(conf.Tracing.Enabled) {
+		logger.Info("exporting traces", "endpoint",
+			config.StringVal(conf.Tracing.Endpoint))
+	}
+	stopTracing, err := tracing.Init(context.Background(), conf.Tracing)
+	if err != nil {
+		logger.Error("error setting up tracing", "error", err)
+		return nil, err
+	}
+
+	var election *leaderElection
+	if conf.HighAvailability != nil && config.BoolVal(conf.HighAvailability.Enabled) {
+		logger.Info("high availability enabled",
+			"cluster_name", config.StringVal(conf.HighAvailability.ClusterName))
+		if consulClient == nil {
+			c, err := client.NewConsulClient(conf.Consul, client.ConsulDefaultMaxRetry)
+			if err != nil {
+				logger.Error("error setting up Consul client", "error", err)
+				return nil, err
+			}
+			consulClient = c
+		}
+		election = newLeaderElection(conf, consulClient)
+		tm.cluster = election
+	}
+
+	var shards *shardManager
+	if conf.Sharding != nil && config.BoolVal(conf.Sharding.Enabled) {
+		logger.Info("sharding enabled", "kv_path", config.StringVal(conf.Consul.KVPath))
+		if consulClient == nil {
+			c, err := client.NewConsulClient(conf.Consul, client.ConsulDefaultMaxRetry)
+			if err != nil {
+				logger.Error("error setting up Consul client", "error", err)
+				return nil, err
+			}
+			consulClient = c
+		}
+		shards = newShardManager(conf, consulClient)
+		tm.cluster = shards
+	}
 
 	return &Daemon{
 		logger:       logger,
@@ -64,12 +139,25 @@ func NewDaemon(conf *config.Config) (*Daemon, error) {
 		tasksManager: tm,
 		watcher:      watcher,
 		monitor:      NewConditionMonitor(tm, watcher),
+		consulClient: consulClient,
+		election:     election,
+		shards:       shards,
+		stopTracing:  stopTracing,
 	}, nil
 }
 
 // Init initializes the controller before it can be run. Ensures that
-// driver is initializes, works are created for each task.
+// persisted state is loaded, driver is initializes, works are created for each
+// task.
 func (ctrl *Daemon) Init(ctx context.Context) error {
+	if s, ok := ctrl.state.(state.PersistentStore); ok {
+		ctrl.logger.Info("loading persisted state")
+		if err := s.Load(ctx); err != nil {
+			ctrl.logger.Error("error loading persisted state", "error", err)
+			return err
+		}
+	}
+
 	return ctrl.tasksManager.Init(ctx)
 }
 
@@ -79,12 +167,21 @@ func (ctrl *Daemon) Run(ctx context.Context) error {
 
 	// Configure API
 	conf := ctrl.tasksManager.state.GetConfig()
-	s, err := api.NewAPI(ctx, api.Config{
+	apiConf := api.Config{
 		Controller: ctrl.tasksManager,
 		Health:     &health.BasicChecker{},
 		Port:       config.IntVal(conf.Port),
 		TLS:        conf.TLS,
-	})
+	}
+	if ctrl.election != nil {
+		apiConf.Elector = ctrl.election
+		apiConf.Interceptor = api.NewFollowerInterceptor(ctrl.election)
+	}
+	if ctrl.shards != nil {
+		apiConf.Sharder = ctrl.shards
+		apiConf.Interceptor = api.NewShardInterceptor(ctrl.shards)
+	}
+	s, err := api.NewAPI(ctx, apiConf)
 	if err != nil {
 		return err
 	}
@@ -112,11 +209,16 @@ func (ctrl *Daemon) Run(ctx context.Context) error {
 		}
 
 		// Configure and start service registration manager
+		var tags []string
+		if ctrl.election != nil {
+			tags = []string{ctrl.election.Role()}
+		}
 		rm = registration.NewServiceRegistrationManager(
 			&registration.ServiceRegistrationManagerConfig{
 				ID:                  *conf.ID,
 				Port:                *conf.Port,
 				TLSEnabled:          conf.TLS != nil && *conf.TLS.Enabled,
+				Tags:                tags,
 				ServiceRegistration: conf.Consul.ServiceRegistration,
 			},
 			ctrl.consulClient)
@@ -127,13 +229,41 @@ func (ctrl *Daemon) Run(ctx context.Context) error {
 		}()
 	}
 
-	// Run tasks once through once-mode
+	if ctrl.election != nil {
+		// Expect one more long-running goroutine
+		exitBufLen++
+		exitCh = make(chan error, exitBufLen)
+
+		go func() {
+			exitCh <- ctrl.election.Run(ctx)
+		}()
+	}
+
+	if ctrl.shards != nil {
+		// Expect one more long-running goroutine
+		exitBufLen++
+		exitCh = make(chan error, exitBufLen)
+
+		go func() {
+			exitCh <- ctrl.shards.Run(ctx)
+		}()
+	}
+
+	// Run tasks once through once-mode. Followers and shards initialize the
+	// tasks that they do not own without applying them.
 	if !ctrl.once {
 		if err := ctrl.Once(ctx); err != nil {
 			return err
 		}
 	}
 
+	if ctrl.election != nil {
+		go ctrl.handleRoleChanges(ctx, rm)
+	}
+	if ctrl.shards != nil {
+		go ctrl.handleRebalances(ctx)
+	}
+
 	// Run long-running mode and monitor existing
 	// and created tasks
 	go func() {
@@ -176,8 +306,60 @@ func (ctrl *Daemon) Once(ctx context.Context) error {
 	return nil
 }
 
+// handleRoleChanges reacts to changes of the role of the instance in the high
+// availability cluster. The role is reflected in the tags of the registered CTS
+// service, and tasks that were skipped as a follower are applied when the
+// instance becomes the leader.
+func (ctrl *Daemon) handleRoleChanges(ctx context.Context,
+	rm *registration.ServiceRegistrationManager) {
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case leader := <-ctrl.election.roleCh:
+			role := roleFollower
+			if leader {
+				role = roleLeader
+			}
+
+			if rm != nil {
+				if err := rm.SetTags(ctx, []string{role}); err != nil {
+					ctrl.logger.Error("error updating service tags with role",
+						"role", role, "error", err)
+				}
+			}
+
+			if leader {
+				ctrl.tasksManager.applySkippedTasks(ctx)
+			}
+		}
+	}
+}
+
+// handleRebalances applies the tasks that are reassigned to the instance when
+// the members of the sharded cluster change
+func (ctrl *Daemon) handleRebalances(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ctrl.shards.rebalanceCh:
+			ctrl.tasksManager.applySkippedTasks(ctx)
+		}
+	}
+}
+
 func (ctrl *Daemon) Stop() {
 	ctrl.watcher.Stop()
+
+	if ctrl.stopTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := ctrl.stopTracing(ctx); err != nil {
+			ctrl.logger.Error("error stopping tracing", "error", err)
+		}
+	}
 }
 
 func (ctrl *Daemon) EnableTaskRanNotify() <-chan string {
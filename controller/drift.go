@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
+)
+
+// TaskDetectDrift detects drift of the infrastructure of a task, i.e. changes
+// made to the infrastructure outside of CTS, by inspecting the task with a
+// refresh-only plan. Changes of the rendered template that were not yet
+// applied, e.g. deferred by a blackout window, rejected for approval or failed
+// to apply, are not drift. A drift event is stored when drift is detected
+// or when the inspection fails.
+//
+// If the task is configured to remediate drift, the task is applied once drift
+// is detected. Remediation follows the task's runs: it is deferred during a
+// blackout window and tasks with a manual apply mode plan the remediation for
+// approval.
+func (tm *TasksManager) TaskDetectDrift(ctx context.Context, taskName string) (err error) {
+	ctx, span := tracing.Start(ctx, "task.drift", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
+	remediate, err := tm.detectDrift(ctx, taskName)
+	if err != nil || !remediate {
+		return err
+	}
+
+	if tm.deferApply(ctx, taskName) {
+		return nil
+	}
+
+	tm.logger.Info("remediating drift", taskNameLogKey, taskName)
+	return tm.applyTask(ctx, taskName)
+}
+
+// detectDrift inspects the task for drift and stores a drift event when drift
+// is detected or the inspection fails. Returns whether the detected drift is
+// to be remediated.
+func (tm *TasksManager) detectDrift(ctx context.Context, taskName string) (bool, error) {
+	logger := tm.logger.With(taskNameLogKey, taskName)
+
+	if tm.drivers.IsMarkedForDeletion(taskName) {
+		logger.Trace("task is marked for deletion, skipping")
+		return false, nil
+	}
+
+	d, ok := tm.drivers.Get(taskName)
+	if !ok {
+		return false, fmt.Errorf("task '%s' does not have a driver. task may "+
+			"have been deleted", taskName)
+	}
+
+	if err := tm.waitForTaskInactive(ctx, taskName); err != nil {
+		return false, err
+	}
+	tm.drivers.SetActive(taskName)
+	defer tm.drivers.SetInactive(taskName)
+
+	task := d.Task()
+	conf, ok := task.DriftDetection()
+	if !ok {
+		logger.Trace("drift detection is not enabled, skipping")
+		return false, nil
+	}
+
+	if !task.IsEnabled() {
+		logger.Trace("skipping drift detection of disabled task")
+		return false, nil
+	}
+
+	if len(tm.TaskPendingPlans(taskName)) > 0 {
+		// the changes pending approval would be detected as drift
+		logger.Debug("task has a plan pending approval, skipping drift detection")
+		return false, nil
+	}
+
+	if len(tm.state.GetTaskEvents(taskName)[taskName]) == 0 {
+		// the task's infrastructure is not yet managed by CTS
+		logger.Debug("task has not run yet, skipping drift detection")
+		return false, nil
+	}
+
+	if tm.cluster != nil && tm.cluster.skipApply(taskName) {
+		logger.Debug("task is executed by another instance, skipping")
+		return false, nil
+	}
+
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderIDs(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error creating event for task %s: %s",
+			taskName, err)
+	}
+	ev.Type = event.TypeDrift
+	ev.Start()
+
+	logger.Debug("detecting drift")
+	var plan driver.InspectPlan
+	desc := fmt.Sprintf("InspectDrift %s", taskName)
+	err = tm.retry.Do(ctx, func(ctx context.Context) error {
+		var err error
+		plan, err = d.InspectDrift(ctx)
+		return err
+	}, desc)
+	if err == nil && !plan.Summary.HasChanges() {
+		logger.Debug("no drift detected")
+		return false, nil
+	}
+
+	ev.Plan = plan.Summary
+	ev.End(err)
+	logger.Trace("adding event", "event", ev.GoString())
+	if err := tm.state.AddTaskEvent(*ev); err != nil {
+		logger.Error("error storing event", "event", ev.GoString())
+	}
+	tm.notify(*ev)
+	if err != nil {
+		return false, fmt.Errorf("could not detect drift for task %s: %s",
+			taskName, err)
+	}
+
+	logger.Warn("drift detected", "resources", plan.Summary.Resources)
+	return conf.Remediate, nil
+}
+
+// runDriftDetection periodically detects drift of a task at the task's drift
+// detection interval until the task is deleted or the context is canceled.
+func (cm *ConditionMonitor) runDriftDetection(ctx context.Context, taskName string, stopCh chan struct{}) error {
+	logger := cm.logger.With(taskNameLogKey, taskName)
+
+	task, err := cm.tasksManager.Task(ctx, taskName)
+	if err != nil {
+		logger.Warn("drift detection cannot be run. task may have been deleted",
+			"error", err)
+		return err
+	}
+
+	if !task.DriftDetection.IsEnabled() {
+		logger.Error("unexpected drift detection of task without drift detection enabled")
+		return fmt.Errorf("error: drift detection is not enabled for task '%s'",
+			taskName)
+	}
+
+	interval := config.TimeDurationVal(task.DriftDetection.Interval)
+	logger.Info("drift detection started", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := cm.tasksManager.TaskDetectDrift(ctx, taskName); err != nil {
+				// print error but continue
+				logger.Error("error detecting drift", "error", err)
+			}
+		case <-stopCh:
+			logger.Info("stopping drift detection")
+			return nil
+		case <-ctx.Done():
+			logger.Info("stopping drift detection")
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/driver"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func driftTestTask(tb testing.TB, name, applyMode string, remediate bool) *driver.Task {
+	task, err := driver.NewTask(driver.TaskConfig{
+		Name:      name,
+		Enabled:   true,
+		ApplyMode: applyMode,
+		Drift: &driver.DriftDetection{
+			Interval:  time.Hour,
+			Remediate: remediate,
+		},
+	})
+	require.NoError(tb, err)
+	return task
+}
+
+func Test_TasksManager_TaskDetectDrift(t *testing.T) {
+	t.Parallel()
+
+	drifted := driver.InspectPlan{
+		ChangesPresent: true,
+		Summary: &event.Plan{
+			Change:    1,
+			Resources: []string{"local_file.drifted"},
+		},
+	}
+	noDrift := driver.InspectPlan{Summary: &event.Plan{Resources: []string{}}}
+
+	cases := []struct {
+		name         string
+		applyMode    string
+		remediate    bool
+		plan         driver.InspectPlan
+		inspectErr   error
+		expectErr    bool
+		expectEvents []string
+		expectApply  bool
+		expectPlan   bool
+	}{
+		{
+			name:         "drift",
+			plan:         drifted,
+			expectEvents: []string{event.TypeDrift},
+		},
+		{
+			name: "no_drift",
+			plan: noDrift,
+		},
+		{
+			name:         "inspect_error",
+			inspectErr:   errors.New("error tf-plan"),
+			expectErr:    true,
+			expectEvents: []string{event.TypeDrift},
+		},
+		{
+			name:         "remediate",
+			remediate:    true,
+			plan:         drifted,
+			expectEvents: []string{event.TypeRun, event.TypeDrift},
+			expectApply:  true,
+		},
+		{
+			name:      "remediate_no_drift",
+			remediate: true,
+			plan:      noDrift,
+		},
+		{
+			name:         "remediate_manual_apply",
+			applyMode:    config.ApplyModeManual,
+			remediate:    true,
+			plan:         drifted,
+			expectEvents: []string{event.TypeDrift},
+			expectPlan:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := new(mocksD.Driver)
+			d.On("Task").Return(driftTestTask(t, "task_a", tc.applyMode, tc.remediate))
+			d.On("TemplateIDs").Return(nil)
+			d.On("InspectDrift", mock.Anything).Return(tc.plan, tc.inspectErr)
+			d.On("ApplyTask", mock.Anything).Return(nil)
+			d.On("PlanTask", mock.Anything).Return(true, nil)
+
+			tm := newTestTasksManager()
+			tm.retry = retry.NewTestRetry(0)
+			tm.drivers.Add("task_a", d)
+			require.NoError(t, tm.state.AddTaskEvent(event.Event{
+				ID:       "previous_run",
+				TaskName: "task_a",
+				Type:     event.TypeRun,
+				Success:  true,
+			}))
+
+			err := tm.TaskDetectDrift(context.Background(), "task_a")
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// exclude the event of the previous run
+			events := tm.state.GetTaskEvents("task_a")["task_a"]
+			require.Len(t, events, len(tc.expectEvents)+1)
+			for i, eventType := range tc.expectEvents {
+				assert.Equal(t, eventType, events[i].Type)
+				if eventType == event.TypeDrift {
+					assert.Equal(t, tc.inspectErr == nil, events[i].Success)
+					assert.Equal(t, tc.plan.Summary, events[i].Plan)
+				}
+			}
+
+			if tc.expectApply {
+				d.AssertCalled(t, "ApplyTask", mock.Anything)
+			} else {
+				d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+			}
+			if tc.expectPlan {
+				assert.Len(t, tm.TaskPendingPlans("task_a"), 1)
+			} else {
+				d.AssertNotCalled(t, "PlanTask", mock.Anything)
+			}
+		})
+	}
+
+	t.Run("skip", func(t *testing.T) {
+		disabled := driftTestTask(t, "task_a", "", false)
+		disabled.Disable()
+		notEnabled, err := driver.NewTask(driver.TaskConfig{
+			Name:    "task_a",
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		skipCases := []struct {
+			name        string
+			task        *driver.Task
+			pendingPlan bool
+			hasRun      bool
+		}{
+			{
+				"disabled_task",
+				disabled,
+				false,
+				true,
+			},
+			{
+				"drift_detection_not_enabled",
+				notEnabled,
+				false,
+				true,
+			},
+			{
+				"pending_plan",
+				driftTestTask(t, "task_a", config.ApplyModeManual, false),
+				true,
+				true,
+			},
+			{
+				"not_run_yet",
+				driftTestTask(t, "task_a", "", false),
+				false,
+				false,
+			},
+		}
+
+		for _, tc := range skipCases {
+			t.Run(tc.name, func(t *testing.T) {
+				d := new(mocksD.Driver)
+				d.On("Task").Return(tc.task)
+				d.On("TemplateIDs").Return(nil)
+
+				tm := newTestTasksManager()
+				tm.drivers.Add("task_a", d)
+				if tc.pendingPlan {
+					tm.pendingPlans.set(api.PendingPlan{ID: "plan-1", TaskName: "task_a"})
+				}
+				if tc.hasRun {
+					require.NoError(t, tm.state.AddTaskEvent(event.Event{
+						ID:       "previous_run",
+						TaskName: "task_a",
+						Success:  true,
+					}))
+				}
+
+				assert.NoError(t, tm.TaskDetectDrift(context.Background(), "task_a"))
+				d.AssertNotCalled(t, "InspectDrift", mock.Anything)
+			})
+		}
+	})
+
+	t.Run("task_not_found", func(t *testing.T) {
+		tm := newTestTasksManager()
+		assert.Error(t, tm.TaskDetectDrift(context.Background(), "task_a"))
+	})
+}
+
+func Test_ConditionMonitor_runDriftDetection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy-path", func(t *testing.T) {
+		tm := newTestTasksManager()
+		require.NoError(t, tm.state.SetTask(config.TaskConfig{
+			Name:    config.String("task_a"),
+			Enabled: config.Bool(true),
+			DriftDetection: &config.DriftDetectionConfig{
+				Enabled:  config.Bool(true),
+				Interval: config.TimeDuration(10 * time.Millisecond),
+			},
+		}))
+		require.NoError(t, tm.state.AddTaskEvent(event.Event{
+			ID:       "previous_run",
+			TaskName: "task_a",
+			Success:  true,
+		}))
+
+		inspected := make(chan struct{}, 10)
+		d := new(mocksD.Driver)
+		d.On("Task").Return(driftTestTask(t, "task_a", "", false))
+		d.On("TemplateIDs").Return(nil)
+		d.On("InspectDrift", mock.Anything).Return(driver.InspectPlan{}, nil).
+			Run(func(mock.Arguments) { inspected <- struct{}{} })
+		tm.drivers.Add("task_a", d)
+
+		cm := newTestConditionMonitor(tm)
+		stopCh := make(chan struct{}, 1)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- cm.runDriftDetection(context.Background(), "task_a", stopCh)
+		}()
+
+		select {
+		case <-inspected:
+		case <-time.After(5 * time.Second):
+			t.Fatal("drift detection did not run")
+		}
+
+		stopCh <- struct{}{}
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("drift detection did not stop")
+		}
+	})
+
+	t.Run("not_enabled", func(t *testing.T) {
+		tm := newTestTasksManager()
+		require.NoError(t, tm.state.SetTask(config.TaskConfig{
+			Name:    config.String("task_a"),
+			Enabled: config.Bool(true),
+		}))
+
+		cm := newTestConditionMonitor(tm)
+		err := cm.runDriftDetection(context.Background(), "task_a", make(chan struct{}))
+		assert.Error(t, err)
+	})
+
+	t.Run("task_not_found", func(t *testing.T) {
+		cm := newTestConditionMonitor(nil)
+		err := cm.runDriftDetection(context.Background(), "task_a", make(chan struct{}))
+		assert.Error(t, err)
+	})
+}
+
+func Test_ConditionMonitor_Run_DriftTasks_Stop(t *testing.T) {
+	// Tests deleting a task with drift detection sends a stop notification
+	t.Parallel()
+
+	tm := newTestTasksManager()
+	tm.deletedDriftCh = make(chan string, 1)
+
+	cm := newTestConditionMonitor(tm)
+	stopCh := make(chan struct{}, 1)
+	cm.driftStopChs["task_a"] = stopCh
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := new(mocks.Watcher)
+	w.On("Size").Return(5)
+	w.On("Watch", mock.Anything, mock.Anything).Return(nil)
+	cm.watcher = w
+
+	go cm.Run(ctx)
+
+	tm.deletedDriftCh <- "task_a"
+
+	select {
+	case <-time.After(1 * time.Second):
+		t.Fatal("drift detection was not notified to stop")
+	case <-stopCh:
+	}
+}
@@ -147,16 +147,23 @@ func (f *driverFactory) loadProviderConfigs(ctx context.Context) ([]driver.Terra
 
 // newDriverFunc is a constructor abstraction for all of supported drivers
 func newDriverFunc(conf *config.Config) (driverFactoryFunc, error) {
-	if conf.Driver.Terraform != nil {
+	if conf.Driver.TerraformCloud != nil {
+		return newTerraformCloudDriver, nil
+	}
+	if conf.Driver.Plugin != nil {
+		return newPluginDriver, nil
+	}
+	if conf.Driver.TerraformCLI() != nil {
 		return newTerraformDriver, nil
 	}
 	return nil, errors.New("unsupported driver")
 }
 
 // newTerraformDriver maps user configuration to initialize a Terraform driver
-// for a task
+// for a task. The driver executes the task with OpenTofu when the OpenTofu
+// driver is configured.
 func newTerraformDriver(_ context.Context, conf *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error) {
-	tfConf := *conf.Driver.Terraform
+	tfConf := *conf.Driver.TerraformCLI()
 	return driver.NewTerraform(&driver.TerraformConfig{
 		Task:              task,
 		Watcher:           w,
@@ -166,6 +173,43 @@ func newTerraformDriver(_ context.Context, conf *config.Config, task *driver.Tas
 		Backend:           tfConf.Backend,
 		RequiredProviders: tfConf.RequiredProviders,
 		ClientType:        *conf.ClientType,
+		OpenTofu:          tfConf.IsOpenTofu(),
+	})
+}
+
+// newTerraformCloudDriver maps user configuration to initialize a Terraform
+// driver for a task that executes the task as runs of a Terraform Cloud
+// workspace
+func newTerraformCloudDriver(_ context.Context, conf *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error) {
+	tfcConf := *conf.Driver.TerraformCloud
+	return driver.NewTerraform(&driver.TerraformConfig{
+		Task:              task,
+		Watcher:           w,
+		RequiredProviders: tfcConf.RequiredProviders,
+		ClientType:        *conf.ClientType,
+		TerraformCloud: &driver.TerraformCloudConfig{
+			Hostname:        *tfcConf.Hostname,
+			Organization:    *tfcConf.Organization,
+			Token:           *tfcConf.Token,
+			WorkspacePrefix: *tfcConf.WorkspacePrefix,
+			WorkspaceTags:   tfcConf.WorkspaceTags,
+		},
+	})
+}
+
+// newPluginDriver maps user configuration to initialize a Terraform driver for
+// a task that executes the task with an external plugin
+func newPluginDriver(_ context.Context, conf *config.Config, task *driver.Task, w templates.Watcher) (driver.Driver, error) {
+	pluginConf := *conf.Driver.Plugin
+	return driver.NewTerraform(&driver.TerraformConfig{
+		Task:       task,
+		Watcher:    w,
+		ClientType: *conf.ClientType,
+		Plugin: &driver.PluginConfig{
+			Path:         *pluginConf.Path,
+			Args:         pluginConf.Args,
+			StartTimeout: *pluginConf.StartTimeout,
+		},
 	})
 }
 
@@ -179,7 +223,7 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 	// Inherit configuration from the parent config before using task config
 	// This will not alter the original configuration
 	tc := taskConfig.InheritParentConfig(*conf.WorkingDir, *conf.BufferPeriod)
-	if err := tc.ValidateForDriver(); err != nil {
+	if err := tc.ValidateForDriver(conf.Driver); err != nil {
 		return nil, err
 	}
 
@@ -189,7 +233,7 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		services[si] = getService(conf.DeprecatedServices, service, meta)
 	}
 
-	tfConf := conf.Driver.Terraform
+	requiredProviders := conf.Driver.RequiredProviders()
 
 	providers := make(driver.TerraformProviderBlocks, len(tc.Providers))
 	providerInfo := make(map[string]interface{})
@@ -199,10 +243,8 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		// This is Terraform specific to pass version and source info for
 		// providers from the required_provider block
 		name, _ := splitProviderID(providerID)
-		if tfConf != nil {
-			if pInfo, ok := tfConf.RequiredProviders[name]; ok {
-				providerInfo[name] = pInfo
-			}
+		if pInfo, ok := requiredProviders[name]; ok {
+			providerInfo[name] = pInfo
 		}
 	}
 
@@ -214,10 +256,19 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		}
 	}
 
+	var drift *driver.DriftDetection // nil if disabled
+	if tc.DriftDetection.IsEnabled() {
+		drift = &driver.DriftDetection{
+			Interval:  config.TimeDurationVal(tc.DriftDetection.Interval),
+			Remediate: config.BoolVal(tc.DriftDetection.Remediate),
+		}
+	}
+
 	task, err := driver.NewTask(driver.TaskConfig{
 		Description:  *tc.Description,
 		Name:         *tc.Name,
 		Enabled:      *tc.Enabled,
+		ApplyMode:    config.StringVal(tc.ApplyMode),
 		Env:          buildTaskEnv(conf, providers.Env()),
 		Providers:    providers,
 		ProviderInfo: providerInfo,
@@ -226,11 +277,12 @@ func newDriverTask(conf *config.Config, taskConfig *config.TaskConfig,
 		Version:      *tc.Version,
 		Variables:    tc.Variables,
 		BufferPeriod: bp,
+		Drift:        drift,
 		Condition:    tc.Condition,
 		ModuleInputs: *tc.ModuleInputs,
 		WorkingDir:   *tc.WorkingDir,
 
-		// Enterprise
+		// Terraform Cloud driver
 		DeprecatedTFVersion: *tc.DeprecatedTFVersion,
 		TFCWorkspace:        *tc.TFCWorkspace,
 	})
@@ -314,7 +366,7 @@ func buildTaskEnv(conf *config.Config, customEnv map[string]string) map[string]s
 
 	// Merge the Consul environment if Consul KV is used as the Terraform backend
 	env := make(map[string]string)
-	if conf.Driver.Terraform.IsConsulBackend() {
+	if conf.Driver.TerraformCLI().IsConsulBackend() {
 		for k, v := range consulEnv {
 			env[k] = v
 		}
@@ -142,8 +142,8 @@ func TestNewDriverTask(t *testing.T) {
 					ModuleInputs: config.DefaultModuleInputConfigs(),
 					WorkingDir:   config.String("working-dir/name"),
 
-					// Enterprise
-					DeprecatedTFVersion: config.String("1.0.0"),
+					// Terraform Cloud driver
+					DeprecatedTFVersion: config.String(""),
 					TFCWorkspace:        config.DefaultTerraformCloudWorkspaceConfig(),
 				},
 			}},
@@ -161,8 +161,8 @@ func TestNewDriverTask(t *testing.T) {
 				ModuleInputs: *config.DefaultModuleInputConfigs(),
 				WorkingDir:   "working-dir/name",
 
-				// Enterprise
-				DeprecatedTFVersion: "1.0.0",
+				// Terraform Cloud driver
+				DeprecatedTFVersion: "",
 				TFCWorkspace:        *config.DefaultTerraformCloudWorkspaceConfig(),
 
 				Env: map[string]string{
@@ -172,6 +172,70 @@ func TestNewDriverTask(t *testing.T) {
 				ProviderInfo: map[string]interface{}{},
 				Services:     []driver.Service{},
 			})},
+		}, {
+			"terraform cloud driver",
+			&config.Config{
+				Driver: &config.DriverConfig{
+					TerraformCloud: &config.TerraformCloudConfig{
+						Organization: config.String("org"),
+						Token:        config.String("token"),
+						RequiredProviders: map[string]interface{}{
+							"local": map[string]interface{}{"source": "hashicorp/local"},
+						},
+					},
+				},
+				TerraformProviders: &config.TerraformProviderConfigs{
+					&config.TerraformProviderConfig{"local": map[string]interface{}{}},
+				},
+				Tasks: &config.TaskConfigs{
+					&config.TaskConfig{
+						Name:         config.String("name"),
+						Enabled:      config.Bool(true),
+						Module:       config.String("path"),
+						Providers:    []string{"local"},
+						BufferPeriod: config.DefaultBufferPeriodConfig(),
+						Condition:    config.EmptyConditionConfig(),
+						ModuleInputs: config.DefaultModuleInputConfigs(),
+						WorkingDir:   config.String("working-dir/name"),
+
+						// Terraform Cloud driver
+						DeprecatedTFVersion: config.String("1.0.0"),
+						TFCWorkspace: &config.TerraformCloudWorkspaceConfig{
+							ExecutionMode: config.String("remote"),
+						},
+					},
+				},
+			},
+			[]*driver.Task{newTestTask(t, driver.TaskConfig{
+				Name:    "name",
+				Enabled: true,
+				Module:  "path",
+				BufferPeriod: &driver.BufferPeriod{
+					Min: 5 * time.Second,
+					Max: 20 * time.Second,
+				},
+				Condition:    config.EmptyConditionConfig(),
+				ModuleInputs: *config.DefaultModuleInputConfigs(),
+				WorkingDir:   "working-dir/name",
+
+				// Terraform Cloud driver
+				DeprecatedTFVersion: "1.0.0",
+				TFCWorkspace: config.TerraformCloudWorkspaceConfig{
+					ExecutionMode:    config.String("remote"),
+					AgentPoolID:      config.String(""),
+					AgentPoolName:    config.String(""),
+					TerraformVersion: config.String(""),
+				},
+
+				Providers: driver.TerraformProviderBlocks{
+					driver.NewTerraformProviderBlock(hcltmpl.NewNamedBlockTest(
+						map[string]interface{}{"local": map[string]interface{}{}})),
+				},
+				ProviderInfo: map[string]interface{}{
+					"local": map[string]interface{}{"source": "hashicorp/local"},
+				},
+				Services: []driver.Service{},
+			})},
 		},
 	}
 
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+// defaultVirtualNodes is the number of points on the hash ring per member.
+// More points distribute keys more evenly across members.
+const defaultVirtualNodes = 128
+
+// hashRing assigns keys to members using consistent hashing. When a member
+// joins or leaves, only the keys of the neighboring points on the ring are
+// reassigned.
+type hashRing struct {
+	points []uint64
+	owners map[uint64]string
+}
+
+// newHashRing returns a hash ring with the given members, each with the given
+// number of virtual nodes on the ring
+func newHashRing(members []string, virtualNodes int) *hashRing {
+	sorted := make([]string, len(members))
+	copy(sorted, members)
+	sort.Strings(sorted)
+
+	r := &hashRing{
+		points: make([]uint64, 0, len(members)*virtualNodes),
+		owners: make(map[uint64]string, len(members)*virtualNodes),
+	}
+	for _, m := range sorted {
+		for i := 0; i < virtualNodes; i++ {
+			p := hashKey(m + "#" + strconv.Itoa(i))
+			if _, ok := r.owners[p]; ok {
+				// collision, first member in sorted order keeps the point
+				continue
+			}
+			r.owners[p] = m
+			r.points = append(r.points, p)
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// Get returns the member that owns the key. Returns an empty string if the
+// ring has no members.
+func (r *hashRing) Get(key string) string {
+	if r == nil || len(r.points) == 0 {
+		return ""
+	}
+
+	h := hashKey(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hashKey hashes a string to a point on the ring
+func hashKey(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	x := h.Sum64()
+
+	// finalize to spread similar strings across the ring
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_hashRing_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, "", newHashRing(nil, defaultVirtualNodes).Get("task"))
+
+		var r *hashRing
+		assert.Equal(t, "", r.Get("task"))
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		a := newHashRing([]string{"cts-01", "cts-02", "cts-03"}, defaultVirtualNodes)
+		b := newHashRing([]string{"cts-03", "cts-01", "cts-02"}, defaultVirtualNodes)
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("task_%d", i)
+			assert.Equal(t, a.Get(key), b.Get(key))
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		members := []string{"cts-01", "cts-02", "cts-03"}
+		r := newHashRing(members, defaultVirtualNodes)
+
+		counts := make(map[string]int)
+		for i := 0; i < 3000; i++ {
+			counts[r.Get(fmt.Sprintf("task_%d", i))]++
+		}
+		for _, m := range members {
+			// each member owns a reasonable share of the tasks
+			assert.Greater(t, counts[m], 600, m)
+		}
+	})
+
+	t.Run("minimal rebalancing", func(t *testing.T) {
+		before := newHashRing([]string{"cts-01", "cts-02", "cts-03"}, defaultVirtualNodes)
+		after := newHashRing([]string{"cts-01", "cts-02", "cts-03", "cts-04"}, defaultVirtualNodes)
+
+		for i := 0; i < 1000; i++ {
+			key := fmt.Sprintf("task_%d", i)
+			if owner := after.Get(key); owner != "cts-04" {
+				// keys only move to the new member
+				assert.Equal(t, before.Get(key), owner, key)
+			}
+		}
+	})
+}
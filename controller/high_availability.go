@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/client"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+const (
+	roleLeader   = "leader"
+	roleFollower = "follower"
+
+	// leaderKVPath is the path relative to the configured Consul KV path of
+	// the leader lock for a cluster
+	leaderKVPath = "cluster/%s/leader"
+
+	haSystemName = "ha"
+
+	defaultElectionRetryInterval = 5 * time.Second
+)
+
+var _ clusterGate = (*leaderElection)(nil)
+
+// leaderElection elects a leader amongst the CTS instances of a cluster using
+// a lock in Consul KV. Only the leader applies tasks. Followers keep their tasks
+// initialized and templates watched so that they can take over immediately
+// when the leader is lost.
+type leaderElection struct {
+	logger logging.Logger
+	client client.ConsulClientInterface
+
+	clusterName   string
+	instanceID    string
+	key           string
+	namespace     string
+	sessionTTL    time.Duration
+	retryInterval time.Duration
+
+	mu     sync.RWMutex
+	leader bool
+
+	// skipped are the names of the tasks that were not applied because the
+	// instance was a follower at the time
+	skipped map[string]bool
+
+	// roleCh receives the role of the instance, true if it is the leader,
+	// whenever the role changes. Only the latest role change is buffered.
+	roleCh chan bool
+}
+
+// newLeaderElection returns a leader election for the cluster configured in
+// the high availability configuration
+func newLeaderElection(conf *config.Config, c client.ConsulClientInterface) *leaderElection {
+	ha := conf.HighAvailability
+	clusterName := config.StringVal(ha.ClusterName)
+
+	kvPath := config.StringVal(conf.Consul.KVPath)
+	if kvPath == "" {
+		kvPath = config.DefaultConsulKVPath
+	}
+	if kvPath[len(kvPath)-1] != '/' {
+		kvPath += "/"
+	}
+
+	return &leaderElection{
+		logger:        logging.Global().Named(haSystemName).With("cluster_name", clusterName),
+		client:        c,
+		clusterName:   clusterName,
+		instanceID:    config.StringVal(conf.ID),
+		key:           kvPath + fmt.Sprintf(leaderKVPath, clusterName),
+		namespace:     config.StringVal(conf.Consul.KVNamespace),
+		sessionTTL:    config.TimeDurationVal(ha.SessionTTL),
+		retryInterval: defaultElectionRetryInterval,
+		skipped:       make(map[string]bool),
+		roleCh:        make(chan bool, 1),
+	}
+}
+
+// Run participates in the leader election until the context is canceled. When
+// leadership is lost, for example when the session expires because Consul is
+// unreachable, the instance becomes a follower and campaigns again.
+func (e *leaderElection) Run(ctx context.Context) error {
+	e.logger.Info("starting leader election", "instance_id", e.instanceID)
+
+	for {
+		err := e.campaign(ctx)
+		e.setLeader(false)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			e.logger.Error("leader election interrupted, retrying", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
+
+// campaign creates a session and waits to acquire the leader lock with it. Once
+// acquired, the instance is the leader until the lock or the session is lost.
+func (e *leaderElection) campaign(ctx context.Context) error {
+	wo := &consulapi.WriteOptions{Namespace: e.namespace}
+	sessionID, _, err := e.client.SessionCreate(ctx, &consulapi.SessionEntry{
+		Name:     fmt.Sprintf("cts-%s-%s", e.clusterName, e.instanceID),
+		TTL:      e.sessionTTL.String(),
+		Behavior: consulapi.SessionBehaviorDelete,
+	}, wo)
+	if err != nil {
+		return fmt.Errorf("error creating session: %s", err)
+	}
+	logger := e.logger.With("session_id", sessionID)
+
+	// closing doneCh stops renewing the session and destroys it, which
+	// releases the lock if it is still held
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	renewErrCh := make(chan error, 1)
+	go func() {
+		renewErrCh <- e.client.SessionRenewPeriodic(e.sessionTTL.String(),
+			sessionID, wo, doneCh)
+	}()
+
+	lock, err := e.client.LockOpts(&consulapi.LockOptions{
+		Key:       e.key,
+		Value:     []byte(e.instanceID),
+		Session:   sessionID,
+		Namespace: e.namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating lock: %s", err)
+	}
+
+	type lockResult struct {
+		lostCh <-chan struct{}
+		err    error
+	}
+	stopCh := make(chan struct{})
+	resultCh := make(chan lockResult, 1)
+	go func() {
+		lostCh, err := e.client.Lock(lock, stopCh)
+		resultCh <- lockResult{lostCh: lostCh, err: err}
+	}()
+
+	logger.Debug("waiting to acquire leader lock", "key", e.key)
+	var lostCh <-chan struct{}
+	select {
+	case <-ctx.Done():
+		close(stopCh)
+		return ctx.Err()
+	case err := <-renewErrCh:
+		close(stopCh)
+		return fmt.Errorf("session lost: %v", err)
+	case r := <-resultCh:
+		if r.err != nil {
+			return fmt.Errorf("error acquiring lock: %s", r.err)
+		}
+		if r.lostCh == nil {
+			return errors.New("stopped acquiring lock")
+		}
+		lostCh = r.lostCh
+	}
+
+	defer func() {
+		if err := e.client.Unlock(lock); err != nil {
+			logger.Debug("error releasing leader lock", "error", err)
+		}
+	}()
+	e.setLeader(true)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-lostCh:
+		return errors.New("leader lock lost")
+	case err := <-renewErrCh:
+		return fmt.Errorf("session lost: %v", err)
+	}
+}
+
+// setLeader sets the role of the instance and notifies of role changes
+func (e *leaderElection) setLeader(leader bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.leader == leader {
+		return
+	}
+	e.leader = leader
+	if leader {
+		e.logger.Info("acquired leadership, instance is now the leader")
+	} else {
+		e.logger.Info("instance is now a follower")
+	}
+
+	// replace any role change that has not been consumed yet
+	select {
+	case <-e.roleCh:
+	default:
+	}
+	e.roleCh <- leader
+}
+
+// IsLeader returns true if the instance is the leader of the cluster
+func (e *leaderElection) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// Role returns the role of the instance in the cluster
+func (e *leaderElection) Role() string {
+	if e.IsLeader() {
+		return roleLeader
+	}
+	return roleFollower
+}
+
+// ClusterName returns the name of the cluster
+func (e *leaderElection) ClusterName() string {
+	return e.clusterName
+}
+
+// InstanceID returns the ID of the instance
+func (e *leaderElection) InstanceID() string {
+	return e.instanceID
+}
+
+// skipApply returns true if the instance is a follower and must not apply the
+// task. The task is tracked so that it is applied if the instance becomes the
+// leader.
+func (e *leaderElection) skipApply(taskName string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.leader {
+		return false
+	}
+	e.skipped[taskName] = true
+	return true
+}
+
+// takeSkipped returns and clears the names of the tasks that were not applied
+// while the instance was a follower. Returns nil if the instance is not the
+// leader.
+func (e *leaderElection) takeSkipped() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.leader {
+		return nil
+	}
+	names := make([]string, 0, len(e.skipped))
+	for name := range e.skipped {
+		names = append(names, name)
+	}
+	e.skipped = make(map[string]bool)
+	return names
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocksC "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newLeaderElection(t *testing.T) {
+	t.Parallel()
+
+	conf := config.DefaultConfig()
+	conf.ID = config.String("cts-01")
+	conf.Consul.KVPath = config.String("cts")
+	conf.Consul.KVNamespace = config.String("ns")
+	conf.HighAvailability = &config.HighAvailabilityConfig{
+		ClusterName: config.String("cts-cluster"),
+	}
+	conf.HighAvailability.Finalize()
+
+	e := newLeaderElection(conf, new(mocksC.ConsulClientInterface))
+	assert.Equal(t, "cts/cluster/cts-cluster/leader", e.key)
+	assert.Equal(t, "cts-cluster", e.ClusterName())
+	assert.Equal(t, "cts-01", e.InstanceID())
+	assert.Equal(t, "ns", e.namespace)
+	assert.Equal(t, config.DefaultSessionTTL, e.sessionTTL)
+	assert.Equal(t, roleFollower, e.Role())
+}
+
+func Test_leaderElection_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("acquire and lose leadership", func(t *testing.T) {
+		e, c := newTestLeaderElection()
+
+		lostCh := make(chan struct{})
+		expectSession(c, nil)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Return((<-chan struct{})(lostCh), nil).Once()
+		c.On("Unlock", mock.Anything).Return(nil)
+		// block re-campaigning after leadership is lost
+		c.On("Lock", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-args.Get(1).(<-chan struct{})
+			}).Return(nil, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error)
+		go func() { errCh <- e.Run(ctx) }()
+
+		assert.True(t, receiveRole(t, e), "expected to become leader")
+		assert.True(t, e.IsLeader())
+		assert.Equal(t, roleLeader, e.Role())
+
+		close(lostCh)
+		assert.False(t, receiveRole(t, e), "expected to become follower")
+		assert.False(t, e.IsLeader())
+
+		cancel()
+		assert.Equal(t, context.Canceled, <-errCh)
+		c.AssertCalled(t, "Unlock", mock.Anything)
+	})
+
+	t.Run("session lost", func(t *testing.T) {
+		e, c := newTestLeaderElection()
+
+		renewErr := make(chan error)
+		expectSession(c, renewErr)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Return(make(<-chan struct{}), nil).Once()
+		c.On("Unlock", mock.Anything).Return(nil)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-args.Get(1).(<-chan struct{})
+			}).Return(nil, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go e.Run(ctx)
+
+		assert.True(t, receiveRole(t, e), "expected to become leader")
+		renewErr <- errors.New("session expired")
+		assert.False(t, receiveRole(t, e), "expected to become follower")
+	})
+
+	t.Run("retry on error", func(t *testing.T) {
+		e, c := newTestLeaderElection()
+
+		c.On("SessionCreate", mock.Anything, mock.Anything, mock.Anything).
+			Return("", nil, errors.New("mock error")).Once()
+		expectSession(c, nil)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Return(make(<-chan struct{}), nil)
+		c.On("Unlock", mock.Anything).Return(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go e.Run(ctx)
+
+		assert.True(t, receiveRole(t, e), "expected to become leader")
+		c.AssertNumberOfCalls(t, "SessionCreate", 2)
+	})
+}
+
+func Test_leaderElection_skipApply(t *testing.T) {
+	t.Parallel()
+
+	e, _ := newTestLeaderElection()
+	assert.True(t, e.skipApply("task_a"))
+	assert.True(t, e.skipApply("task_b"))
+	assert.True(t, e.skipApply("task_a"))
+
+	// followers cannot take skipped tasks
+	assert.Nil(t, e.takeSkipped())
+
+	e.setLeader(true)
+	assert.False(t, e.skipApply("task_c"))
+
+	assert.ElementsMatch(t, []string{"task_a", "task_b"}, e.takeSkipped())
+	assert.Empty(t, e.takeSkipped())
+}
+
+func Test_TasksManager_HighAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_a"))
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil)
+	d.On("ApplyTask", mock.Anything).Return(nil)
+
+	tm := newTestTasksManager()
+	tm.drivers.Add("task_a", d)
+	e, _ := newTestLeaderElection()
+	tm.cluster = e
+
+	t.Run("follower skips apply", func(t *testing.T) {
+		require.NoError(t, tm.TaskRunNow(ctx, "task_a"))
+		d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+		assert.Empty(t, tm.state.GetTaskEvents("task_a"))
+	})
+
+	t.Run("new leader applies skipped tasks", func(t *testing.T) {
+		e.setLeader(true)
+		tm.applySkippedTasks(ctx)
+		d.AssertNumberOfCalls(t, "ApplyTask", 1)
+
+		events := tm.state.GetTaskEvents("task_a")["task_a"]
+		require.Len(t, events, 1)
+		assert.True(t, events[0].Success)
+	})
+
+	t.Run("leader applies", func(t *testing.T) {
+		require.NoError(t, tm.TaskRunNow(ctx, "task_a"))
+		d.AssertNumberOfCalls(t, "ApplyTask", 2)
+	})
+}
+
+func Test_TasksManager_HighAvailability_notRendered(t *testing.T) {
+	t.Parallel()
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_a"))
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(false, nil)
+
+	tm := newTestTasksManager()
+	tm.drivers.Add("task_a", d)
+	e, _ := newTestLeaderElection()
+	tm.cluster = e
+
+	// A task that is not yet rendered is not skipped for the leader to apply
+	require.NoError(t, tm.TaskRunNow(context.Background(), "task_a"))
+	d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+	e.setLeader(true)
+	assert.Empty(t, e.takeSkipped())
+}
+
+func Test_TasksManager_runNewTask_follower(t *testing.T) {
+	t.Parallel()
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_a"))
+
+	tm := newTestTasksManager()
+	e, _ := newTestLeaderElection()
+	tm.cluster = e
+
+	ev, err := tm.runNewTask(context.Background(), d, false)
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+	d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+
+	e.setLeader(true)
+	assert.ElementsMatch(t, []string{"task_a"}, e.takeSkipped())
+}
+
+func newTestLeaderElection() (*leaderElection, *mocksC.ConsulClientInterface) {
+	c := new(mocksC.ConsulClientInterface)
+	return &leaderElection{
+		logger:        logging.NewNullLogger(),
+		client:        c,
+		clusterName:   "cts-cluster",
+		instanceID:    "cts-01",
+		key:           "cts/cluster/cts-cluster/leader",
+		sessionTTL:    15 * time.Second,
+		retryInterval: 10 * time.Millisecond,
+		skipped:       make(map[string]bool),
+		roleCh:        make(chan bool, 1),
+	}, c
+}
+
+// expectSession sets up the mock Consul client to create a session and renew
+// it until the session is destroyed or an error is sent on renewErr
+func expectSession(c *mocksC.ConsulClientInterface, renewErr chan error) {
+	c.On("SessionCreate", mock.Anything, mock.Anything, mock.Anything).
+		Return("session-id", &consulapi.WriteMeta{}, nil)
+	c.On("SessionRenewPeriodic", mock.Anything, "session-id", mock.Anything, mock.Anything).
+		Return(func(_ string, _ string, _ *consulapi.WriteOptions, doneCh <-chan struct{}) error {
+			select {
+			case <-doneCh:
+				return nil
+			case err := <-renewErr:
+				return err
+			}
+		})
+	c.On("LockOpts", mock.Anything).Return(&consulapi.Lock{}, nil)
+}
+
+func receiveRole(t *testing.T, e *leaderElection) bool {
+	select {
+	case leader := <-e.roleCh:
+		return leader
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for role change")
+		return false
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/api"
+)
+
+var _ api.ScheduleReporter = (*TasksManager)(nil)
+
+// nextRuns tracks the next scheduled run time of the scheduled tasks
+type nextRuns struct {
+	mu sync.RWMutex
+
+	// times is the next scheduled run time by task name
+	times map[string]time.Time
+}
+
+func newNextRuns() *nextRuns {
+	return &nextRuns{
+		times: make(map[string]time.Time),
+	}
+}
+
+// set sets the next scheduled run time of the task
+func (n *nextRuns) set(taskName string, next time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.times[taskName] = next
+}
+
+// get returns the next scheduled run time of the task
+func (n *nextRuns) get(taskName string) (time.Time, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	next, ok := n.times[taskName]
+	return next, ok
+}
+
+// delete clears the next scheduled run time of the task
+func (n *nextRuns) delete(taskName string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.times, taskName)
+}
+
+// TaskNextRun returns the next time that the scheduled task runs. Returns false
+// if the task is not scheduled or its schedule has not started.
+func (tm *TasksManager) TaskNextRun(taskName string) (time.Time, bool) {
+	if tm.nextRuns == nil {
+		return time.Time{}, false
+	}
+	return tm.nextRuns.get(taskName)
+}
+
+// setNextRun records the next time that the scheduled task runs
+func (tm *TasksManager) setNextRun(taskName string, next time.Time) {
+	if tm.nextRuns == nil {
+		return
+	}
+	tm.nextRuns.set(taskName, next)
+}
+
+// clearNextRun clears the next run time of a scheduled task that stopped
+func (tm *TasksManager) clearNextRun(taskName string) {
+	if tm.nextRuns == nil {
+		return
+	}
+	tm.nextRuns.delete(taskName)
+}
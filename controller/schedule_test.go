@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_nextRuns(t *testing.T) {
+	t.Parallel()
+
+	n := newNextRuns()
+	next := time.Now().Add(time.Minute)
+
+	_, ok := n.get("task_a")
+	assert.False(t, ok)
+
+	n.set("task_a", next)
+	actual, ok := n.get("task_a")
+	assert.True(t, ok)
+	assert.Equal(t, next, actual)
+
+	n.delete("task_a")
+	_, ok = n.get("task_a")
+	assert.False(t, ok)
+}
+
+func Test_TasksManager_TaskNextRun(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scheduled", func(t *testing.T) {
+		tm := newTestTasksManager()
+		next := time.Now().Add(time.Minute)
+
+		tm.setNextRun("task_a", next)
+		actual, ok := tm.TaskNextRun("task_a")
+		assert.True(t, ok)
+		assert.Equal(t, next, actual)
+
+		tm.clearNextRun("task_a")
+		_, ok = tm.TaskNextRun("task_a")
+		assert.False(t, ok)
+	})
+
+	t.Run("uninitialized", func(t *testing.T) {
+		tm := &TasksManager{}
+		tm.setNextRun("task_a", time.Now())
+		_, ok := tm.TaskNextRun("task_a")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/client"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+const (
+	// membersKVPath is the path relative to the configured Consul KV path
+	// where the members of a sharded cluster register themselves
+	membersKVPath = "cluster/members/"
+
+	shardingSystemName = "sharding"
+)
+
+var _ clusterGate = (*shardManager)(nil)
+
+// shardManager partitions tasks amongst the CTS instances that share the same
+// Consul KV path. Each instance registers itself as a member by holding a lock
+// on a key under the members path, and watches the members path for other
+// instances. Tasks are assigned to members by consistent hashing on the task
+// name.
+type shardManager struct {
+	logger logging.Logger
+	client client.ConsulClientInterface
+
+	instanceID    string
+	prefix        string
+	namespace     string
+	sessionTTL    time.Duration
+	retryInterval time.Duration
+
+	mu      sync.RWMutex
+	members []string
+	ring    *hashRing
+
+	// skipped are the names of the tasks that were not applied because they
+	// were owned by another member at the time
+	skipped map[string]bool
+
+	// rebalanceCh receives a notification whenever the members change and
+	// tasks are reassigned. Only one notification is buffered.
+	rebalanceCh chan struct{}
+}
+
+// newShardManager returns a shard manager for the CTS instances sharing the
+// configured Consul KV path
+func newShardManager(conf *config.Config, c client.ConsulClientInterface) *shardManager {
+	kvPath := config.StringVal(conf.Consul.KVPath)
+	if kvPath == "" {
+		kvPath = config.DefaultConsulKVPath
+	}
+	if kvPath[len(kvPath)-1] != '/' {
+		kvPath += "/"
+	}
+
+	return &shardManager{
+		logger:        logging.Global().Named(shardingSystemName),
+		client:        c,
+		instanceID:    config.StringVal(conf.ID),
+		prefix:        kvPath + membersKVPath,
+		namespace:     config.StringVal(conf.Consul.KVNamespace),
+		sessionTTL:    config.TimeDurationVal(conf.Sharding.SessionTTL),
+		retryInterval: defaultElectionRetryInterval,
+		skipped:       make(map[string]bool),
+		rebalanceCh:   make(chan struct{}, 1),
+	}
+}
+
+// Run registers the instance as a member and watches for membership changes
+// until the context is canceled. When the membership is lost, for example
+// when the session expires because Consul is unreachable, the instance owns no
+// tasks until it registers again.
+func (s *shardManager) Run(ctx context.Context) error {
+	s.logger.Info("joining sharded cluster", "instance_id", s.instanceID)
+
+	for {
+		err := s.join(ctx)
+		s.setMembers(nil)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			s.logger.Error("cluster membership interrupted, retrying", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.retryInterval):
+		}
+	}
+}
+
+// join creates a session and registers the instance as a member with it. It
+// then watches the members until the membership or the session is lost.
+func (s *shardManager) join(ctx context.Context) error {
+	wo := &consulapi.WriteOptions{Namespace: s.namespace}
+	sessionID, _, err := s.client.SessionCreate(ctx, &consulapi.SessionEntry{
+		Name:     fmt.Sprintf("cts-member-%s", s.instanceID),
+		TTL:      s.sessionTTL.String(),
+		Behavior: consulapi.SessionBehaviorDelete,
+	}, wo)
+	if err != nil {
+		return fmt.Errorf("error creating session: %s", err)
+	}
+
+	// closing doneCh stops renewing the session and destroys it, which
+	// removes the member key
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	renewErrCh := make(chan error, 1)
+	go func() {
+		renewErrCh <- s.client.SessionRenewPeriodic(s.sessionTTL.String(),
+			sessionID, wo, doneCh)
+	}()
+
+	lock, err := s.client.LockOpts(&consulapi.LockOptions{
+		Key:       s.prefix + s.instanceID,
+		Value:     []byte(s.instanceID),
+		Session:   sessionID,
+		Namespace: s.namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating member lock: %s", err)
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	lostCh, err := s.client.Lock(lock, stopCh)
+	if err != nil {
+		return fmt.Errorf("error registering member: %s", err)
+	}
+	if lostCh == nil {
+		return errors.New("stopped registering member")
+	}
+	defer func() {
+		if err := s.client.Unlock(lock); err != nil {
+			s.logger.Debug("error releasing member lock", "error", err)
+		}
+	}()
+
+	// stop watching members when the membership is lost
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	lostErrCh := make(chan error, 1)
+	go func() {
+		select {
+		case <-watchCtx.Done():
+			return
+		case <-lostCh:
+			lostErrCh <- errors.New("member lock lost")
+		case err := <-renewErrCh:
+			lostErrCh <- fmt.Errorf("session lost: %v", err)
+		}
+		cancel()
+	}()
+
+	err = s.watchMembers(watchCtx)
+	select {
+	case lostErr := <-lostErrCh:
+		return lostErr
+	default:
+		return err
+	}
+}
+
+// watchMembers watches the members path with blocking queries and updates
+// the members as they change
+func (s *shardManager) watchMembers(ctx context.Context) error {
+	var waitIndex uint64
+	for {
+		q := &consulapi.QueryOptions{
+			Namespace: s.namespace,
+			WaitIndex: waitIndex,
+		}
+		pairs, meta, err := s.client.KVList(ctx, s.prefix, q.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("error listing members: %s", err)
+		}
+
+		members := make([]string, 0, len(pairs))
+		for _, p := range pairs {
+			// only keys held by a session are live members
+			if p.Session != "" {
+				members = append(members, strings.TrimPrefix(p.Key, s.prefix))
+			}
+		}
+		s.setMembers(members)
+
+		if meta == nil || meta.LastIndex < waitIndex {
+			// reset the index if it went backwards, as recommended for
+			// blocking queries
+			waitIndex = 0
+		} else {
+			waitIndex = meta.LastIndex
+		}
+	}
+}
+
+// setMembers updates the members and reassigns the tasks if they changed
+func (s *shardManager) setMembers(members []string) {
+	sorted := make([]string, len(members))
+	copy(sorted, members)
+	sort.Strings(sorted)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if equalStrings(s.members, sorted) {
+		return
+	}
+	s.members = sorted
+	if len(sorted) == 0 {
+		s.ring = nil
+	} else {
+		s.ring = newHashRing(sorted, defaultVirtualNodes)
+	}
+	s.logger.Info("cluster members changed, rebalancing tasks",
+		"members", sorted)
+
+	select {
+	case s.rebalanceCh <- struct{}{}:
+	default:
+	}
+}
+
+// InstanceID returns the ID of the instance
+func (s *shardManager) InstanceID() string {
+	return s.instanceID
+}
+
+// Members returns the IDs of the members of the cluster
+func (s *shardManager) Members() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := make([]string, len(s.members))
+	copy(members, s.members)
+	return members
+}
+
+// TaskOwner returns the ID of the member that owns the task. Returns an empty
+// string if there are no members.
+func (s *shardManager) TaskOwner(taskName string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ring.Get(taskName)
+}
+
+// skipApply returns true if the task is owned by another member. The task is
+// tracked so that it is applied if it is reassigned to this instance.
+func (s *shardManager) skipApply(taskName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ring.Get(taskName) == s.instanceID {
+		return false
+	}
+	s.skipped[taskName] = true
+	return true
+}
+
+// takeSkipped returns and clears the names of the skipped tasks that are now
+// owned by this instance
+func (s *shardManager) takeSkipped() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var names []string
+	for name := range s.skipped {
+		if s.ring.Get(name) == s.instanceID {
+			names = append(names, name)
+			delete(s.skipped, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
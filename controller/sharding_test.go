@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocksC "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newShardManager(t *testing.T) {
+	t.Parallel()
+
+	conf := config.DefaultConfig()
+	conf.ID = config.String("cts-01")
+	conf.Consul.KVPath = config.String("cts")
+	conf.Consul.KVNamespace = config.String("ns")
+	conf.Sharding = &config.ShardingConfig{Enabled: config.Bool(true)}
+	conf.Sharding.Finalize()
+
+	s := newShardManager(conf, new(mocksC.ConsulClientInterface))
+	assert.Equal(t, "cts/cluster/members/", s.prefix)
+	assert.Equal(t, "cts-01", s.InstanceID())
+	assert.Equal(t, "ns", s.namespace)
+	assert.Equal(t, config.DefaultSessionTTL, s.sessionTTL)
+	assert.Empty(t, s.Members())
+	assert.Empty(t, s.TaskOwner("task_a"))
+}
+
+func Test_shardManager_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("watch members", func(t *testing.T) {
+		s, c := newTestShardManager()
+
+		lostCh := make(chan struct{})
+		expectSession(c, nil)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Return((<-chan struct{})(lostCh), nil).Once()
+		c.On("Unlock", mock.Anything).Return(nil)
+		// block re-joining after the membership is lost
+		c.On("Lock", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-args.Get(1).(<-chan struct{})
+			}).Return(nil, nil)
+
+		membersCh := make(chan consulapi.KVPairs)
+		c.On("KVList", mock.Anything, "cts/cluster/members/", mock.Anything).
+			Return(func(ctx context.Context, _ string, _ *consulapi.QueryOptions) consulapi.KVPairs {
+				select {
+				case <-ctx.Done():
+					return nil
+				case pairs := <-membersCh:
+					return pairs
+				}
+			}, func(ctx context.Context, _ string, _ *consulapi.QueryOptions) *consulapi.QueryMeta {
+				return &consulapi.QueryMeta{LastIndex: 1}
+			}, func(ctx context.Context, _ string, _ *consulapi.QueryOptions) error {
+				return ctx.Err()
+			})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error)
+		go func() { errCh <- s.Run(ctx) }()
+
+		membersCh <- consulapi.KVPairs{
+			{Key: "cts/cluster/members/cts-01", Session: "session-id"},
+			{Key: "cts/cluster/members/cts-02", Session: "other-session"},
+			// keys without a session are not live members
+			{Key: "cts/cluster/members/cts-03"},
+		}
+		receiveRebalance(t, s)
+		assert.Equal(t, []string{"cts-01", "cts-02"}, s.Members())
+
+		close(lostCh)
+		receiveRebalance(t, s)
+		assert.Empty(t, s.Members())
+
+		cancel()
+		assert.Equal(t, context.Canceled, <-errCh)
+		c.AssertCalled(t, "Unlock", mock.Anything)
+	})
+
+	t.Run("retry on error", func(t *testing.T) {
+		s, c := newTestShardManager()
+
+		c.On("SessionCreate", mock.Anything, mock.Anything, mock.Anything).
+			Return("", nil, errors.New("mock error")).Once()
+		expectSession(c, nil)
+		c.On("Lock", mock.Anything, mock.Anything).
+			Return(make(<-chan struct{}), nil)
+		c.On("Unlock", mock.Anything).Return(nil)
+		c.On("KVList", mock.Anything, mock.Anything, mock.Anything).
+			Return(consulapi.KVPairs{
+				{Key: "cts/cluster/members/cts-01", Session: "session-id"},
+			}, &consulapi.QueryMeta{LastIndex: 1}, nil).Once()
+		c.On("KVList", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-args.Get(0).(context.Context).Done()
+			}).Return(nil, nil, context.Canceled)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go s.Run(ctx)
+
+		receiveRebalance(t, s)
+		assert.Equal(t, []string{"cts-01"}, s.Members())
+		c.AssertNumberOfCalls(t, "SessionCreate", 2)
+	})
+}
+
+func Test_shardManager_skipApply(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestShardManager()
+
+	// no tasks are owned before joining
+	assert.True(t, s.skipApply("task_a"))
+	assert.True(t, s.skipApply("task_b"))
+	assert.Empty(t, s.takeSkipped())
+
+	s.setMembers([]string{"cts-01"})
+	assert.False(t, s.skipApply("task_c"))
+	assert.Equal(t, []string{"task_a", "task_b"}, s.takeSkipped())
+	assert.Empty(t, s.takeSkipped())
+
+	// tasks owned by the other member are skipped and taken when the other
+	// member leaves
+	s.setMembers([]string{"cts-01", "cts-02"})
+	var owned, other []string
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		if s.skipApply(name) {
+			other = append(other, name)
+			assert.Equal(t, "cts-02", s.TaskOwner(name))
+		} else {
+			owned = append(owned, name)
+			assert.Equal(t, "cts-01", s.TaskOwner(name))
+		}
+	}
+	require.NotEmpty(t, owned)
+	require.NotEmpty(t, other)
+	assert.Empty(t, s.takeSkipped())
+
+	s.setMembers([]string{"cts-01"})
+	assert.Equal(t, other, s.takeSkipped())
+}
+
+func Test_shardManager_setMembers(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestShardManager()
+	s.setMembers([]string{"cts-02", "cts-01"})
+	receiveRebalance(t, s)
+	assert.Equal(t, []string{"cts-01", "cts-02"}, s.Members())
+
+	// no rebalance when the members are unchanged
+	s.setMembers([]string{"cts-01", "cts-02"})
+	select {
+	case <-s.rebalanceCh:
+		t.Fatal("unexpected rebalance")
+	default:
+	}
+}
+
+func Test_TasksManager_Sharding(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_a"))
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil)
+	d.On("ApplyTask", mock.Anything).Return(nil)
+
+	tm := newTestTasksManager()
+	tm.drivers.Add("task_a", d)
+	s, _ := newTestShardManager()
+	tm.cluster = s
+
+	t.Run("unassigned task skips apply", func(t *testing.T) {
+		require.NoError(t, tm.TaskRunNow(ctx, "task_a"))
+		d.AssertNotCalled(t, "ApplyTask", mock.Anything)
+	})
+
+	t.Run("reassigned task is applied", func(t *testing.T) {
+		s.setMembers([]string{"cts-01"})
+		tm.applySkippedTasks(ctx)
+		d.AssertNumberOfCalls(t, "ApplyTask", 1)
+	})
+
+	t.Run("owned task applies", func(t *testing.T) {
+		require.NoError(t, tm.TaskRunNow(ctx, "task_a"))
+		d.AssertNumberOfCalls(t, "ApplyTask", 2)
+	})
+}
+
+func newTestShardManager() (*shardManager, *mocksC.ConsulClientInterface) {
+	c := new(mocksC.ConsulClientInterface)
+	return &shardManager{
+		logger:        logging.NewNullLogger(),
+		client:        c,
+		instanceID:    "cts-01",
+		prefix:        "cts/cluster/members/",
+		sessionTTL:    15 * time.Second,
+		retryInterval: 10 * time.Millisecond,
+		skipped:       make(map[string]bool),
+		rebalanceCh:   make(chan struct{}, 1),
+	}, c
+}
+
+func receiveRebalance(t *testing.T, s *shardManager) {
+	select {
+	case <-s.rebalanceCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for rebalance")
+	}
+}
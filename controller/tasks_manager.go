@@ -11,10 +11,13 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/driver"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
+	"github.com/hashicorp/consul-terraform-sync/notification"
 	"github.com/hashicorp/consul-terraform-sync/retry"
 	"github.com/hashicorp/consul-terraform-sync/state"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/pkg/errors"
 )
 
@@ -30,6 +33,9 @@ type TasksManager struct {
 
 	retry retry.Retry
 
+	// notifier notifies webhooks when task runs finish
+	notifier *notification.Notifier
+
 	// createdScheduleCh sends the task name of newly created scheduled tasks
 	// that will need to be monitored
 	createdScheduleCh chan string
@@ -38,6 +44,14 @@ type TasksManager struct {
 	// should stop being monitored
 	deletedScheduleCh chan string
 
+	// createdDriftCh sends the task name of newly created tasks with drift
+	// detection enabled that will need to be inspected for drift
+	createdDriftCh chan string
+
+	// deletedDriftCh sends the task name of deleted tasks with drift detection
+	// enabled that should stop being inspected for drift
+	deletedDriftCh chan string
+
 	// ranTaskNotify is only initialized if EnableTaskRanNotify() is used. It
 	// provides tests insight into which tasks were triggered and had completed
 	ranTaskNotify chan string
@@ -45,6 +59,35 @@ type TasksManager struct {
 	// deletedTaskNotify is only initialized if EnableTaskDeletedNotify() is used.
 	// It provides tests insight into when a task has been deleted
 	deletedTaskNotify chan string
+
+	// cluster is only initialized when the instance is part of a cluster of
+	// CTS instances, i.e. high availability or sharding is configured. It
+	// determines which tasks the instance applies.
+	cluster clusterGate
+
+	// blackouts tracks the tasks with changes that are deferred until the end
+	// of a blackout window
+	blackouts *blackouts
+
+	// pendingPlans tracks the plans of tasks with a manual apply mode that
+	// are pending approval
+	pendingPlans *pendingPlans
+
+	// nextRuns tracks the next scheduled run time of the scheduled tasks
+	nextRuns *nextRuns
+}
+
+// clusterGate determines which tasks a CTS instance applies when it is part of
+// a cluster of CTS instances
+type clusterGate interface {
+	// skipApply returns true if the task is applied by another instance. The
+	// task is tracked so that it can be applied if this instance becomes
+	// responsible for it.
+	skipApply(taskName string) bool
+
+	// takeSkipped returns and clears the names of the skipped tasks that this
+	// instance is now responsible for
+	takeSkipped() []string
 }
 
 // NewTasksManager configures a new tasks manager
@@ -62,8 +105,14 @@ func NewTasksManager(conf *config.Config, state state.Store, watcher templates.W
 		state:             state,
 		drivers:           driver.NewDrivers(),
 		retry:             retry.NewRetry(defaultRetry, time.Now().UnixNano()),
+		notifier:          notification.NewNotifier(),
 		createdScheduleCh: make(chan string, 100), // arbitrarily chosen size
 		deletedScheduleCh: make(chan string, 100), // arbitrarily chosen size
+		createdDriftCh:    make(chan string, 100), // arbitrarily chosen size
+		deletedDriftCh:    make(chan string, 100), // arbitrarily chosen size
+		blackouts:         newBlackouts(),
+		pendingPlans:      newPendingPlans(),
+		nextRuns:          newNextRuns(),
 	}, nil
 }
 
@@ -140,6 +189,7 @@ func (tm *TasksManager) TaskCreateAndRun(ctx context.Context, taskConfig config.
 			// only log error since creating a task occurred successfully by now
 			logger.Error("error storing event", "event", ev.GoString(), "error", err)
 		}
+		tm.notify(*ev)
 	}
 
 	return addedConf, err
@@ -201,6 +251,10 @@ func (tm *TasksManager) TaskUpdate(ctx context.Context, updateConf config.TaskCo
 
 	var storedErr error
 	if runOp == driver.RunOptionNow {
+		spanCtx, span := tracing.Start(ctx, "task.run", tracing.TaskName(taskName))
+		ctx = spanCtx
+		defer func() { tracing.End(span, storedErr) }()
+
 		task := d.Task()
 		ev, err := event.NewEvent(taskName, &event.Config{
 			Providers: task.ProviderIDs(),
@@ -220,13 +274,23 @@ func (tm *TasksManager) TaskUpdate(ctx context.Context, updateConf config.TaskCo
 				// only log error since update task occurred successfully by now
 				logger.Error("error storing event", "event", ev.GoString(), "error", err)
 			}
+			tm.notify(*ev)
 		}()
 		ev.Start()
 	}
 
 	if runOp != driver.RunOptionInspect {
-		// Only update state if the update is not inspect type
-		if err := tm.state.SetTask(updateConf); err != nil {
+		// A plan pending approval is stale once the task is updated
+		tm.discardPendingPlan(taskName)
+
+		// Only update state if the update is not inspect type. Patch the
+		// stored task so that the rest of its configuration is retained.
+		taskConf, ok := tm.state.GetTask(taskName)
+		if !ok {
+			taskConf = config.TaskConfig{Name: updateConf.Name}
+		}
+		taskConf.Enabled = config.Bool(*updateConf.Enabled)
+		if err := tm.state.SetTask(taskConf); err != nil {
 			logger.Error("error while setting task state", "error", err)
 			return false, "", "", err
 		}
@@ -289,6 +353,7 @@ then restarting the instance for changes to take effect`
 			// only log error since creating a task occurred successfully by now
 			logger.Error("error storing event", "event", ev.GoString(), "error", err)
 		}
+		tm.notify(*ev)
 	}
 
 	logger.Info("task was created and run successfully")
@@ -318,13 +383,17 @@ func (tm TasksManager) addTask(ctx context.Context, tc config.TaskConfig, d driv
 		tm.createdScheduleCh <- name
 	}
 
+	if _, ok := d.Task().DriftDetection(); ok {
+		tm.createdDriftCh <- name
+	}
+
 	return tc, nil
 }
 
 // cleanupTask cleans up a newly created task that has not yet been added to CTS
 // and started monitoring. Use TaskDelete for added and monitored tasks
 func (tm TasksManager) cleanupTask(ctx context.Context, d driver.Driver) {
-	// at the moment, only the driver needs to destroy its dependencies
+	tm.discardPendingPlan(d.Task().Name())
 	d.DestroyTask(ctx)
 }
 
@@ -341,9 +410,18 @@ func (tm TasksManager) cleanupTask(ctx context.Context, d driver.Driver) {
 // Note on #2: no event is stored when a dynamic task renders but does not apply.
 // This can occur because driver.RenderTemplate() may need to be called multiple
 // times before a template is ready to be applied.
-func (tm *TasksManager) TaskRunNow(ctx context.Context, taskName string) error {
+//
+// Tasks within a blackout window render but do not apply. Their changes are
+// applied once when the window ends.
+//
+// Tasks with a manual apply mode plan their changes instead of applying them.
+// The plan is pending until it is approved or superseded by newer changes.
+func (tm *TasksManager) TaskRunNow(ctx context.Context, taskName string) (err error) {
 	logger := tm.logger.With(taskNameLogKey, taskName)
 
+	ctx, span := tracing.Start(ctx, "task.run", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
 	if tm.drivers.IsMarkedForDeletion(taskName) {
 		logger.Trace("task is marked for deletion, skipping")
 		return nil
@@ -403,28 +481,70 @@ func (tm *TasksManager) TaskRunNow(ctx context.Context, taskName string) error {
 	var storedErr error
 	storeEvent := func() {
 		ev.End(storedErr)
+		metrics.ObserveTaskRun(taskName, storedErr, ev.EndTime.Sub(ev.StartTime))
 		logger.Trace("adding event", "event", ev.GoString())
 		if err := tm.state.AddTaskEvent(*ev); err != nil {
 			logger.Error("error storing event", "event", ev.GoString())
 		}
+		tm.notify(*ev)
 	}
 	ev.Start()
 
 	var rendered bool
+	renderStart := time.Now()
 	rendered, storedErr = d.RenderTemplate(ctx)
+	metrics.ObserveTemplateRender(taskName, time.Since(renderStart))
 	if storedErr != nil {
+		if !event.HasErrorCode(storedErr) {
+			storedErr = event.NewCodedError(event.ErrCodeTemplateRender, storedErr)
+		}
 		defer storeEvent()
 		return fmt.Errorf("error rendering template for task %s: %s",
 			taskName, storedErr)
 	}
 
+	// Note: the rendered check must happen before the cluster check so that
+	// only tasks with a rendered template are tracked to be applied by this
+	// instance if it becomes responsible for them.
 	if !rendered {
 		if task.IsScheduled() {
+			if tm.cluster != nil && tm.cluster.skipApply(taskName) {
+				logger.Debug("task is executed by another instance, skipping")
+				return nil
+			}
+
 			// We want to store an event even when a scheduled task did not
 			// render i.e. the task ran on schedule but there were no
 			// dependency changes so the template did not re-render
 			logger.Info("scheduled task triggered but had no changes")
 			defer storeEvent()
+			return nil
+		}
+
+		logger.Trace("template not yet rendered, skipping")
+		return nil
+	}
+
+	if tm.cluster != nil && tm.cluster.skipApply(taskName) {
+		logger.Debug("task is executed by another instance, skipping")
+		return nil
+	}
+
+	if tm.deferApply(ctx, taskName) {
+		return nil
+	}
+
+	if task.IsManualApply() {
+		storedErr = tm.planForApproval(ctx, d)
+		if storedErr != nil {
+			ev.Plan = lastPlan(d)
+			defer storeEvent()
+			return fmt.Errorf("could not plan changes for task %s: %s",
+				taskName, storedErr)
+		}
+
+		if tm.ranTaskNotify != nil {
+			tm.ranTaskNotify <- taskName
 		}
 		return nil
 	}
@@ -437,6 +557,7 @@ func (tm *TasksManager) TaskRunNow(ctx context.Context, taskName string) error {
 
 		desc := fmt.Sprintf("ApplyTask %s", taskName)
 		storedErr = tm.retry.Do(ctx, d.ApplyTask, desc)
+		ev.Plan = lastPlan(d)
 		if storedErr != nil {
 			return fmt.Errorf("could not apply changes for task %s: %s",
 				taskName, storedErr)
@@ -452,6 +573,153 @@ func (tm *TasksManager) TaskRunNow(ctx context.Context, taskName string) error {
 	return nil
 }
 
+// applySkippedTasks applies the tasks that were skipped because they were
+// executed by another instance of the cluster, and that this instance is now
+// responsible for. It is called when the instance becomes the cluster leader or
+// when tasks are rebalanced, so that the infrastructure reflects any changes
+// that the previously responsible instance may have missed.
+func (tm *TasksManager) applySkippedTasks(ctx context.Context) {
+	if tm.cluster == nil {
+		return
+	}
+
+	for _, taskName := range tm.cluster.takeSkipped() {
+		if err := tm.applyTask(ctx, taskName); err != nil {
+			tm.logger.Error("error applying task taken over from another instance",
+				taskNameLogKey, taskName, "error", err)
+		}
+	}
+}
+
+// applyTask applies an existing task with its currently rendered template and
+// stores an event, regardless of whether there were dependency changes.
+func (tm *TasksManager) applyTask(ctx context.Context, taskName string) (err error) {
+	logger := tm.logger.With(taskNameLogKey, taskName)
+
+	ctx, span := tracing.Start(ctx, "task.run", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
+	d, ok := tm.drivers.Get(taskName)
+	if !ok || tm.drivers.IsMarkedForDeletion(taskName) {
+		logger.Trace("task no longer exists, skipping")
+		return nil
+	}
+
+	if err := tm.waitForTaskInactive(ctx, taskName); err != nil {
+		return err
+	}
+	tm.drivers.SetActive(taskName)
+	defer tm.drivers.SetInactive(taskName)
+
+	task := d.Task()
+	if !task.IsEnabled() {
+		logger.Trace("skipping disabled task")
+		return nil
+	}
+
+	if task.IsManualApply() {
+		if err := tm.planForApproval(ctx, d); err != nil {
+			return fmt.Errorf("could not plan changes for task %s: %s",
+				taskName, err)
+		}
+		return nil
+	}
+
+	ev, err := event.NewEvent(taskName, &event.Config{
+		Providers: task.ProviderIDs(),
+		Services:  task.ServiceNames(),
+		Source:    task.Module(),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating event for task %s: %s",
+			taskName, err)
+	}
+	ev.Start()
+
+	logger.Info("executing task")
+	desc := fmt.Sprintf("ApplyTask %s", taskName)
+	err = tm.retry.Do(ctx, d.ApplyTask, desc)
+	ev.Plan = lastPlan(d)
+	ev.End(err)
+	metrics.ObserveTaskRun(taskName, err, ev.EndTime.Sub(ev.StartTime))
+	logger.Trace("adding event", "event", ev.GoString())
+	if err := tm.state.AddTaskEvent(*ev); err != nil {
+		logger.Error("error storing event", "event", ev.GoString())
+	}
+	tm.notify(*ev)
+	if err != nil {
+		return fmt.Errorf("could not apply changes for task %s: %s",
+			taskName, err)
+	}
+
+	logger.Info("task completed")
+	if tm.ranTaskNotify != nil {
+		tm.ranTaskNotify <- taskName
+	}
+	return nil
+}
+
+// planSummarizer is implemented by drivers that summarize the plan of the
+// last time a task was applied
+type planSummarizer interface {
+	LastPlan() *event.Plan
+}
+
+// lastPlan returns the summary of the plan of the last time the driver's task
+// was applied. Returns nil if the driver does not summarize plans.
+func lastPlan(d driver.Driver) *event.Plan {
+	if s, ok := d.(planSummarizer); ok {
+		return s.LastPlan()
+	}
+	return nil
+}
+
+// conditionEvaluator is implemented by drivers that evaluate whether the
+// conditions of a scheduled task are met for the task to run on schedule
+type conditionEvaluator interface {
+	TakeConditionsMet() bool
+}
+
+// takeConditionsMet returns whether the conditions of the scheduled task are
+// met for the task to run on schedule. Returns true if the task's driver does
+// not evaluate conditions.
+func (tm *TasksManager) takeConditionsMet(taskName string) bool {
+	d, ok := tm.drivers.Get(taskName)
+	if !ok {
+		return true
+	}
+	if e, ok := d.(conditionEvaluator); ok {
+		return e.TakeConditionsMet()
+	}
+	return true
+}
+
+// notify notifies the webhooks configured globally and for the task that the
+// task run of the event finished
+func (tm *TasksManager) notify(ev event.Event) {
+	if tm.notifier == nil {
+		return
+	}
+
+	conf := tm.state.GetConfig().Notification
+	if tc, ok := tm.state.GetTask(ev.TaskName); ok && tc.Notification != nil {
+		conf = conf.Merge(tc.Notification)
+	}
+	tm.notifier.Notify(conf, ev)
+}
+
+// countTasks returns the number of enabled and disabled tasks
+func (tm *TasksManager) countTasks() (enabled, disabled int) {
+	for _, t := range tm.state.GetAllTasks() {
+		if config.BoolVal(t.Enabled) {
+			enabled++
+		} else {
+			disabled++
+		}
+	}
+	return enabled, disabled
+}
+
 // TaskByTemplate returns the name of the task associated with a template id.
 // If no task is associated with the template id, returns false.
 func (tm TasksManager) TaskByTemplate(tmplID string) (string, bool) {
@@ -494,6 +762,18 @@ func (tm TasksManager) WatchDeletedScheduleTask() <-chan string {
 	return tm.deletedScheduleCh
 }
 
+// WatchCreatedDriftTasks returns a channel to inform any watcher that a new
+// task with drift detection enabled has been created and added to CTS.
+func (tm TasksManager) WatchCreatedDriftTasks() <-chan string {
+	return tm.createdDriftCh
+}
+
+// WatchDeletedDriftTask returns a channel to inform any watcher that a task
+// with drift detection enabled has been deleted and removed from CTS.
+func (tm TasksManager) WatchDeletedDriftTask() <-chan string {
+	return tm.deletedDriftCh
+}
+
 // createTask creates and initializes a singular task from configuration
 func (tm *TasksManager) createTask(ctx context.Context, taskConfig config.TaskConfig) (*config.TaskConfig, driver.Driver, error) {
 	conf := tm.state.GetConfig()
@@ -566,16 +846,40 @@ func (tm *TasksManager) createTask(ctx context.Context, taskConfig config.TaskCo
 // Stores an event in the state that should be cleaned up if the task is not
 // added to CTS.
 func (tm *TasksManager) runNewTask(ctx context.Context, d driver.Driver,
-	allowApplyErr bool) (*event.Event, error) {
+	allowApplyErr bool) (_ *event.Event, err error) {
 
 	task := d.Task()
 	taskName := task.Name()
 	logger := tm.logger.With(taskNameLogKey, taskName)
+
+	ctx, span := tracing.Start(ctx, "task.run", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
 	if !task.IsEnabled() {
 		logger.Trace("skipping disabled task")
 		return nil, nil
 	}
 
+	if tm.cluster != nil && tm.cluster.skipApply(taskName) {
+		logger.Debug("task is executed by another instance, skipping")
+		return nil, nil
+	}
+
+	if task.IsManualApply() {
+		// no event is stored until the plan is approved and applied
+		err = tm.planForApproval(ctx, d)
+		if err != nil {
+			logger.Error("error planning task", "error", err)
+			if !allowApplyErr {
+				return nil, err
+			}
+		}
+
+		if tm.ranTaskNotify != nil {
+			tm.ranTaskNotify <- taskName
+		}
+		return nil, err
+	}
+
 	// Create new event for task run
 	ev, err := event.NewEvent(taskName, &event.Config{
 		Providers: task.ProviderIDs(),
@@ -631,10 +935,18 @@ func (tm *TasksManager) deleteTask(ctx context.Context, name string) error {
 	}
 
 	logger.Trace("task is inactive, deleting")
+	if tm.blackouts != nil {
+		tm.blackouts.delete(name)
+	}
+	tm.discardPendingPlan(name)
 	if d.Task().IsScheduled() {
 		// Notify the scheduled task to stop
 		tm.deletedScheduleCh <- name
 	}
+	if _, ok := d.Task().DriftDetection(); ok {
+		// Notify the drift detection of the task to stop
+		tm.deletedDriftCh <- name
+	}
 
 	// Delete task from drivers
 	err = tm.drivers.Delete(name)
@@ -652,6 +964,7 @@ func (tm *TasksManager) deleteTask(ctx context.Context, name string) error {
 		logger.Error("error while deleting task events state", "error", err)
 		return err
 	}
+	metrics.DeleteTask(name)
 
 	if tm.deletedTaskNotify != nil {
 		tm.deletedTaskNotify <- name
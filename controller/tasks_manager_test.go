@@ -5,8 +5,12 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,12 +20,20 @@ import (
 	mocksD "github.com/hashicorp/consul-terraform-sync/mocks/driver"
 	mocksS "github.com/hashicorp/consul-terraform-sync/mocks/state"
 	mocksTmpl "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul-terraform-sync/notification"
+	"github.com/hashicorp/consul-terraform-sync/retry"
 	"github.com/hashicorp/consul-terraform-sync/state"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -266,7 +278,7 @@ func Test_TasksManager_TaskCreateAndRun(t *testing.T) {
 		mockD.On("Task").Return(task).
 			On("InitTask", ctx).Return(nil).
 			On("RenderTemplate", mock.Anything).Return(true, nil).
-			On("ApplyTask", ctx).Return(fmt.Errorf("apply err"))
+			On("ApplyTask", mock.Anything).Return(fmt.Errorf("apply err"))
 		tm.state = state.NewInMemoryStore(conf)
 		tm.drivers = driver.NewDrivers()
 		tm.factory.newDriver = func(context.Context, *config.Config, *driver.Task, templates.Watcher) (driver.Driver, error) {
@@ -483,6 +495,7 @@ func Test_TasksManager_TaskUpdate(t *testing.T) {
 		// add to state
 		err = tm.state.SetTask(config.TaskConfig{
 			Name:    &taskName,
+			Module:  config.String("module"),
 			Enabled: config.Bool(false),
 		})
 		require.NoError(t, err, "unexpected error while setting task state")
@@ -500,10 +513,11 @@ func Test_TasksManager_TaskUpdate(t *testing.T) {
 		events := tm.state.GetTaskEvents(taskName)
 		assert.Len(t, events, 0)
 
-		// Confirm task became enabled in state
+		// Confirm task became enabled in state and retained its config
 		stateTask, exists := tm.state.GetTask(taskName)
 		require.True(t, exists)
 		assert.True(t, *stateTask.Enabled)
+		assert.Equal(t, "module", *stateTask.Module)
 	})
 }
 
@@ -598,6 +612,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 		renderTmplErr error
 		taskName      string
 		addToStore    bool
+		errorCode     string
 	}{
 		{
 			"error on driver.RenderTemplate()",
@@ -607,6 +622,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 			errors.New("error on driver.RenderTemplate()"),
 			"task_render_tmpl",
 			true,
+			event.ErrCodeTemplateRender,
 		},
 		{
 			"error on driver.ApplyTask()",
@@ -616,6 +632,18 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 			nil,
 			"task_apply",
 			true,
+			event.ErrCodeUnknown,
+		},
+		{
+			"handler error on driver.ApplyTask()",
+			true,
+			true,
+			event.NewCodedError(event.ErrCodeHandler,
+				errors.New("handler error on driver.ApplyTask()")),
+			nil,
+			"task_apply",
+			true,
+			event.ErrCodeHandler,
 		},
 		{
 			"disabled task",
@@ -625,6 +653,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 			nil,
 			"disabled_task",
 			false,
+			"",
 		},
 		{
 			"happy path",
@@ -634,6 +663,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 			nil,
 			"task_apply",
 			true,
+			"",
 		},
 	}
 
@@ -683,6 +713,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 				assert.False(t, e.Success)
 				require.NotNil(t, e.EventError)
 				assert.Contains(t, e.EventError.Message, tc.name)
+				assert.Equal(t, tc.errorCode, e.EventError.Code)
 			} else {
 				assert.NoError(t, err)
 				assert.True(t, e.Success)
@@ -772,7 +803,7 @@ func Test_TasksManager_TaskRunNow(t *testing.T) {
 		d.On("Task").Return(enabledTestTask(t, validTaskName)).
 			On("TemplateIDs").Return(nil).
 			On("RenderTemplate", mock.Anything).Return(true, nil).
-			On("ApplyTask", ctx).Return(nil)
+			On("ApplyTask", mock.Anything).Return(nil)
 		drivers := tm.drivers
 		drivers.Add(validTaskName, d)
 		drivers.SetActive(validTaskName)
@@ -835,6 +866,227 @@ func Test_TasksManager_TaskRunNow_Store(t *testing.T) {
 	})
 }
 
+func Test_TasksManager_TaskRunNow_Plan(t *testing.T) {
+	t.Parallel()
+
+	plan := &event.Plan{
+		Add:       1,
+		Resources: []string{"local_file.a"},
+		Output:    "Plan: 1 to add, 0 to change, 0 to destroy.",
+	}
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_a"))
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil)
+	d.On("ApplyTask", mock.Anything).Return(nil)
+
+	tm := newTestTasksManager()
+	tm.drivers.Add("task_a", &testPlanDriver{Driver: d, plan: plan})
+
+	require.NoError(t, tm.TaskRunNow(context.Background(), "task_a"))
+	events := tm.state.GetTaskEvents("task_a")["task_a"]
+	require.Len(t, events, 1)
+	assert.Equal(t, plan, events[0].Plan)
+}
+
+func Test_TasksManager_TaskRunNow_Tracing(t *testing.T) {
+	// Not parallel since the global tracer provider is configured
+	sr := tracetest.NewSpanRecorder()
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	d := new(mocksD.Driver)
+	d.On("Task").Return(enabledTestTask(t, "task_trace"))
+	d.On("TemplateIDs").Return(nil)
+	d.On("RenderTemplate", mock.Anything).Return(true, nil)
+	d.On("ApplyTask", mock.Anything).Run(func(args mock.Arguments) {
+		// stages of the driver are traced as children of the task run
+		ctx := args.Get(0).(context.Context)
+		assert.True(t, trace.SpanFromContext(ctx).SpanContext().IsValid())
+	}).Return(fmt.Errorf("apply err"))
+
+	tm := newTestTasksManager()
+	tm.retry = retry.NewTestRetry(0)
+	tm.drivers.Add("task_trace", d)
+
+	ctx := tracing.WithRequestID(context.Background(), "request-id")
+	require.Error(t, tm.TaskRunNow(ctx, "task_trace"))
+
+	spans := sr.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "task.run", span.Name())
+	assert.Contains(t, span.Attributes(), tracing.TaskName("task_trace"))
+	assert.Contains(t, span.Attributes(), tracing.AttrRequestID.String("request-id"))
+	assert.Equal(t, codes.Error, span.Status().Code)
+}
+
+func Test_TasksManager_TaskRunNow_Notification(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var payloads []notification.Payload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p notification.Payload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+		mu.Lock()
+		payloads = append(payloads, p)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	conf := &config.Config{
+		Tasks:        &config.TaskConfigs{},
+		Notification: &config.NotificationConfig{URLs: []string{srv.URL}},
+	}
+	taskConf := config.TaskConfig{
+		Name: config.String("task_a"),
+		Notification: &config.NotificationConfig{
+			FailuresOnly: config.Bool(true),
+		},
+	}
+
+	cases := []struct {
+		name     string
+		applyErr error
+		expected int
+	}{
+		{
+			"success_filtered",
+			nil,
+			0,
+		},
+		{
+			"failure_notified",
+			errors.New("apply err"),
+			1,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			mu.Lock()
+			payloads = nil
+			mu.Unlock()
+
+			d := new(mocksD.Driver)
+			d.On("Task").Return(enabledTestTask(t, "task_a"))
+			d.On("TemplateIDs").Return(nil)
+			d.On("RenderTemplate", mock.Anything).Return(true, nil)
+			d.On("ApplyTask", mock.Anything).Return(tc.applyErr)
+
+			tm := newTestTasksManager()
+			tm.retry = retry.NewTestRetry(0)
+			tm.notifier = notification.NewNotifier()
+			tm.state = state.NewInMemoryStore(conf)
+			require.NoError(t, tm.state.SetTask(taskConf))
+			tm.drivers.Add("task_a", d)
+
+			tm.TaskRunNow(context.Background(), "task_a")
+			tm.notifier.Wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, payloads, tc.expected)
+			if tc.expected > 0 {
+				assert.Equal(t, "task_a", payloads[0].TaskName)
+				assert.False(t, payloads[0].Success)
+				require.NotNil(t, payloads[0].Error)
+				assert.Equal(t, event.ErrCodeUnknown, payloads[0].Error.Code)
+			}
+		})
+	}
+}
+
+// testPlanDriver is a mock driver that summarizes the plan of the last time
+// the task was applied
+type testPlanDriver struct {
+	*mocksD.Driver
+	plan *event.Plan
+}
+
+func (d *testPlanDriver) LastPlan() *event.Plan {
+	return d.plan
+}
+
+// testConditionDriver is a mock driver that evaluates whether the conditions of
+// a scheduled task are met
+type testConditionDriver struct {
+	*mocksD.Driver
+	met bool
+}
+
+func (d *testConditionDriver) TakeConditionsMet() bool {
+	return d.met
+}
+
+func Test_TasksManager_takeConditionsMet(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		driver   func(*mocksD.Driver) driver.Driver
+		expected bool
+	}{
+		{
+			"conditions met",
+			func(d *mocksD.Driver) driver.Driver {
+				return &testConditionDriver{Driver: d, met: true}
+			},
+			true,
+		},
+		{
+			"conditions not met",
+			func(d *mocksD.Driver) driver.Driver {
+				return &testConditionDriver{Driver: d, met: false}
+			},
+			false,
+		},
+		{
+			"driver does not evaluate conditions",
+			func(d *mocksD.Driver) driver.Driver {
+				return d
+			},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := new(mocksD.Driver)
+			d.On("TemplateIDs").Return(nil)
+
+			tm := newTestTasksManager()
+			require.NoError(t, tm.drivers.Add("task_a", tc.driver(d)))
+			assert.Equal(t, tc.expected, tm.takeConditionsMet("task_a"))
+		})
+	}
+
+	t.Run("task does not exist", func(t *testing.T) {
+		tm := newTestTasksManager()
+		assert.True(t, tm.takeConditionsMet("task_a"))
+	})
+}
+
+func Test_TasksManager_countTasks(t *testing.T) {
+	t.Parallel()
+
+	s := new(mocksS.Store)
+	s.On("GetAllTasks").Return(config.TaskConfigs{
+		{Name: config.String("task_a"), Enabled: config.Bool(true)},
+		{Name: config.String("task_b"), Enabled: config.Bool(false)},
+		{Name: config.String("task_c"), Enabled: config.Bool(true)},
+	})
+
+	tm := newTestTasksManager()
+	tm.state = s
+
+	enabled, disabled := tm.countTasks()
+	assert.Equal(t, 2, enabled)
+	assert.Equal(t, 1, disabled)
+}
+
 func Test_ConditionMonitor_EnableTaskRanNotify(t *testing.T) {
 	t.Parallel()
 
@@ -881,7 +1133,7 @@ func Test_TasksManager_TaskCreateAndRunAllowFail(t *testing.T) {
 		mockD.On("Task").Return(task).
 			On("InitTask", ctx).Return(nil).
 			On("RenderTemplate", mock.Anything).Return(true, nil).
-			On("ApplyTask", ctx).Return(fmt.Errorf("apply err")).
+			On("ApplyTask", mock.Anything).Return(fmt.Errorf("apply err")).
 			On("SetBufferPeriod").Return().Once().
 			On("TemplateIDs").Return(nil).Once()
 
@@ -1084,7 +1336,7 @@ func mockDriver(ctx context.Context, d *mocksD.Driver, task *driver.Task) {
 		On("InitTask", ctx).Return(nil).
 		On("TemplateIDs").Return(nil).
 		On("RenderTemplate", mock.Anything).Return(true, nil).
-		On("ApplyTask", ctx).Return(nil)
+		On("ApplyTask", mock.Anything).Return(nil)
 }
 
 func newTestTasksManager() *TasksManager {
@@ -1093,7 +1345,10 @@ func newTestTasksManager() *TasksManager {
 		factory: &driverFactory{
 			logger: logging.NewNullLogger(),
 		},
-		drivers: driver.NewDrivers(),
-		state:   state.NewInMemoryStore(nil),
+		drivers:      driver.NewDrivers(),
+		state:        state.NewInMemoryStore(nil),
+		blackouts:    newBlackouts(),
+		pendingPlans: newPendingPlans(),
+		nextRuns:     newNextRuns(),
 	}
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
 	"github.com/hashicorp/consul-terraform-sync/retry"
 	"github.com/hashicorp/hcat"
 	"github.com/hashicorp/hcat/events"
@@ -135,6 +136,16 @@ func setVaultClient(clients *hcat.ClientSet, conf *config.Config) error {
 
 func newWatcherEventHandler(logger logging.Logger) events.EventHandler {
 	return func(e events.Event) {
+		// Count the results of the blocking queries to Consul
+		switch e.(type) {
+		case events.ServerContacted:
+			metrics.IncConsulBlockingQuery(metrics.BlockingQuerySuccess)
+		case events.ServerError:
+			metrics.IncConsulBlockingQuery(metrics.BlockingQueryError)
+		case events.ServerTimeout:
+			metrics.IncConsulBlockingQuery(metrics.BlockingQueryTimeout)
+		}
+
 		// Log events at different log levels based on the type
 		var level logging.Level
 		switch e.(type) {
@@ -29,9 +29,20 @@ type Driver interface {
 	// the state of Consul and network infrastructure
 	InspectTask(ctx context.Context) (InspectPlan, error)
 
+	// InspectDrift inspects the task's infrastructure for changes made outside
+	// of CTS without changing the task
+	InspectDrift(ctx context.Context) (InspectPlan, error)
+
 	// ApplyTask applies change for the task managed by the driver
 	ApplyTask(ctx context.Context) error
 
+	// PlanTask plans the changes for the task and saves the plan to be
+	// applied later by ApplyPlannedTask. Returns whether the plan has changes
+	PlanTask(ctx context.Context) (bool, error)
+
+	// ApplyPlannedTask applies the plan saved by PlanTask
+	ApplyPlannedTask(ctx context.Context) error
+
 	// UpdateTask supports updating certain fields of a task
 	UpdateTask(ctx context.Context, task PatchTask) (InspectPlan, error)
 
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package driver
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	ctsVersion "github.com/hashicorp/consul-terraform-sync/version"
+	goVersion "github.com/hashicorp/go-version"
+)
+
+// fallbackOpenTofuVersion is the version of OpenTofu that is installed when
+// the latest version cannot be determined or is not supported by CTS.
+const fallbackOpenTofuVersion = "1.8.8"
+
+// openTofuDownloadTimeout is the timeout for each request to download an
+// OpenTofu release.
+const openTofuDownloadTimeout = 5 * time.Minute
+
+var (
+	// openTofuReleasesURL is the base URL of the OpenTofu release artifacts
+	openTofuReleasesURL = "https://github.com/opentofu/opentofu/releases/download"
+
+	// openTofuLatestReleaseURL is the API endpoint of the latest OpenTofu
+	// release
+	openTofuLatestReleaseURL = "https://api.github.com/repos/opentofu/opentofu/releases/latest"
+
+	// openTofuSigningKey is the armored GPG key that the SHA256SUMS files of
+	// the releases are verified with
+	openTofuSigningKey = openTofuPublicKey
+)
+
+// installOpenTofu attempts to install the configured version of OpenTofu, or
+// the latest version if one is not configured, into the path. If the latest
+// version is unknown or outside of the known supported range for CTS, the
+// fallback version is installed.
+func installOpenTofu(ctx context.Context, conf *config.TerraformConfig) (*goVersion.Version, error) {
+	var tfVersion *goVersion.Version
+	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
+	if conf.Version != nil && *conf.Version != "" {
+		tfVersion = goVersion.Must(goVersion.NewVersion(*conf.Version))
+	} else {
+		latest, err := latestOpenTofuVersion(ctx)
+		if err != nil {
+			logger.Error("error fetching the latest OpenTofu version", "error", err)
+		} else {
+			tfVersion = latest
+		}
+	}
+
+	if tfVersion == nil || !ctsVersion.OpenTofuConstraint.Check(tfVersion) {
+		logger.Warn("could not determine a supported latest version of OpenTofu, fallback to fallback version",
+			"fallback_version", fallbackOpenTofuVersion)
+		tfVersion = goVersion.Must(goVersion.NewVersion(fallbackOpenTofuVersion))
+	}
+
+	if err := isTFCompatible(conf, tfVersion); err != nil {
+		return nil, err
+	}
+
+	// Create path if one doesn't already exist
+	_ = os.MkdirAll(*conf.Path, os.ModePerm)
+
+	if err := downloadOpenTofu(ctx, tfVersion, *conf.Path); err != nil {
+		return nil, err
+	}
+
+	logger.Debug("successfully installed opentofu", "version", tfVersion.String(),
+		"install_path", filepath.Join(*conf.Path, openTofuBinary))
+	return tfVersion, nil
+}
+
+// latestOpenTofuVersion fetches the version of the latest OpenTofu release
+func latestOpenTofuVersion(ctx context.Context) (*goVersion.Version, error) {
+	body, err := openTofuGet(ctx, openTofuLatestReleaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.Unmarshal(body, &release); err != nil {
+		return nil, fmt.Errorf("unable to decode the latest OpenTofu release: %s", err)
+	}
+
+	return goVersion.NewVersion(strings.TrimPrefix(release.TagName, "v"))
+}
+
+// downloadOpenTofu downloads the release archive of the OpenTofu version for
+// the current platform, verifies the archive against the SHA256 checksums of
+// the release, and extracts the tofu binary into the install directory. The
+// checksums are only trusted once their GPG signature is verified with the
+// OpenTofu release key, since they are served from the same origin as the
+// archive.
+func downloadOpenTofu(ctx context.Context, v *goVersion.Version, installDir string) error {
+	version := v.String()
+	baseURL := fmt.Sprintf("%s/v%s", strings.TrimSuffix(openTofuReleasesURL, "/"), version)
+	archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", version, runtime.GOOS, runtime.GOARCH)
+
+	sumsURL := fmt.Sprintf("%s/tofu_%s_SHA256SUMS", baseURL, version)
+	sums, err := openTofuGet(ctx, sumsURL)
+	if err != nil {
+		return err
+	}
+	sig, err := openTofuGet(ctx, sumsURL+".gpgsig")
+	if err != nil {
+		return err
+	}
+	if err := verifySignature(sums, sig); err != nil {
+		return fmt.Errorf("unable to verify the checksums of OpenTofu %s: %s",
+			version, err)
+	}
+
+	expected, err := findChecksum(sums, archiveName)
+	if err != nil {
+		return err
+	}
+
+	archive, err := openTofuGet(ctx, fmt.Sprintf("%s/%s", baseURL, archiveName))
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(archive)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s",
+			archiveName, expected, actual)
+	}
+
+	return extractBinary(archive, openTofuBinary, installDir)
+}
+
+// verifySignature verifies the detached GPG signature of the content with the
+// OpenTofu signing key
+func verifySignature(content, sig []byte) error {
+	keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(openTofuSigningKey))
+	if err != nil {
+		return fmt.Errorf("invalid signing key: %s", err)
+	}
+
+	_, err = openpgp.CheckDetachedSignature(keyRing, bytes.NewReader(content),
+		bytes.NewReader(sig), nil)
+	return err
+}
+
+// findChecksum returns the checksum of the file from the content of a
+// SHA256SUMS file
+func findChecksum(sums []byte, filename string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == filename {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("checksum not found for %s", filename)
+}
+
+// extractBinary extracts the binary from the zip archive into the directory
+func extractBinary(archive []byte, binary, dir string) error {
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return fmt.Errorf("unable to read the OpenTofu archive: %s", err)
+	}
+
+	for _, f := range r.File {
+		if f.Name != binary {
+			continue
+		}
+
+		src, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		// Write to a temporary file first so that a partial download does not
+		// leave a broken binary in the path
+		dst, err := os.CreateTemp(dir, binary+"-*")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(dst.Name())
+
+		if _, err := io.Copy(dst, src); err != nil {
+			dst.Close()
+			return err
+		}
+		if err := dst.Close(); err != nil {
+			return err
+		}
+		if err := os.Chmod(dst.Name(), 0755); err != nil {
+			return err
+		}
+		return os.Rename(dst.Name(), filepath.Join(dir, binary))
+	}
+
+	return fmt.Errorf("%s binary not found in the OpenTofu archive", binary)
+}
+
+// openTofuGet fetches the content of the URL
+func openTofuGet(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, openTofuDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q for %s", resp.Status, url)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package driver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallOpenTofu(t *testing.T) {
+	cases := []struct {
+		name            string
+		version         string
+		latest          string
+		corrupt         bool
+		untrusted       bool
+		expectedVersion string
+		expectError     bool
+	}{
+		{
+			"configured version",
+			"1.7.3",
+			"",
+			false,
+			false,
+			"1.7.3",
+			false,
+		},
+		{
+			"latest version",
+			"",
+			"v1.9.1",
+			false,
+			false,
+			"1.9.1",
+			false,
+		},
+		{
+			"unsupported latest version",
+			"",
+			"v2.0.0",
+			false,
+			false,
+			fallbackOpenTofuVersion,
+			false,
+		},
+		{
+			"checksum mismatch",
+			"1.7.3",
+			"",
+			true,
+			false,
+			"",
+			true,
+		},
+		{
+			"untrusted checksums signature",
+			"1.7.3",
+			"",
+			false,
+			true,
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newFakeOpenTofuReleases(t, tc.latest, tc.corrupt, tc.untrusted)
+
+			path := t.TempDir()
+			conf := &config.TerraformConfig{
+				Product: config.String(config.ProductOpenTofu),
+				Version: config.String(tc.version),
+				Path:    config.String(path),
+				Backend: map[string]interface{}{},
+			}
+
+			v, err := installOpenTofu(context.Background(), conf)
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.NoFileExists(t, filepath.Join(path, openTofuBinary))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, v.String())
+
+			content, err := os.ReadFile(filepath.Join(path, openTofuBinary))
+			require.NoError(t, err)
+			assert.Equal(t, ts.binary(tc.expectedVersion), content)
+
+			info, err := os.Stat(filepath.Join(path, openTofuBinary))
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	content := []byte("abc123  tofu_1.8.8_linux_amd64.zip\n")
+
+	t.Run("release key", func(t *testing.T) {
+		// The signing key is the OpenTofu release key
+		keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(openTofuSigningKey))
+		require.NoError(t, err)
+		require.Len(t, keyRing, 1)
+		assert.Equal(t, "E3E6E43D84CB852EADB0051D0C0AF313E5FD9F80",
+			fmt.Sprintf("%X", keyRing[0].PrimaryKey.Fingerprint))
+
+		// A signature of another key is not trusted
+		other := newTestSigner(t)
+		assert.Error(t, verifySignature(content, other.sign(t, content)))
+	})
+
+	t.Run("signing key", func(t *testing.T) {
+		signer := newTestSigner(t)
+		signer.trust(t)
+
+		sig := signer.sign(t, content)
+		assert.NoError(t, verifySignature(content, sig))
+		assert.Error(t, verifySignature(append(content, '\n'), sig))
+		assert.Error(t, verifySignature(content, []byte("not a signature")))
+	})
+}
+
+func TestFindChecksum(t *testing.T) {
+	sums := []byte("abc123  tofu_1.8.8_darwin_arm64.zip\n" +
+		"def456  tofu_1.8.8_linux_amd64.zip\n")
+
+	sum, err := findChecksum(sums, "tofu_1.8.8_linux_amd64.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "def456", sum)
+
+	_, err = findChecksum(sums, "tofu_1.8.8_windows_amd64.zip")
+	assert.Error(t, err)
+}
+
+type fakeOpenTofuReleases struct {
+	*httptest.Server
+}
+
+// testSigner signs content with a GPG key generated for a test
+type testSigner struct {
+	entity *openpgp.Entity
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	entity, err := openpgp.NewEntity("CTS Test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	return &testSigner{entity: entity}
+}
+
+// trust sets the key of the signer as the OpenTofu signing key for the test
+func (s *testSigner) trust(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	key := openTofuSigningKey
+	openTofuSigningKey = buf.String()
+	t.Cleanup(func() {
+		openTofuSigningKey = key
+	})
+}
+
+// sign returns the detached signature of the content
+func (s *testSigner) sign(t *testing.T, content []byte) []byte {
+	var buf bytes.Buffer
+	err := openpgp.DetachSign(&buf, s.entity, bytes.NewReader(content), nil)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+// newFakeOpenTofuReleases starts a server that serves the latest release and
+// the release artifacts of any version in place of the OpenTofu releases. The
+// checksums are signed with a trusted key, or with an untrusted key if set.
+func newFakeOpenTofuReleases(t *testing.T, latest string, corrupt, untrusted bool) *fakeOpenTofuReleases {
+	f := &fakeOpenTofuReleases{}
+
+	signer := newTestSigner(t)
+	if !untrusted {
+		signer.trust(t)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /latest", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"tag_name": %q}`, latest)
+	})
+	mux.HandleFunc("GET /download/{tag}/{file}", func(w http.ResponseWriter, r *http.Request) {
+		version := r.PathValue("tag")[1:]
+		archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", version, runtime.GOOS, runtime.GOARCH)
+		archive := f.archive(t, version)
+
+		sum := sha256.Sum256(archive)
+		sums := []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), archiveName))
+
+		switch r.PathValue("file") {
+		case fmt.Sprintf("tofu_%s_SHA256SUMS", version):
+			w.Write(sums)
+		case fmt.Sprintf("tofu_%s_SHA256SUMS.gpgsig", version):
+			w.Write(signer.sign(t, sums))
+		case archiveName:
+			if corrupt {
+				archive = append(archive, 0)
+			}
+			w.Write(archive)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+
+	releasesURL, latestURL := openTofuReleasesURL, openTofuLatestReleaseURL
+	openTofuReleasesURL = f.URL + "/download"
+	openTofuLatestReleaseURL = f.URL + "/latest"
+	t.Cleanup(func() {
+		openTofuReleasesURL, openTofuLatestReleaseURL = releasesURL, latestURL
+	})
+
+	return f
+}
+
+// binary is the content of the fake tofu binary of a version
+func (f *fakeOpenTofuReleases) binary(version string) []byte {
+	return []byte("tofu " + version)
+}
+
+// archive is the release archive of a version
+func (f *fakeOpenTofuReleases) archive(t *testing.T, version string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{"LICENSE", []byte("license")},
+		{openTofuBinary, f.binary(version)},
+	}
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		require.NoError(t, err)
+		_, err = w.Write(file.content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package driver
+
+import (
+	"unicode/utf8"
+
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/terraform-json"
+)
+
+// maxPlanOutputLength is the maximum length of the plan output that is
+// recorded for a task event
+const maxPlanOutputLength = 4096
+
+// newEventPlan summarizes the changes of a Terraform plan and its output for a
+// task event. Replaced resources are counted as both added and destroyed,
+// consistent with the Terraform plan output.
+func newEventPlan(plan *tfjson.Plan, output string) *event.Plan {
+	var changes []*tfjson.ResourceChange
+	if plan != nil {
+		changes = plan.ResourceChanges
+	}
+	return summarizePlan(changes, output)
+}
+
+// newDriftPlan summarizes the resources of a refresh-only Terraform plan that
+// were changed outside of Terraform, and the plan output, for a drift event.
+// Resources deleted outside of Terraform are counted as destroyed.
+func newDriftPlan(plan *tfjson.Plan, output string) *event.Plan {
+	var changes []*tfjson.ResourceChange
+	if plan != nil {
+		changes = plan.ResourceDrift
+	}
+	return summarizePlan(changes, output)
+}
+
+// summarizePlan counts the resource changes and truncates the plan output
+func summarizePlan(changes []*tfjson.ResourceChange, output string) *event.Plan {
+	p := &event.Plan{
+		Resources: []string{},
+	}
+
+	for _, rc := range changes {
+		if rc == nil || rc.Change == nil {
+			continue
+		}
+
+		actions := rc.Change.Actions
+		switch {
+		case actions.Replace():
+			p.Add++
+			p.Destroy++
+		case actions.Create():
+			p.Add++
+		case actions.Update():
+			p.Change++
+		case actions.Delete():
+			p.Destroy++
+		default:
+			// no-op and read actions do not change resources
+			continue
+		}
+		p.Resources = append(p.Resources, rc.Address)
+	}
+
+	if len(output) > maxPlanOutputLength {
+		// Truncate on a rune boundary to keep the output valid UTF-8
+		end := maxPlanOutputLength
+		for end > 0 && !utf8.RuneStart(output[end]) {
+			end--
+		}
+		output = output[:end]
+		p.Truncated = true
+	}
+	p.Output = output
+
+	return p
+}
+
+// hasOutputChanges returns whether the Terraform plan changes any of the root
+// module outputs
+func hasOutputChanges(plan *tfjson.Plan) bool {
+	if plan == nil {
+		return false
+	}
+	for _, oc := range plan.OutputChanges {
+		if oc != nil && len(oc.Actions) > 0 && !oc.Actions.NoOp() &&
+			!oc.Actions.Read() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newEventPlan(t *testing.T) {
+	t.Parallel()
+
+	change := func(address string, actions ...tfjson.Action) *tfjson.ResourceChange {
+		return &tfjson.ResourceChange{
+			Address: address,
+			Change:  &tfjson.Change{Actions: actions},
+		}
+	}
+
+	longOutput := strings.Repeat("a", maxPlanOutputLength+1)
+
+	// A 3-byte rune that starts 1 byte before the maximum length
+	multiByteOutput := strings.Repeat("a", maxPlanOutputLength-1) + "→ local_file.a"
+
+	cases := []struct {
+		name     string
+		plan     *tfjson.Plan
+		output   string
+		expected *event.Plan
+	}{
+		{
+			"nil_plan",
+			nil,
+			"",
+			&event.Plan{Resources: []string{}},
+		},
+		{
+			"changes",
+			&tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{
+					change("local_file.create", tfjson.ActionCreate),
+					change("local_file.update", tfjson.ActionUpdate),
+					change("local_file.delete", tfjson.ActionDelete),
+					change("local_file.replace", tfjson.ActionDelete, tfjson.ActionCreate),
+					change("local_file.noop", tfjson.ActionNoop),
+					change("data.local_file.read", tfjson.ActionRead),
+					{Address: "local_file.no_change"},
+				},
+			},
+			"Plan: 2 to add, 1 to change, 2 to destroy.",
+			&event.Plan{
+				Add:     2,
+				Change:  1,
+				Destroy: 2,
+				Resources: []string{"local_file.create", "local_file.update",
+					"local_file.delete", "local_file.replace"},
+				Output: "Plan: 2 to add, 1 to change, 2 to destroy.",
+			},
+		},
+		{
+			"truncated_output",
+			&tfjson.Plan{},
+			longOutput,
+			&event.Plan{
+				Resources: []string{},
+				Output:    longOutput[:maxPlanOutputLength],
+				Truncated: true,
+			},
+		},
+		{
+			"truncated_multi_byte_output",
+			&tfjson.Plan{},
+			multiByteOutput,
+			&event.Plan{
+				Resources: []string{},
+				Output:    multiByteOutput[:maxPlanOutputLength-1],
+				Truncated: true,
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			actual := newEventPlan(tc.plan, tc.output)
+			assert.Equal(t, tc.expected, actual)
+			assert.True(t, utf8.ValidString(actual.Output))
+		})
+	}
+}
+
+func Test_newDriftPlan(t *testing.T) {
+	t.Parallel()
+
+	change := func(address string, actions ...tfjson.Action) *tfjson.ResourceChange {
+		return &tfjson.ResourceChange{
+			Address: address,
+			Change:  &tfjson.Change{Actions: actions},
+		}
+	}
+
+	t.Run("nil_plan", func(t *testing.T) {
+		assert.Equal(t, &event.Plan{Resources: []string{}}, newDriftPlan(nil, ""))
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		plan := &tfjson.Plan{
+			ResourceDrift: []*tfjson.ResourceChange{
+				change("local_file.update", tfjson.ActionUpdate),
+				change("local_file.delete", tfjson.ActionDelete),
+			},
+			// changes of the configuration that are not applied are not drift
+			ResourceChanges: []*tfjson.ResourceChange{
+				change("local_file.create", tfjson.ActionCreate),
+			},
+		}
+		expected := &event.Plan{
+			Change:    1,
+			Destroy:   1,
+			Resources: []string{"local_file.update", "local_file.delete"},
+			Output:    "output",
+		}
+		assert.Equal(t, expected, newDriftPlan(plan, "output"))
+	})
+}
+
+func Test_hasOutputChanges(t *testing.T) {
+	t.Parallel()
+
+	outputs := func(actions ...tfjson.Action) *tfjson.Plan {
+		return &tfjson.Plan{
+			OutputChanges: map[string]*tfjson.Change{
+				"output": {Actions: actions},
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected bool
+	}{
+		{
+			"nil_plan",
+			nil,
+			false,
+		},
+		{
+			"no_outputs",
+			&tfjson.Plan{},
+			false,
+		},
+		{
+			"noop",
+			outputs(tfjson.ActionNoop),
+			false,
+		},
+		{
+			"update",
+			outputs(tfjson.ActionUpdate),
+			true,
+		},
+		{
+			"create",
+			outputs(tfjson.ActionCreate),
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			assert.Equal(t, tc.expected, hasOutputChanges(tc.plan))
+		})
+	}
+}
+
+func TestTerraform_LastPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newTerraform := func(c *mocks.Client) *Terraform {
+		return &Terraform{
+			task:   &Task{name: "task", enabled: true, logger: logging.NewNullLogger()},
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+	}
+
+	t.Run("applied", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Run(func(args mock.Arguments) {
+			if w, ok := args.Get(0).(interface{ WriteString(string) (int, error) }); ok {
+				w.WriteString("Plan: 1 to add, 0 to change, 0 to destroy.")
+			}
+		}).Twice()
+		c.On("SavePlan", ctx).Return(&tfjson.Plan{
+			ResourceChanges: []*tfjson.ResourceChange{{
+				Address: "local_file.a",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			}},
+		}, nil).Once()
+		c.On("ApplyPlan", ctx).Return(nil).Once()
+
+		tf := newTerraform(c)
+		assert.Nil(t, tf.LastPlan())
+		require.NoError(t, tf.ApplyTask(ctx))
+
+		plan := tf.LastPlan()
+		require.NotNil(t, plan)
+		assert.Equal(t, 1, plan.Add)
+		assert.Equal(t, []string{"local_file.a"}, plan.Resources)
+		assert.Contains(t, plan.Output, "1 to add")
+	})
+
+	t.Run("plan_error", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("SetStdout", mock.Anything).Twice()
+		c.On("SavePlan", ctx).Return(nil, errors.New("plan error")).Once()
+
+		tf := newTerraform(c)
+		tf.lastPlan = &event.Plan{}
+		err := tf.ApplyTask(ctx)
+		require.Error(t, err)
+		assert.Equal(t, event.ErrCodeTerraformPlan, event.ErrorCode(err))
+		assert.Nil(t, tf.LastPlan())
+		c.AssertNotCalled(t, "ApplyPlan", mock.Anything)
+	})
+}
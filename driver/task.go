@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	"github.com/hashicorp/consul-terraform-sync/plugin"
 	"github.com/hashicorp/consul-terraform-sync/templates/hcltmpl"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
 )
@@ -52,6 +53,13 @@ type BufferPeriod struct {
 	Max time.Duration
 }
 
+// DriftDetection is the configuration for periodically detecting drift of the
+// task's infrastructure
+type DriftDetection struct {
+	Interval  time.Duration
+	Remediate bool
+}
+
 // Task contains task configuration information
 type Task struct {
 	mu sync.RWMutex
@@ -59,6 +67,7 @@ type Task struct {
 	description  string
 	name         string
 	enabled      bool
+	applyMode    string
 	env          map[string]string
 	providers    TerraformProviderBlocks // task.providers config info
 	providerInfo map[string]interface{}  // driver.required_provider config info
@@ -66,13 +75,14 @@ type Task struct {
 	module       string
 	variables    hcltmpl.Variables // loaded variables
 	version      string
-	bufferPeriod *BufferPeriod // nil when disabled
+	bufferPeriod *BufferPeriod   // nil when disabled
+	drift        *DriftDetection // nil when disabled
 	condition    config.ConditionConfig
 	moduleInputs config.ModuleInputConfigs
 	workingDir   string
 	logger       logging.Logger
 
-	// Enterprise
+	// Terraform Cloud driver
 	deprecatedTFVersion string
 	tfcWorkspace        config.TerraformCloudWorkspaceConfig
 }
@@ -81,6 +91,7 @@ type TaskConfig struct {
 	Description  string
 	Name         string
 	Enabled      bool
+	ApplyMode    string
 	Env          map[string]string
 	Providers    TerraformProviderBlocks
 	ProviderInfo map[string]interface{}
@@ -89,11 +100,12 @@ type TaskConfig struct {
 	Variables    map[string]string
 	Version      string
 	BufferPeriod *BufferPeriod
+	Drift        *DriftDetection
 	Condition    config.ConditionConfig
 	ModuleInputs config.ModuleInputConfigs
 	WorkingDir   string
 
-	// Enterprise
+	// Terraform Cloud driver
 	DeprecatedTFVersion string
 	TFCWorkspace        config.TerraformCloudWorkspaceConfig
 }
@@ -115,6 +127,7 @@ func NewTask(conf TaskConfig) (*Task, error) {
 		description:  conf.Description,
 		name:         conf.Name,
 		enabled:      conf.Enabled,
+		applyMode:    conf.ApplyMode,
 		env:          conf.Env,
 		providers:    conf.Providers,
 		providerInfo: conf.ProviderInfo,
@@ -123,12 +136,13 @@ func NewTask(conf TaskConfig) (*Task, error) {
 		variables:    loadedVars,
 		version:      conf.Version,
 		bufferPeriod: conf.BufferPeriod,
+		drift:        conf.Drift,
 		condition:    conf.Condition,
 		moduleInputs: conf.ModuleInputs,
 		workingDir:   conf.WorkingDir,
 		logger:       logging.Global().Named(logSystemName),
 
-		// Enterprise
+		// Terraform Cloud driver
 		deprecatedTFVersion: conf.DeprecatedTFVersion,
 		tfcWorkspace:        conf.TFCWorkspace,
 	}, nil
@@ -145,6 +159,17 @@ func (t *Task) BufferPeriod() (BufferPeriod, bool) {
 	return *t.bufferPeriod, true
 }
 
+// DriftDetection returns a copy of the drift detection configuration. If drift
+// detection is not enabled, the second parameter returns false.
+func (t *Task) DriftDetection() (DriftDetection, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.drift == nil {
+		return DriftDetection{}, false
+	}
+	return *t.drift, true
+}
+
 // Condition returns the type of condition for the task to run
 func (t *Task) Condition() config.ConditionConfig {
 	t.mu.RLock()
@@ -163,8 +188,14 @@ func (t *Task) ModuleInputs() config.ModuleInputConfigs {
 func (t *Task) IsScheduled() bool {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
-	_, ok := t.condition.(*config.ScheduleConditionConfig)
-	return ok
+	switch v := t.condition.(type) {
+	case *config.ScheduleConditionConfig:
+		return true
+	case *config.CompositeConditionConfig:
+		_, ok := v.Schedule()
+		return ok
+	}
+	return false
 }
 
 // Description returns the task description
@@ -202,6 +233,20 @@ func (t *Task) Disable() {
 	t.enabled = false
 }
 
+// ApplyMode returns how the task applies changes: automatically, or manually
+// after a plan of the changes is approved
+func (t *Task) ApplyMode() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.applyMode
+}
+
+// IsManualApply returns whether changes of the task are applied only after a
+// plan of the changes is approved
+func (t *Task) IsManualApply() bool {
+	return t.ApplyMode() == config.ApplyModeManual
+}
+
 // Env returns a copy of task environment variables
 func (t *Task) Env() map[string]string {
 	t.mu.RLock()
@@ -295,7 +340,7 @@ func (t *Task) WorkingDir() string {
 }
 
 // DeprecatedTFVersion returns the Terraform version to use when using the Terraform Cloud
-// driver. Enterprise.
+// driver.
 // Deprecated, use the Terraform Version from TFCWorkspace() instead.
 func (t *Task) DeprecatedTFVersion() string {
 	t.mu.RLock()
@@ -304,7 +349,7 @@ func (t *Task) DeprecatedTFVersion() string {
 }
 
 // TFCWorkspace returns the Terraform Cloud Workspace configuration to use for the task
-// when using the Terraform Cloud driver. Enterprise only.
+// when using the Terraform Cloud driver.
 func (t *Task) TFCWorkspace() config.TerraformCloudWorkspaceConfig {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -364,48 +409,12 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 			fmt.Sprintf("%T", template))
 	}
 
-	var condition tftmpl.Template
-	switch v := t.condition.(type) {
-	case *config.CatalogServicesConditionConfig:
-		condition = &tftmpl.CatalogServicesTemplate{
-			Regexp:     *v.Regexp,
-			Datacenter: *v.Datacenter,
-			Namespace:  *v.Namespace,
-			NodeMeta:   v.NodeMeta,
-			RenderVar:  *v.UseAsModuleInput,
-		}
-	case *config.ServicesConditionConfig:
-		if v.Regexp != nil {
-			condition = &tftmpl.ServicesRegexTemplate{
-				Regexp:     *v.Regexp,
-				Datacenter: *v.Datacenter,
-				Namespace:  *v.Namespace,
-				Filter:     *v.Filter,
-				RenderVar:  *v.UseAsModuleInput,
-			}
-		} else {
-			condition = &tftmpl.ServicesTemplate{
-				Names:      v.Names,
-				Datacenter: *v.Datacenter,
-				Namespace:  *v.Namespace,
-				Filter:     *v.Filter,
-				RenderVar:  *v.UseAsModuleInput,
-			}
-		}
-	case *config.ConsulKVConditionConfig:
-		condition = &tftmpl.ConsulKVTemplate{
-			Path:       *v.Path,
-			Datacenter: *v.Datacenter,
-			Recurse:    *v.Recurse,
-			Namespace:  *v.Namespace,
-			RenderVar:  *v.UseAsModuleInput,
+	// a composite condition configures a template for each of its conditions
+	for _, cond := range config.Conditions(t.condition) {
+		condition := conditionTemplate(cond)
+		if condition == nil {
+			continue
 		}
-	default:
-		// no-op: condition block currently not required since services.list
-		// can be used alternatively
-	}
-
-	if condition != nil {
 		templates = append(templates, condition)
 		t.logger.Trace("condition block template configured", "template_type",
 			fmt.Sprintf("%T", condition))
@@ -418,19 +427,23 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 		case *config.ServicesModuleInputConfig:
 			if v.Regexp != nil {
 				moduleInputs[ix] = &tftmpl.ServicesRegexTemplate{
-					Regexp:     *v.Regexp,
-					Datacenter: *v.Datacenter,
-					Namespace:  *v.Namespace,
-					Filter:     *v.Filter,
+					Regexp:      *v.Regexp,
+					Datacenter:  *v.Datacenter,
+					Datacenters: v.Datacenters,
+					Peers:       v.Peers,
+					Namespace:   *v.Namespace,
+					Filter:      *v.Filter,
 					// always render var for module_input config
 					RenderVar: true,
 				}
 			} else {
 				moduleInputs[ix] = &tftmpl.ServicesTemplate{
-					Names:      v.Names,
-					Datacenter: *v.Datacenter,
-					Namespace:  *v.Namespace,
-					Filter:     *v.Filter,
+					Names:       v.Names,
+					Datacenter:  *v.Datacenter,
+					Datacenters: v.Datacenters,
+					Peers:       v.Peers,
+					Namespace:   *v.Namespace,
+					Filter:      *v.Filter,
 					// always render var for module_input config
 					RenderVar: true,
 				}
@@ -444,6 +457,39 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 				// always render var for module_input config
 				RenderVar: true,
 			}
+		case *config.IntentionsModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.IntentionsTemplate{
+				SourceServices:      v.SourceServices,
+				DestinationServices: v.DestinationServices,
+				Datacenter:          *v.Datacenter,
+				Namespace:           *v.Namespace,
+				// always render var for module_input config
+				RenderVar: true,
+			}
+		case *config.NodesModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.NodesTemplate{
+				Datacenter: *v.Datacenter,
+				NodeMeta:   v.NodeMeta,
+				Filter:     *v.Filter,
+				// always render var for module_input config
+				RenderVar: true,
+			}
+		case *config.PreparedQueryModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.PreparedQueryTemplate{
+				Name:       *v.Name,
+				Datacenter: *v.Datacenter,
+				Near:       *v.Near,
+				// always render var for module_input config
+				RenderVar: true,
+			}
+		case *config.GatewaysModuleInputConfig:
+			moduleInputs[ix] = &tftmpl.GatewaysTemplate{
+				Names:      v.Names,
+				Datacenter: *v.Datacenter,
+				Namespace:  *v.Namespace,
+				// always render var for module_input config
+				RenderVar: true,
+			}
 		default:
 			return fmt.Errorf("task %q has unsupported type of module_input "+
 				" block configuration %T", t.name, v)
@@ -474,6 +520,82 @@ func (t *Task) configureRootModuleInput(input *tftmpl.RootModuleInputData) error
 	return nil
 }
 
+// conditionTemplate returns the template for a condition. Returns nil for
+// conditions without a template.
+func conditionTemplate(cond config.ConditionConfig) tftmpl.Template {
+	switch v := cond.(type) {
+	case *config.CatalogServicesConditionConfig:
+		return &tftmpl.CatalogServicesTemplate{
+			Regexp:     *v.Regexp,
+			Datacenter: *v.Datacenter,
+			Namespace:  *v.Namespace,
+			NodeMeta:   v.NodeMeta,
+			RenderVar:  *v.UseAsModuleInput,
+		}
+	case *config.ServicesConditionConfig:
+		if v.Regexp != nil {
+			return &tftmpl.ServicesRegexTemplate{
+				Regexp:      *v.Regexp,
+				Datacenter:  *v.Datacenter,
+				Datacenters: v.Datacenters,
+				Peers:       v.Peers,
+				Namespace:   *v.Namespace,
+				Filter:      *v.Filter,
+				RenderVar:   *v.UseAsModuleInput,
+			}
+		}
+		return &tftmpl.ServicesTemplate{
+			Names:       v.Names,
+			Datacenter:  *v.Datacenter,
+			Datacenters: v.Datacenters,
+			Peers:       v.Peers,
+			Namespace:   *v.Namespace,
+			Filter:      *v.Filter,
+			RenderVar:   *v.UseAsModuleInput,
+		}
+	case *config.HealthChecksConditionConfig:
+		// a single query for all of the services allows for health to be
+		// compared across services and for services without instances. All
+		// statuses are queried so that instances transitioning out of passing
+		// are not mistaken for deregistered instances.
+		return &tftmpl.ServicesRegexTemplate{
+			Regexp:      v.ServicesRegexp(),
+			Datacenter:  *v.Datacenter,
+			Namespace:   *v.Namespace,
+			Filter:      *v.Filter,
+			AllStatuses: true,
+			RenderVar:   *v.UseAsModuleInput,
+		}
+	case *config.ConsulKVConditionConfig:
+		return &tftmpl.ConsulKVTemplate{
+			Path:       *v.Path,
+			Datacenter: *v.Datacenter,
+			Recurse:    *v.Recurse,
+			Namespace:  *v.Namespace,
+			RenderVar:  *v.UseAsModuleInput,
+		}
+	case *config.IntentionsConditionConfig:
+		return &tftmpl.IntentionsTemplate{
+			SourceServices:      v.SourceServices,
+			DestinationServices: v.DestinationServices,
+			Datacenter:          *v.Datacenter,
+			Namespace:           *v.Namespace,
+			RenderVar:           *v.UseAsModuleInput,
+		}
+	case *config.NodesConditionConfig:
+		return &tftmpl.NodesTemplate{
+			Datacenter: *v.Datacenter,
+			NodeMeta:   v.NodeMeta,
+			Filter:     *v.Filter,
+			RenderVar:  *v.UseAsModuleInput,
+		}
+	default:
+		// no-op: condition block currently not required since services.list
+		// can be used alternatively. schedule conditions have no template
+	}
+	return nil
+}
+
 // clientConfig configures a driver client for a task
 type clientConfig struct {
 	clientType string
@@ -482,6 +604,13 @@ type clientConfig struct {
 	persistLog bool
 	path       string
 	workingDir string
+	binary     string
+
+	// cloud configures a Terraform Cloud client when not nil
+	cloud *client.TerraformCloudConfig
+
+	// plugin configures a plugin client when not nil
+	plugin *client.PluginConfig
 }
 
 // newClient initializes a specific type of client given a task
@@ -504,6 +633,18 @@ func newClient(conf *clientConfig) (client.Client, error) {
 		tnlog.Trace("creating mock client for task")
 		c = new(mocks.Client)
 	default:
+		if conf.cloud != nil {
+			tnlog.Trace("creating terraform cloud client for task")
+			c, err = client.NewTerraformCloud(conf.cloud)
+			break
+		}
+
+		if conf.plugin != nil {
+			tnlog.Trace("creating plugin client for task")
+			c, err = client.NewPlugin(conf.plugin)
+			break
+		}
+
 		tnlog.Trace("creating terraform cli client for task")
 		c, err = client.NewTerraformCLI(&client.TerraformCLIConfig{
 			Log:        conf.log,
@@ -511,8 +652,59 @@ func newClient(conf *clientConfig) (client.Client, error) {
 			ExecPath:   conf.path,
 			WorkingDir: conf.workingDir,
 			Workspace:  taskName,
+			Binary:     conf.binary,
 		})
 	}
 
 	return c, err
 }
+
+// newTerraformCloudClientConfig configures the Terraform Cloud client of a
+// task with the workspace configuration of the task. Returns nil if tasks are
+// not executed with Terraform Cloud.
+func newTerraformCloudClientConfig(conf *TerraformCloudConfig, task *Task) *client.TerraformCloudConfig {
+	if conf == nil {
+		return nil
+	}
+
+	ws := task.TFCWorkspace()
+	tfVersion := config.StringVal(ws.TerraformVersion)
+	if tfVersion == "" {
+		tfVersion = task.DeprecatedTFVersion()
+	}
+
+	return &client.TerraformCloudConfig{
+		Address:          conf.Hostname,
+		Organization:     conf.Organization,
+		Token:            conf.Token,
+		Workspace:        conf.WorkspacePrefix + task.Name(),
+		WorkingDir:       task.WorkingDir(),
+		Tags:             conf.WorkspaceTags,
+		ExecutionMode:    config.StringVal(ws.ExecutionMode),
+		AgentPoolID:      config.StringVal(ws.AgentPoolID),
+		AgentPoolName:    config.StringVal(ws.AgentPoolName),
+		TerraformVersion: tfVersion,
+	}
+}
+
+// newPluginClientConfig configures the plugin client of a task. Returns nil if
+// tasks are not executed with a plugin.
+func newPluginClientConfig(conf *PluginConfig, task *Task) *client.PluginConfig {
+	if conf == nil {
+		return nil
+	}
+
+	return &client.PluginConfig{
+		Path:         conf.Path,
+		Args:         conf.Args,
+		StartTimeout: conf.StartTimeout,
+		WorkingDir:   task.WorkingDir(),
+		Task: plugin.Task{
+			Name:        task.Name(),
+			Description: task.Description(),
+			Module:      task.Module(),
+			Version:     task.Version(),
+			Providers:   task.ProviderIDs(),
+		},
+	}
+}
@@ -6,11 +6,13 @@ package driver
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/logging"
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	"github.com/hashicorp/consul-terraform-sync/plugin"
 	"github.com/hashicorp/consul-terraform-sync/templates/hcltmpl"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
 	"github.com/stretchr/testify/assert"
@@ -24,33 +26,80 @@ func TestNewClient(t *testing.T) {
 	cases := []struct {
 		name        string
 		clientType  string
+		cloud       *client.TerraformCloudConfig
+		plugin      *client.PluginConfig
 		expectError bool
 		expect      client.Client
 	}{
 		{
 			"happy path with development client",
 			developmentClient,
+			nil,
+			nil,
 			false,
 			&client.Printer{},
 		},
 		{
 			"happy path with mock client",
 			testClient,
+			nil,
+			nil,
 			false,
 			&mocks.Client{},
 		},
 		{
 			"error when creating Terraform CLI client",
 			"",
+			nil,
+			nil,
 			true,
 			&client.TerraformCLI{},
 		},
+		{
+			"happy path with Terraform Cloud client",
+			"",
+			&client.TerraformCloudConfig{
+				Address:      "app.terraform.io",
+				Organization: "org",
+				Token:        "token",
+				Workspace:    "cts-task",
+			},
+			nil,
+			false,
+			&client.TerraformCloud{},
+		},
+		{
+			"error when creating Terraform Cloud client",
+			"",
+			&client.TerraformCloudConfig{},
+			nil,
+			true,
+			&client.TerraformCloud{},
+		},
+		{
+			"happy path with plugin client",
+			"",
+			nil,
+			&client.PluginConfig{Path: "/opt/cts/plugin"},
+			false,
+			&client.Plugin{},
+		},
+		{
+			"error when creating plugin client",
+			"",
+			nil,
+			&client.PluginConfig{},
+			true,
+			&client.Plugin{},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			actual, err := newClient(&clientConfig{
 				clientType: tc.clientType,
+				cloud:      tc.cloud,
+				plugin:     tc.plugin,
 			})
 			if tc.expectError {
 				assert.Error(t, err)
@@ -100,6 +149,44 @@ func TestTask_BufferPeriod(t *testing.T) {
 	}
 }
 
+func TestTask_DriftDetection(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		drift        *DriftDetection
+		isConfigured bool
+	}{
+		{
+			name: "configured",
+			drift: &DriftDetection{
+				Interval:  time.Hour,
+				Remediate: true,
+			},
+			isConfigured: true,
+		},
+		{
+			name:         "not configured",
+			isConfigured: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var task Task
+			task.drift = tc.drift
+			drift, isConfigured := task.DriftDetection()
+			require.Equal(t, tc.isConfigured, isConfigured)
+
+			if tc.drift == nil {
+				require.Equal(t, DriftDetection{}, drift)
+			} else {
+				require.Equal(t, *tc.drift, drift)
+			}
+		})
+	}
+}
+
 func TestTask_Condition(t *testing.T) {
 	t.Parallel()
 
@@ -134,6 +221,30 @@ func TestTask_IsScheduled(t *testing.T) {
 			condition:   &config.ConsulKVConditionConfig{},
 			isScheduled: false,
 		},
+		{
+			name: "composite condition with schedule",
+			condition: &config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Conditions: config.ConditionConfigs{
+						&config.ConsulKVConditionConfig{},
+						&config.ScheduleConditionConfig{},
+					},
+				},
+			},
+			isScheduled: true,
+		},
+		{
+			name: "composite condition without schedule",
+			condition: &config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Conditions: config.ConditionConfigs{
+						&config.ConsulKVConditionConfig{},
+						&config.NodesConditionConfig{},
+					},
+				},
+			},
+			isScheduled: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -378,6 +489,88 @@ func TestTask_TFCWorkspace(t *testing.T) {
 	assert.Equal(t, task.tfcWorkspace, tfcWorkspace)
 }
 
+func TestNewTerraformCloudClientConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newTerraformCloudClientConfig(nil, &Task{}))
+
+	conf := &TerraformCloudConfig{
+		Hostname:        "app.terraform.io",
+		Organization:    "org",
+		Token:           "token",
+		WorkspacePrefix: "cts-",
+		WorkspaceTags:   []string{"cts"},
+	}
+
+	task := &Task{
+		name:                "task",
+		workingDir:          "sync-tasks/task",
+		deprecatedTFVersion: "1.0.0",
+		tfcWorkspace: config.TerraformCloudWorkspaceConfig{
+			ExecutionMode: config.String("agent"),
+			AgentPoolName: config.String("pool"),
+		},
+	}
+	expected := &client.TerraformCloudConfig{
+		Address:          "app.terraform.io",
+		Organization:     "org",
+		Token:            "token",
+		Workspace:        "cts-task",
+		WorkingDir:       "sync-tasks/task",
+		Tags:             []string{"cts"},
+		ExecutionMode:    "agent",
+		AgentPoolName:    "pool",
+		TerraformVersion: "1.0.0",
+	}
+	assert.Equal(t, expected, newTerraformCloudClientConfig(conf, task))
+
+	// the workspace Terraform version takes precedence over the deprecated
+	// task Terraform version
+	task.tfcWorkspace.TerraformVersion = config.String("1.5.0")
+	expected.TerraformVersion = "1.5.0"
+	assert.Equal(t, expected, newTerraformCloudClientConfig(conf, task))
+}
+
+func TestNewPluginClientConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newPluginClientConfig(nil, &Task{}))
+
+	conf := &PluginConfig{
+		Path:         "/opt/cts/plugin",
+		Args:         []string{"-a"},
+		StartTimeout: time.Minute,
+	}
+
+	task := &Task{
+		name:        "task",
+		description: "description",
+		module:      "org/module",
+		version:     "1.0.0",
+		workingDir:  "sync-tasks/task",
+		providers: TerraformProviderBlocks{
+			NewTerraformProviderBlock(hcltmpl.NewNamedBlock(
+				map[string]interface{}{
+					"local": map[string]interface{}{"alias": "one"},
+				})),
+		},
+	}
+	expected := &client.PluginConfig{
+		Path:         "/opt/cts/plugin",
+		Args:         []string{"-a"},
+		StartTimeout: time.Minute,
+		WorkingDir:   "sync-tasks/task",
+		Task: plugin.Task{
+			Name:        "task",
+			Description: "description",
+			Module:      "org/module",
+			Version:     "1.0.0",
+			Providers:   []string{"local.one"},
+		},
+	}
+	assert.Equal(t, expected, newPluginClientConfig(conf, task))
+}
+
 func TestTask_configureRootModuleInput(t *testing.T) {
 	t.Parallel()
 
@@ -485,6 +678,33 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "templates: health checks condition",
+			task: &Task{
+				condition: &config.HealthChecksConditionConfig{
+					HealthChecksMonitorConfig: config.HealthChecksMonitorConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:      []string{"api", "web"},
+							Datacenter: config.String("dc1"),
+							Namespace:  config.String("ns1"),
+							Filter:     config.String("filter"),
+						},
+						CriticalThreshold: config.Int(50),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ServicesRegexTemplate{
+					Regexp:      "^(?:api|web)$",
+					Datacenter:  "dc1",
+					Namespace:   "ns1",
+					Filter:      "filter",
+					AllStatuses: true,
+					RenderVar:   true,
+				},
+			},
+		},
 		{
 			name: "templates: consul kv condition",
 			task: &Task{
@@ -508,6 +728,133 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "templates: intentions condition",
+			task: &Task{
+				condition: &config.IntentionsConditionConfig{
+					IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+						SourceServices:      []string{"api"},
+						DestinationServices: []string{"db"},
+						Datacenter:          config.String("dc1"),
+						Namespace:           config.String("ns1"),
+					},
+					UseAsModuleInput: config.Bool(false),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.IntentionsTemplate{
+					SourceServices:      []string{"api"},
+					DestinationServices: []string{"db"},
+					Datacenter:          "dc1",
+					Namespace:           "ns1",
+					RenderVar:           false,
+				},
+			},
+		},
+		{
+			name: "templates: intentions module_input",
+			task: &Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.IntentionsModuleInputConfig{
+						IntentionsMonitorConfig: config.IntentionsMonitorConfig{
+							DestinationServices: []string{"db"},
+							Datacenter:          config.String(""),
+							Namespace:           config.String(""),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.IntentionsTemplate{
+					DestinationServices: []string{"db"},
+					RenderVar:           true,
+				},
+			},
+		},
+		{
+			name: "templates: nodes condition",
+			task: &Task{
+				condition: &config.NodesConditionConfig{
+					NodesMonitorConfig: config.NodesMonitorConfig{
+						Datacenter: config.String("dc1"),
+						NodeMeta:   map[string]string{"rack": "rack-1"},
+						Filter:     config.String("filter"),
+					},
+					UseAsModuleInput: config.Bool(true),
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.NodesTemplate{
+					Datacenter: "dc1",
+					NodeMeta:   map[string]string{"rack": "rack-1"},
+					Filter:     "filter",
+					RenderVar:  true,
+				},
+			},
+		},
+		{
+			name: "templates: nodes module_input",
+			task: &Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.NodesModuleInputConfig{
+						NodesMonitorConfig: config.NodesMonitorConfig{
+							Datacenter: config.String(""),
+							NodeMeta:   map[string]string{},
+							Filter:     config.String("Meta.env == prod"),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.NodesTemplate{
+					NodeMeta:  map[string]string{},
+					Filter:    "Meta.env == prod",
+					RenderVar: true,
+				},
+			},
+		},
+		{
+			name: "templates: prepared-query module_input",
+			task: &Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.PreparedQueryModuleInputConfig{
+						PreparedQueryMonitorConfig: config.PreparedQueryMonitorConfig{
+							Name:       config.String("api-failover"),
+							Datacenter: config.String("dc2"),
+							Near:       config.String(""),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.PreparedQueryTemplate{
+					Name:       "api-failover",
+					Datacenter: "dc2",
+					RenderVar:  true,
+				},
+			},
+		},
+		{
+			name: "templates: gateways module_input",
+			task: &Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.GatewaysModuleInputConfig{
+						GatewaysMonitorConfig: config.GatewaysMonitorConfig{
+							Names:      []string{"mesh-gateway", "ingress-gateway"},
+							Datacenter: config.String("dc2"),
+							Namespace:  config.String(""),
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.GatewaysTemplate{
+					Names:      []string{"mesh-gateway", "ingress-gateway"},
+					Datacenter: "dc2",
+					RenderVar:  true,
+				},
+			},
+		},
 		{
 			name: "templates: services module_input regex",
 			task: &Task{
@@ -555,6 +902,77 @@ func TestTask_configureRootModuleInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "templates: services module_input datacenters and peers",
+			task: &Task{
+				moduleInputs: config.ModuleInputConfigs{
+					&config.ServicesModuleInputConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							Names:       []string{"api"},
+							Datacenter:  config.String(""),
+							Datacenters: []string{"dc1", "dc2"},
+							Peers:       []string{"peer-a"},
+							Namespace:   config.String(""),
+							Filter:      config.String("")},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ServicesTemplate{
+					Names:       []string{"api"},
+					Datacenters: []string{"dc1", "dc2"},
+					Peers:       []string{"peer-a"},
+					RenderVar:   true,
+				},
+			},
+		},
+		{
+			name: "templates: composite condition",
+			task: &Task{
+				condition: &config.CompositeConditionConfig{
+					CompositeMonitorConfig: config.CompositeMonitorConfig{
+						Operator: config.String(config.CompositeOperatorAnd),
+						Conditions: config.ConditionConfigs{
+							&config.ConsulKVConditionConfig{
+								ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+									Path:       config.String("path"),
+									Datacenter: config.String("dc1"),
+									Namespace:  config.String("ns1"),
+									Recurse:    config.Bool(false),
+								},
+								UseAsModuleInput: config.Bool(true),
+							},
+							&config.ScheduleConditionConfig{
+								ScheduleMonitorConfig: config.ScheduleMonitorConfig{
+									Cron: config.String("* * * * * * *"),
+								},
+							},
+							&config.NodesConditionConfig{
+								NodesMonitorConfig: config.NodesMonitorConfig{
+									Datacenter: config.String("dc1"),
+									NodeMeta:   map[string]string{},
+									Filter:     config.String(""),
+								},
+								UseAsModuleInput: config.Bool(false),
+							},
+						},
+					},
+				},
+			},
+			expectedTemplates: []tftmpl.Template{
+				&tftmpl.ConsulKVTemplate{
+					Path:       "path",
+					Datacenter: "dc1",
+					Namespace:  "ns1",
+					RenderVar:  true,
+				},
+				&tftmpl.NodesTemplate{
+					Datacenter: "dc1",
+					NodeMeta:   map[string]string{},
+					RenderVar:  false,
+				},
+			},
+		},
 		{
 			name: "templates: multiple module_inputs",
 			task: &Task{
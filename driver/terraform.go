@@ -7,21 +7,27 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/handler"
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/hashicorp/consul-terraform-sync/templates"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/notifier"
 	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/consul-terraform-sync/tracing"
 	"github.com/hashicorp/hcat"
 	"github.com/hashicorp/hcat/dep"
 	"github.com/pkg/errors"
@@ -36,7 +42,8 @@ const (
 	workingDirPerms = os.FileMode(0750) // drwxr-x---
 	filePerms       = os.FileMode(0640) // -rw-r-----
 
-	errSuggestion = "remove Terraform from the configured path or specify a new path to safely install a compatible version."
+	errSuggestion         = "remove Terraform from the configured path or specify a new path to safely install a compatible version."
+	errOpenTofuSuggestion = "remove OpenTofu from the configured path or specify a new path to safely install a compatible version."
 
 	taskNameLogKey = "task_name"
 )
@@ -46,16 +53,19 @@ var (
 
 	errUnsupportedTerraformVersion = fmt.Errorf("unsupported Terraform version: %s", errSuggestion)
 	errIncompatibleTerraformBinary = fmt.Errorf("incompatible Terraform binary: %s", errSuggestion)
+	errUnsupportedOpenTofuVersion  = fmt.Errorf("unsupported OpenTofu version: %s", errOpenTofuSuggestion)
+	errIncompatibleOpenTofuBinary  = fmt.Errorf("incompatible OpenTofu binary: %s", errOpenTofuSuggestion)
 )
 
-// Terraform is a CTS driver that uses the Terraform CLI to interface with
-// low-level network infrastructure.
+// Terraform is a CTS driver that uses the Terraform CLI, or Terraform Cloud
+// runs, to interface with low-level network infrastructure.
 type Terraform struct {
 	mu sync.RWMutex
 
 	task              *Task
 	backend           map[string]interface{}
 	requiredProviders map[string]interface{}
+	openTofu          bool
 
 	resolver   templates.Resolver
 	template   templates.Template
@@ -68,9 +78,25 @@ type Terraform struct {
 
 	inited bool
 
+	// lastPlan summarizes the plan of the last time the task was applied
+	lastPlan *event.Plan
+
 	logger logging.Logger
 
 	onceNotifier *notifier.OnceNotifier
+
+	// compositeCheck tracks the conditions of a composite condition. It is nil
+	// for other conditions
+	compositeCheck *notifier.CompositeTriggerCheck
+
+	// lastRendered is the content of the template the last time it was
+	// rendered. It is used to skip insignificant changes for tasks that
+	// configure significant fields
+	lastRendered []byte
+
+	// significantFields returns the significant fields of a service. It is
+	// nil for tasks that do not configure significant fields
+	significantFields tftmpl.SignificantFieldsFunc
 }
 
 // TerraformConfig configures the Terraform driver
@@ -84,6 +110,52 @@ type TerraformConfig struct {
 	Watcher           templates.Watcher
 	// empty/unknown string will default to TerraformCLI client
 	ClientType string
+
+	// OpenTofu configures the driver to execute the task with the OpenTofu
+	// CLI instead of the Terraform CLI
+	OpenTofu bool
+
+	// TerraformCloud configures the driver to execute the task as runs of a
+	// Terraform Cloud workspace instead of with the local Terraform CLI
+	TerraformCloud *TerraformCloudConfig
+
+	// Plugin configures the driver to execute the task with an external
+	// plugin instead of with the local Terraform CLI
+	Plugin *PluginConfig
+}
+
+// TerraformCloudConfig configures the Terraform driver to execute tasks
+// remotely with Terraform Cloud
+type TerraformCloudConfig struct {
+	Hostname        string
+	Organization    string
+	Token           string
+	WorkspacePrefix string
+	WorkspaceTags   []string
+}
+
+// PluginConfig configures the Terraform driver to execute tasks with an
+// external plugin
+type PluginConfig struct {
+	Path         string
+	Args         []string
+	StartTimeout time.Duration
+}
+
+// VerifyPlugin verifies that the plugin executable at the path exists so that
+// it can be launched by tasks
+func VerifyPlugin(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to find plugin: %s", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("plugin path %s is a directory, expected an executable", path)
+	}
+	if info.Mode().Perm()&0111 == 0 {
+		return fmt.Errorf("plugin %s is not executable", path)
+	}
+	return nil
 }
 
 // NewTerraform configures and initializes a new Terraform driver for a task.
@@ -107,6 +179,9 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 		persistLog: config.PersistLog,
 		path:       config.Path,
 		workingDir: wd,
+		binary:     cliBinary(config.OpenTofu),
+		cloud:      newTerraformCloudClientConfig(config.TerraformCloud, task),
+		plugin:     newPluginClientConfig(config.Plugin, task),
 	})
 	if err != nil {
 		logger.Error("init client type error", "client_type", config.ClientType, "error", err)
@@ -119,7 +194,12 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 		// The terraform-exec package disables inheriting from the os environment
 		// when using tfexec.SetEnv(). So for CTS purposes, we'll force inheritance
 		// to allow Terraform commands to use the os environment as necessary.
-		env := envMap(os.Environ())
+		// Remote runs with Terraform Cloud only receive the task environment,
+		// and plugins inherit the os environment when they are launched.
+		env := make(map[string]string)
+		if config.TerraformCloud == nil && config.Plugin == nil {
+			env = envMap(os.Environ())
+		}
 		for k, v := range taskEnv {
 			env[k] = v
 		}
@@ -140,6 +220,7 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 		task:              config.Task,
 		backend:           config.Backend,
 		requiredProviders: config.RequiredProviders,
+		openTofu:          config.OpenTofu,
 		client:            tfClient,
 		logClient:         config.Log,
 		postApply:         h,
@@ -150,8 +231,14 @@ func NewTerraform(config *TerraformConfig) (*Terraform, error) {
 	}, nil
 }
 
-// Version returns the Terraform CLI version for the Terraform driver.
+// Version returns the Terraform CLI version for the Terraform driver, or the
+// OpenTofu CLI version when tasks are executed with OpenTofu. The version is
+// empty when Terraform is not installed locally, i.e. when tasks are executed
+// with Terraform Cloud.
 func (tf *Terraform) Version() string {
+	if TerraformVersion == nil {
+		return ""
+	}
 	return TerraformVersion.String()
 }
 
@@ -176,12 +263,20 @@ func (tf *Terraform) InitTask(ctx context.Context) error {
 	return tf.initTask(ctx)
 }
 
-// DestroyTask destroys task dependencies so that it is safe for deletion
-func (tf *Terraform) DestroyTask(_ context.Context) {
+// DestroyTask destroys task dependencies so that it is safe for deletion,
+// including the resources of the client, e.g. the process of a plugin
+func (tf *Terraform) DestroyTask(ctx context.Context) {
 	tf.mu.Lock()
 	defer tf.mu.Unlock()
 
 	tf.deregisterTemplate()
+
+	if d, ok := tf.client.(client.Destroyer); ok {
+		if err := d.Destroy(ctx); err != nil {
+			tf.logger.Warn("error destroying the client of the task",
+				taskNameLogKey, tf.task.Name(), "error", err)
+		}
+	}
 }
 
 // SetBufferPeriod sets the buffer period for the task. Do not set this when
@@ -225,11 +320,14 @@ func (tf *Terraform) TemplateIDs() []string {
 // renders the template. Rendering a template for the first time may take several
 // cycles to load all the dependencies asynchronously. Returns a boolean whether
 // the template was rendered
-func (tf *Terraform) RenderTemplate(_ context.Context) (bool, error) {
+func (tf *Terraform) RenderTemplate(ctx context.Context) (_ bool, err error) {
 	tf.mu.Lock()
 	defer tf.mu.Unlock()
 	taskName := tf.task.Name()
 
+	_, span := tracing.Start(ctx, "driver.render_template", tracing.TaskName(taskName))
+	defer func() { tracing.End(span, err) }()
+
 	if !tf.task.IsEnabled() {
 		tf.logger.Trace("task disabled. skip rendering template", taskNameLogKey, taskName)
 		return true, nil
@@ -241,7 +339,9 @@ func (tf *Terraform) RenderTemplate(_ context.Context) (bool, error) {
 }
 
 // InspectTask inspects for any differences pertaining to the task between
-// the state of Consul and network infrastructure using the Terraform plan command
+// the state of Consul and network infrastructure using the Terraform plan
+// command. The inspected task is not run, so its template is deregistered
+// and the resources of its client are released once inspected.
 func (tf *Terraform) InspectTask(ctx context.Context) (InspectPlan, error) {
 	tf.mu.Lock()
 	defer tf.mu.Unlock()
@@ -256,9 +356,48 @@ func (tf *Terraform) InspectTask(ctx context.Context) (InspectPlan, error) {
 
 	plan, err := tf.inspectTask(ctx, true)
 	tf.deregisterTemplate()
+	if c, ok := tf.client.(client.Closer); ok {
+		c.Close()
+	}
 	return plan, err
 }
 
+// InspectDrift inspects the infrastructure of the task for changes made
+// outside of CTS using a refresh-only Terraform plan. Only the drifted
+// resources are summarized, not the changes of the rendered template that
+// were not yet applied. The inspection does not change the task, so it is
+// safe to inspect a task that is running.
+func (tf *Terraform) InspectDrift(ctx context.Context) (InspectPlan, error) {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	taskName := tf.task.Name()
+	if !tf.task.IsEnabled() {
+		tf.logger.Trace(
+			"task disabled. skip inspecting drift", taskNameLogKey, taskName)
+		return InspectPlan{
+			Plan: "Task is disabled, drift inspection was skipped.",
+		}, nil
+	}
+
+	var buf bytes.Buffer
+	defer tf.captureStdout(&buf)()
+
+	tf.logger.Trace("refresh-only plan", taskNameLogKey, taskName)
+	plan, err := tf.client.InspectDrift(ctx)
+	if err != nil {
+		return InspectPlan{}, event.NewCodedError(event.ErrCodeTerraformPlan,
+			errors.Wrap(err, fmt.Sprintf("error tf-plan -refresh-only for '%s'", taskName)))
+	}
+
+	summary := newDriftPlan(plan, buf.String())
+	return InspectPlan{
+		ChangesPresent: summary.HasChanges(),
+		Plan:           buf.String(),
+		Summary:        summary,
+	}, nil
+}
+
 // ApplyTask applies the task changes.
 func (tf *Terraform) ApplyTask(ctx context.Context) error {
 	tf.mu.Lock()
@@ -278,6 +417,10 @@ type InspectPlan struct {
 	ChangesPresent bool   `json:"changes_present"`
 	Plan           string `json:"plan"`
 	URL            string `json:"url,omitempty"`
+
+	// Summary summarizes the resource changes of the inspected plan. Nil if
+	// the task was not inspected.
+	Summary *event.Plan `json:"-"`
 }
 
 // UpdateTask updates the task on the driver. Makes any calls to re-init
@@ -380,7 +523,8 @@ func (tf *Terraform) init(ctx context.Context) error {
 
 	tf.logger.Trace("initializing workspace", taskNameLogKey, taskName)
 	if err := tf.client.Init(ctx); err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error tf-init for '%s'", taskName))
+		return event.NewCodedError(event.ErrCodeTerraformInit,
+			errors.Wrap(err, fmt.Sprintf("error tf-init for '%s'", taskName)))
 	}
 	tf.inited = true
 	return nil
@@ -390,6 +534,7 @@ func (tf *Terraform) init(ctx context.Context) error {
 func (tf *Terraform) initTask(ctx context.Context) error {
 	input := tftmpl.RootModuleInputData{
 		TerraformVersion: TerraformVersion,
+		OpenTofu:         tf.openTofu,
 		Backend:          tf.backend,
 		Path:             tf.task.WorkingDir(),
 		FilePerms:        filePerms,
@@ -455,8 +600,9 @@ func (tf *Terraform) renderTemplate() (hcat.ResolveEvent, error) {
 	if err != nil {
 		tnlog.Error("error checking dependency changes for task", "error", err)
 
-		return hcat.ResolveEvent{}, fmt.Errorf("error fetching template dependencies for task %s: %s",
-			taskName, err)
+		return hcat.ResolveEvent{}, event.NewCodedError(event.ErrCodeTemplateRender,
+			fmt.Errorf("error fetching template dependencies for task %s: %s",
+				taskName, err))
 	}
 
 	// result.NoChange can occur when template rendering is forced even though
@@ -468,21 +614,140 @@ func (tf *Terraform) renderTemplate() (hcat.ResolveEvent, error) {
 	}
 
 	if result.Complete && !result.NoChange {
+		if tf.insignificantChange(result.Contents) {
+			tnlog.Debug("only insignificant changes detected for task, skipping")
+			result.NoChange = true
+			return result, nil
+		}
+
 		tnlog.Debug("change detected for task")
 
 		rendered, err := tf.template.Render(result.Contents)
 		if err != nil {
 			tnlog.Error("rendering template for task", "error", err)
 
-			return hcat.ResolveEvent{}, err
+			return hcat.ResolveEvent{}, event.NewCodedError(event.ErrCodeTemplateRender, err)
 		}
 		tnlog.Trace("template for task rendered", "rendered_template", rendered)
+		tf.lastRendered = result.Contents
+		tf.observeBufferPeriodDelay()
+		if !tf.onceNotifier.OnceDone() {
+			// conditions met while fetching the initial data are handled by
+			// the first run of the task
+			tf.compositeCheck.Reset()
+		}
 		tf.onceNotifier.SetOnceDone()
 	}
 
 	return result, nil
 }
 
+// insignificantChange returns whether the contents of the template only differ
+// from the last rendered contents in fields of service instances that are not
+// configured as significant fields
+func (tf *Terraform) insignificantChange(contents []byte) bool {
+	if tf.lastRendered == nil || !tf.OnceDone() {
+		return false
+	}
+
+	if tf.significantFields == nil {
+		return false
+	}
+
+	changed, err := tftmpl.SignificantChange(tf.lastRendered, contents,
+		tf.significantFields)
+	if err != nil {
+		tf.logger.Warn("unable to compare changes to significant fields for "+
+			"task, treating changes as significant", taskNameLogKey,
+			tf.task.Name(), "error", err)
+		return false
+	}
+	return !changed
+}
+
+// significantFieldsFunc returns the significant fields of the services of a
+// task configured by its services condition(s) and module inputs. Returns nil
+// when no significant fields are configured. The regexps of the services are
+// compiled once for the returned function.
+func significantFieldsFunc(task *Task) tftmpl.SignificantFieldsFunc {
+	var monitors []*servicesMonitor
+	addMonitor := func(c config.ServicesMonitorConfig) {
+		if c.SignificantFields == nil {
+			return
+		}
+		m := &servicesMonitor{names: c.Names, fields: c.SignificantFields}
+		if c.Regexp != nil {
+			re, err := regexp.Compile(*c.Regexp)
+			if err != nil {
+				// the regexp is validated with the task configuration
+				return
+			}
+			m.regexp = re
+		}
+		monitors = append(monitors, m)
+	}
+
+	conditions := []config.ConditionConfig{task.Condition()}
+	if c, ok := task.Condition().(*config.CompositeConditionConfig); ok {
+		conditions = c.Conditions
+	}
+	for _, cond := range conditions {
+		if c, ok := cond.(*config.ServicesConditionConfig); ok {
+			addMonitor(c.ServicesMonitorConfig)
+		}
+	}
+	for _, input := range task.ModuleInputs() {
+		if c, ok := input.(*config.ServicesModuleInputConfig); ok {
+			addMonitor(c.ServicesMonitorConfig)
+		}
+	}
+
+	if len(monitors) == 0 {
+		return nil
+	}
+
+	return func(service string) []string {
+		for _, m := range monitors {
+			if m.selects(service) {
+				return m.fields
+			}
+		}
+		return nil
+	}
+}
+
+// servicesMonitor is a services monitor with significant fields
+type servicesMonitor struct {
+	regexp *regexp.Regexp
+	names  []string
+	fields []string
+}
+
+// selects returns whether the monitor selects a service by name
+func (m *servicesMonitor) selects(service string) bool {
+	if m.regexp != nil {
+		return m.regexp.MatchString(service)
+	}
+	for _, name := range m.names {
+		if name == service {
+			return true
+		}
+	}
+	return false
+}
+
+// observeBufferPeriodDelay records the delay between the first change that
+// triggered the task and the template rendering, for tasks with a buffer period
+func (tf *Terraform) observeBufferPeriodDelay() {
+	triggeredAt := tf.onceNotifier.TakeTriggeredAt()
+	if triggeredAt.IsZero() || !tf.onceNotifier.OnceDone() {
+		return
+	}
+	if _, ok := tf.task.BufferPeriod(); ok {
+		metrics.ObserveBufferPeriodDelay(tf.task.Name(), time.Since(triggeredAt))
+	}
+}
+
 // inspectTask inspects the task changes. Option to return inspection plan
 // details rather than logging out
 func (tf *Terraform) inspectTask(ctx context.Context, returnPlan bool) (InspectPlan, error) {
@@ -490,49 +755,132 @@ func (tf *Terraform) inspectTask(ctx context.Context, returnPlan bool) (InspectP
 
 	var buf bytes.Buffer
 	if returnPlan {
-		tf.client.SetStdout(&buf)
-
-		var tfLogger *log.Logger
-		if tf.logClient {
-			tfLogger = log.New(log.Writer(), "", log.Flags())
-		} else {
-			tfLogger = log.New(ioutil.Discard, "", 0)
-		}
-		defer tf.client.SetStdout(tfLogger.Writer())
+		defer tf.captureStdout(&buf)()
 	}
 
 	tf.logger.Trace("plan", taskNameLogKey, taskName)
-	c, err := tf.client.Plan(ctx)
+	plan, err := tf.client.InspectPlan(ctx)
 	if err != nil {
-		return InspectPlan{}, errors.Wrap(err,
-			fmt.Sprintf("error tf-plan for '%s'", taskName))
+		return InspectPlan{}, event.NewCodedError(event.ErrCodeTerraformPlan,
+			errors.Wrap(err, fmt.Sprintf("error tf-plan for '%s'", taskName)))
 	}
 
+	summary := newEventPlan(plan, buf.String())
 	return InspectPlan{
-		ChangesPresent: c,
+		ChangesPresent: summary.HasChanges() || hasOutputChanges(plan),
 		Plan:           buf.String(),
+		Summary:        summary,
 	}, nil
 }
 
-// applyTask applies the task changes.
+// LastPlan returns the summary of the plan of the last time the task was
+// applied. Returns nil if the task was not applied or planning failed.
+func (tf *Terraform) LastPlan() *event.Plan {
+	tf.mu.RLock()
+	defer tf.mu.RUnlock()
+	return tf.lastPlan
+}
+
+// PlanTask plans the task changes and saves the plan to be applied later by
+// ApplyPlannedTask. Returns whether the plan changes any resources. The
+// summary of the plan is returned by LastPlan.
+func (tf *Terraform) PlanTask(ctx context.Context) (bool, error) {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	if !tf.task.IsEnabled() {
+		tf.logger.Trace(
+			"task disabled. skip planning", taskNameLogKey, tf.task.Name())
+		return false, nil
+	}
+
+	if err := tf.planTask(ctx); err != nil {
+		return false, err
+	}
+	return tf.lastPlan.HasChanges(), nil
+}
+
+// ApplyPlannedTask applies the plan saved by PlanTask.
+func (tf *Terraform) ApplyPlannedTask(ctx context.Context) error {
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	if !tf.task.IsEnabled() {
+		tf.logger.Trace(
+			"task disabled. skip applying", taskNameLogKey, tf.task.Name())
+		return nil
+	}
+
+	return tf.applyPlannedTask(ctx)
+}
+
+// applyTask plans the task changes, summarizes the plan and applies it.
 func (tf *Terraform) applyTask(ctx context.Context) error {
+	if err := tf.planTask(ctx); err != nil {
+		return err
+	}
+	return tf.applyPlannedTask(ctx)
+}
+
+// planTask plans the task changes, saves the plan and summarizes it.
+func (tf *Terraform) planTask(ctx context.Context) error {
+	taskName := tf.task.Name()
+	tf.lastPlan = nil
+
+	tf.logger.Trace("plan", taskNameLogKey, taskName)
+	var buf bytes.Buffer
+	reset := tf.captureStdout(&buf)
+	plan, err := tf.client.SavePlan(ctx)
+	reset()
+	if err != nil {
+		return event.NewCodedError(event.ErrCodeTerraformPlan,
+			errors.Wrap(err, fmt.Sprintf("error tf-plan for '%s'", taskName)))
+	}
+	tf.lastPlan = newEventPlan(plan, buf.String())
+	return nil
+}
+
+// applyPlannedTask applies the saved plan and executes the post-apply handler.
+func (tf *Terraform) applyPlannedTask(ctx context.Context) error {
 	taskName := tf.task.Name()
 
 	tf.logger.Trace("apply", taskNameLogKey, taskName)
-	if err := tf.client.Apply(ctx); err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error tf-apply for '%s'", taskName))
+	if err := tf.client.ApplyPlan(ctx); err != nil {
+		return event.NewCodedError(event.ErrCodeTerraformApply,
+			errors.Wrap(err, fmt.Sprintf("error tf-apply for '%s'", taskName)))
 	}
 
 	if tf.postApply != nil {
 		tf.logger.Trace("post-apply out-of-band actions for task", taskNameLogKey, taskName)
-		if err := tf.postApply.Do(ctx, nil); err != nil {
-			return err
+		hctx, span := tracing.Start(ctx, "handler.post_apply", tracing.TaskName(taskName))
+		err := tf.postApply.Do(hctx, nil)
+		tracing.End(span, err)
+		if err != nil {
+			return event.NewCodedError(event.ErrCodeHandler, err)
 		}
 	}
 
 	return nil
 }
 
+// captureStdout captures the standard out of the client in the buffer, in
+// addition to logging it if configured. Returns a function that resets the
+// standard out.
+func (tf *Terraform) captureStdout(buf *bytes.Buffer) func() {
+	var tfLogger *log.Logger
+	if tf.logClient {
+		tfLogger = log.New(log.Writer(), "", log.Flags())
+		tf.client.SetStdout(io.MultiWriter(buf, tfLogger.Writer()))
+	} else {
+		tfLogger = log.New(ioutil.Discard, "", 0)
+		tf.client.SetStdout(buf)
+	}
+
+	return func() {
+		tf.client.SetStdout(tfLogger.Writer())
+	}
+}
+
 // initTaskTemplate creates templates to be monitored and rendered.
 func (tf *Terraform) initTaskTemplate() error {
 	wd := tf.task.WorkingDir()
@@ -621,27 +969,71 @@ func validateTemplate(t *hcat.Template, clients hcat.Looker) error {
 // monitored changes (and not the module input's changes) trigger the task.
 func (tf *Terraform) setNotifier(tmpl templates.Template) error {
 	var notifyTrigger notifier.TriggerCheck
-	switch tf.task.Condition().(type) {
+	tf.compositeCheck = nil
+	switch v := tf.task.Condition().(type) {
+	case *config.CompositeConditionConfig:
+		_, scheduled := v.Schedule()
+		var checks []notifier.TriggerCheck
+		for _, cond := range v.Conditions {
+			if _, ok := cond.(*config.ScheduleConditionConfig); ok {
+				// the schedule is tracked by the controller
+				continue
+			}
+			checks = append(checks, triggerCheck(cond))
+		}
+		tf.compositeCheck = notifier.NewCompositeTriggerCheck(checks,
+			*v.Operator == config.CompositeOperatorAnd, scheduled)
+		notifyTrigger = tf.compositeCheck.TriggerCheck
+	default:
+		notifyTrigger = triggerCheck(v)
+	}
+	tf.onceNotifier = notifier.NewOnceNotifier(notifyTrigger, tmpl)
+	tf.template = tf.onceNotifier
+	tf.significantFields = significantFieldsFunc(tf.task)
+	return nil
+}
+
+// triggerCheck returns the trigger check for a condition
+func triggerCheck(cond config.ConditionConfig) notifier.TriggerCheck {
+	switch v := cond.(type) {
 	case *config.ServicesConditionConfig:
-		notifyTrigger = notifier.TriggerCheckService
+		return notifier.TriggerCheckService
+	case *config.HealthChecksConditionConfig:
+		if v.CriticalThreshold != nil {
+			return notifier.MakeTriggerCheckCriticalThreshold(*v.CriticalThreshold)
+		}
+		return notifier.MakeTriggerCheckHealthChecks()
 	case *config.CatalogServicesConditionConfig:
-		notifyTrigger = notifier.MakeTriggerCheckCatalogService()
+		return notifier.MakeTriggerCheckCatalogService()
 	case *config.ConsulKVConditionConfig:
-		notifyTrigger = notifier.TriggerCheckConsulKV
+		if v.Predicate != nil {
+			return notifier.MakeTriggerCheckConsulKVPredicate(v.Predicate.Match)
+		}
+		return notifier.TriggerCheckConsulKV
+	case *config.IntentionsConditionConfig:
+		return notifier.TriggerCheckIntentions
+	case *config.NodesConditionConfig:
+		return notifier.TriggerCheckNodes
 	case *config.ScheduleConditionConfig:
-		notifyTrigger = notifier.TriggerCheckSuppress
+		return notifier.TriggerCheckSuppress
 	default:
-		notifyTrigger = notifier.TriggerCheckService
+		return notifier.TriggerCheckService
 	}
-	tf.onceNotifier = notifier.NewOnceNotifier(notifyTrigger, tmpl)
-	tf.template = tf.onceNotifier
-	return nil
+}
+
+// TakeConditionsMet returns whether the conditions of a scheduled task with a
+// composite "and" condition have all been met since the task last ran on
+// schedule. Returns true for all other tasks.
+func (tf *Terraform) TakeConditionsMet() bool {
+	tf.mu.RLock()
+	defer tf.mu.RUnlock()
+	return tf.compositeCheck.TakeConditionsMet()
 }
 
 func (tf *Terraform) validateTask(ctx context.Context) error {
 	err := tf.client.Validate(ctx)
 	if err != nil {
-		return err
+		return event.NewCodedError(event.ErrCodeTerraformInit, err)
 	}
 	return nil
 }
@@ -683,17 +1075,33 @@ func getTerraformHandlers(taskName string, providers TerraformProviderBlocks) (h
 func getServicesMetaData(logger logging.Logger, task *Task) (*tmplfunc.ServicesMeta, error) {
 	servicesMeta := &tmplfunc.ServicesMeta{}
 
-	// Introduced in 0.5. Metadata comes from condition "services"
-	servicesCond, ok := task.Condition().(*config.ServicesConditionConfig)
-	if ok {
-		err := servicesMeta.SetMeta(servicesCond.CTSUserDefinedMeta)
-		if err != nil {
-			logger.Error("unable to to set metadata from services condition",
-				taskNameLogKey, task.Name(), "error", err)
-			return nil, err
+	// a composite condition can be configured with one of these conditions
+	for _, cond := range config.Conditions(task.Condition()) {
+		// Introduced in 0.5. Metadata comes from condition "services"
+		servicesCond, ok := cond.(*config.ServicesConditionConfig)
+		if ok {
+			err := servicesMeta.SetMeta(servicesCond.CTSUserDefinedMeta)
+			if err != nil {
+				logger.Error("unable to to set metadata from services condition",
+					taskNameLogKey, task.Name(), "error", err)
+				return nil, err
+			}
+
+			return servicesMeta, nil
 		}
 
-		return servicesMeta, nil
+		// Metadata comes from condition "health-checks"
+		healthCond, ok := cond.(*config.HealthChecksConditionConfig)
+		if ok {
+			err := servicesMeta.SetMeta(healthCond.CTSUserDefinedMeta)
+			if err != nil {
+				logger.Error("unable to to set metadata from health-checks condition",
+					taskNameLogKey, task.Name(), "error", err)
+				return nil, err
+			}
+
+			return servicesMeta, nil
+		}
 	}
 
 	// Introduced in 0.5. Metadata comes from module_input "services"
@@ -30,20 +30,72 @@ const (
 
 const fallbackTFVersion = "1.1.8"
 
+const (
+	terraformBinary = "terraform"
+	openTofuBinary  = "tofu"
+)
+
 // TerraformVersion is the version of Terraform CLI for the Terraform driver.
+// It is the version of the OpenTofu CLI when tasks are executed with OpenTofu.
 var TerraformVersion *goVersion.Version
 
-// InstallTerraform installs the Terraform binary to the configured path.
-// If an existing Terraform exists in the path, it is checked for compatibility.
+// cliProduct describes the CLI that is installed and executed by the Terraform
+// driver, which is either Terraform or OpenTofu.
+type cliProduct struct {
+	name          string
+	binary        string
+	constraint    goVersion.Constraints
+	constraintStr string
+
+	errUnsupportedVersion error
+	errIncompatibleBinary error
+}
+
+// newCLIProduct returns the CLI product of the driver configuration
+func newCLIProduct(conf *config.TerraformConfig) cliProduct {
+	if conf.IsOpenTofu() {
+		return cliProduct{
+			name:                  "OpenTofu",
+			binary:                openTofuBinary,
+			constraint:            ctsVersion.OpenTofuConstraint,
+			constraintStr:         ctsVersion.CompatibleOpenTofuVersionConstraint,
+			errUnsupportedVersion: errUnsupportedOpenTofuVersion,
+			errIncompatibleBinary: errIncompatibleOpenTofuBinary,
+		}
+	}
+
+	return cliProduct{
+		name:                  "Terraform",
+		binary:                terraformBinary,
+		constraint:            ctsVersion.TerraformConstraint,
+		constraintStr:         ctsVersion.CompatibleTerraformVersionConstraint,
+		errUnsupportedVersion: errUnsupportedTerraformVersion,
+		errIncompatibleBinary: errIncompatibleTerraformBinary,
+	}
+}
+
+// cliBinary returns the name of the CLI binary executed by the Terraform
+// driver
+func cliBinary(openTofu bool) string {
+	if openTofu {
+		return openTofuBinary
+	}
+	return terraformBinary
+}
+
+// InstallTerraform installs the Terraform binary to the configured path, or
+// the OpenTofu binary if the driver is configured for OpenTofu. If an
+// existing binary exists in the path, it is checked for compatibility.
 func InstallTerraform(ctx context.Context, conf *config.TerraformConfig) error {
 	path := *conf.Path
+	product := newCLIProduct(conf)
 
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	if isTFInstalled(path) {
+	if isTFInstalled(path, product.binary) {
 		tfVersion, compatible, err := verifyInstalledTF(ctx, conf)
 		if err != nil {
 			if strings.Contains(err.Error(), "exec format error") {
-				return errIncompatibleTerraformBinary
+				return product.errIncompatibleBinary
 			}
 			return err
 		}
@@ -51,54 +103,64 @@ func InstallTerraform(ctx context.Context, conf *config.TerraformConfig) error {
 		// Set the global variable to the installed version
 		TerraformVersion = tfVersion
 		if !compatible {
-			return errUnsupportedTerraformVersion
+			return product.errUnsupportedVersion
 		}
-		logger.Info("skipping install, terraform already exists",
+		logger.Info("skipping install, binary already exists", "binary", product.binary,
 			"tf_version", tfVersion.String(), "install_path", path)
 
 		return nil
 	}
 
-	logger.Info("install terraform", "install_path", path)
-	tfVersion, err := installTerraform(ctx, conf)
+	logger.Info("install binary", "binary", product.binary, "install_path", path)
+	var tfVersion *goVersion.Version
+	var err error
+	if conf.IsOpenTofu() {
+		tfVersion, err = installOpenTofu(ctx, conf)
+	} else {
+		tfVersion, err = installTerraform(ctx, conf)
+	}
 	if err != nil {
-		logger.Error("error installing terraform", "error", err)
+		logger.Error("error installing binary", "binary", product.binary, "error", err)
 		return err
 	}
-	logger.Info("successfully installed terraform")
+	logger.Info("successfully installed binary", "binary", product.binary)
 
 	// Set the global variable to the installed version
 	TerraformVersion = tfVersion
 	return nil
 }
 
-// isTFInstalled checks to see if terraform already exists at path.
-func isTFInstalled(tfPath string) bool {
-	tfPath = filepath.Join(tfPath, "terraform")
+// isTFInstalled checks to see if the binary, terraform or tofu, already exists
+// at path.
+func isTFInstalled(tfPath, binary string) bool {
+	tfPath = filepath.Join(tfPath, binary)
 
-	// Check if terraform exists in target path
+	// Check if the binary exists in target path
 	if _, err := os.Stat(tfPath); err == nil {
 		return true
 	}
 
-	// Check if terraform exists in $PATH to notify users about the new
+	// Check if the binary exists in $PATH to notify users about the new
 	// installation for CTS
-	path, err := exec.LookPath("terraform")
+	path, err := exec.LookPath(binary)
 	if err != nil {
 		return false
 	}
 
-	// have terraform at a different path
+	// have the binary at a different path
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	logger.Debug("an existing Terraform was found in another path", "install_path", path)
+	logger.Debug("an existing binary was found in another path", "binary", binary,
+		"install_path", path)
 	logger.Debug("continuing with new installation")
 	return false
 }
 
-// verifyInstalledTF checks if the installed Terraform is compatible with the
-// current architecture and is valid within CTS version constraints.
+// verifyInstalledTF checks if the installed Terraform, or OpenTofu, is
+// compatible with the current architecture and is valid within CTS version
+// constraints.
 func verifyInstalledTF(ctx context.Context, conf *config.TerraformConfig) (*goVersion.Version, bool, error) {
 	tfPath := *conf.Path
+	product := newCLIProduct(conf)
 
 	// NewTerraform requires an existing directory. This tfexec client is only
 	// used for validation, so we don't need to use the actual working dir for the task
@@ -109,22 +171,25 @@ func verifyInstalledTF(ctx context.Context, conf *config.TerraformConfig) (*goVe
 
 	// Verify version for existing terraform
 	logger := logging.Global().Named(logSystemName).Named(terraformSubsystemName)
-	tf, err := tfexec.NewTerraform(wd, filepath.Join(tfPath, "terraform"))
+	tf, err := tfexec.NewTerraform(wd, filepath.Join(tfPath, product.binary))
 	if err != nil {
-		logger.Error("unable to setup Terraform client", "terraform_path", tfPath, "error", err)
+		logger.Error("unable to setup Terraform client", "terraform_path", tfPath,
+			"binary", product.binary, "error", err)
 		return nil, false, err
 	}
 
+	// OpenTofu reports its version in the same format as Terraform
 	tfVersion, _, err := tf.Version(ctx, true)
 	if err != nil {
-		logger.Error("unable to verify Terraform version", "terraform_path", tfPath, "error", err)
+		logger.Error("unable to verify version", "terraform_path", tfPath,
+			"binary", product.binary, "error", err)
 		return nil, false, err
 	}
 
-	if !ctsVersion.TerraformConstraint.Check(tfVersion) {
-		logger.Error("found Terraform version does not satisfy the version constraint",
-			"terraform_path", tfPath, "version", tfVersion.String(),
-			"compatible_version_constraint", ctsVersion.CompatibleTerraformVersionConstraint)
+	if !product.constraint.Check(tfVersion) {
+		logger.Error("found version does not satisfy the version constraint",
+			"terraform_path", tfPath, "binary", product.binary, "version", tfVersion.String(),
+			"compatible_version_constraint", product.constraintStr)
 		return tfVersion, false, nil
 	}
 
@@ -133,8 +198,8 @@ func verifyInstalledTF(ctx context.Context, conf *config.TerraformConfig) (*goVe
 	}
 
 	if *conf.Version != "" && *conf.Version != tfVersion.String() {
-		logger.Warn("another terraform version was found that does not match the configured version",
-			"terraform_path", tfPath, "terraform_version", tfVersion.String(),
+		logger.Warn("another version was found that does not match the configured version",
+			"terraform_path", tfPath, "binary", product.binary, "terraform_version", tfVersion.String(),
 			"configured_version", *conf.Version)
 	}
 
@@ -4,11 +4,16 @@
 package driver
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsTFCompatible(t *testing.T) {
@@ -53,3 +58,45 @@ func TestIsTFCompatible(t *testing.T) {
 		})
 	}
 }
+
+func TestInstallTerraform_openTofuInstalled(t *testing.T) {
+	cases := []struct {
+		name        string
+		version     string
+		expectedErr error
+	}{
+		{
+			"supported",
+			"1.8.8",
+			nil,
+		}, {
+			"unsupported",
+			"1.5.7",
+			errUnsupportedOpenTofuVersion,
+		},
+	}
+
+	tfVersion := TerraformVersion
+	t.Cleanup(func() { TerraformVersion = tfVersion })
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Fake tofu binary that reports its version like OpenTofu
+			path := t.TempDir()
+			script := fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\": %q, "+
+				"\"platform\": \"linux_amd64\", \"provider_selections\": {}}'\n", tc.version)
+			err := os.WriteFile(filepath.Join(path, openTofuBinary), []byte(script), 0755)
+			require.NoError(t, err)
+
+			conf := &config.TerraformConfig{
+				Product: config.String(config.ProductOpenTofu),
+				Version: config.String(""),
+				Path:    config.String(path),
+				Backend: map[string]interface{}{},
+			}
+			err = InstallTerraform(context.Background(), conf)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.version, TerraformVersion.String())
+		})
+	}
+}
@@ -6,6 +6,9 @@ package driver
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -21,6 +24,8 @@ import (
 	"github.com/hashicorp/go-uuid"
 	goVersion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/hashicorp/terraform-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -112,10 +117,13 @@ func TestRenderTemplate(t *testing.T) {
 }
 
 func TestTerraform_Version(t *testing.T) {
+	var tf Terraform
+	TerraformVersion = nil
+	assert.Empty(t, tf.Version(), "Terraform is not installed")
+
 	var err error
 	TerraformVersion, err = goVersion.NewVersion("1.2")
 	require.NoError(t, err)
-	var tf Terraform
 	s := tf.Version()
 	assert.Equal(t, "1.2.0", s)
 }
@@ -148,14 +156,145 @@ func TestInspectTask(t *testing.T) {
 		}
 
 		ctx := context.Background()
-		w.On("Deregister", mock.Anything).Return()
-		c.On("Plan", ctx).Return(true, nil).Once()
+		c.On("InspectPlan", ctx).Return(&tfjson.Plan{
+			ResourceChanges: []*tfjson.ResourceChange{{
+				Address: "local_file.changed",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			}},
+		}, nil).Once()
 		c.On("SetStdout", mock.Anything).Twice()
+		w.On("Deregister", mock.Anything).Return().Once()
 
 		ctx = context.Background()
 		plan, err := tf.InspectTask(ctx)
 		assert.NoError(t, err)
 		require.Equal(t, "", plan.Plan)
+		assert.True(t, plan.ChangesPresent)
+		require.NotNil(t, plan.Summary)
+		assert.Equal(t, 1, plan.Summary.Change)
+		assert.Equal(t, []string{"local_file.changed"}, plan.Summary.Resources)
+
+		// the inspected task is not run, so its template is deregistered
+		w.AssertExpectations(t)
+	})
+
+	t.Run("client released", func(t *testing.T) {
+		var w mocksTmpl.Watcher
+		c := &destroyerClient{Client: new(mocks.Client)}
+		tf := Terraform{
+			task:    &Task{enabled: true},
+			logger:  logging.NewNullLogger(),
+			watcher: &w,
+			client:  c,
+		}
+
+		ctx := context.Background()
+		c.On("InspectPlan", ctx).Return(&tfjson.Plan{}, nil).Once()
+		c.On("SetStdout", mock.Anything).Twice()
+		w.On("Deregister", mock.Anything).Return().Once()
+
+		_, err := tf.InspectTask(ctx)
+		assert.NoError(t, err)
+
+		// the client is closed without destroying the task
+		assert.True(t, c.closed)
+		assert.False(t, c.destroyed)
+		w.AssertExpectations(t)
+	})
+}
+
+func TestInspectDrift(t *testing.T) {
+	t.Run("task disabled", func(t *testing.T) {
+		tf := Terraform{
+			task:   &Task{},
+			logger: logging.NewNullLogger(),
+		}
+		plan, err := tf.InspectDrift(context.Background())
+		assert.NoError(t, err)
+		assert.Contains(t, plan.Plan, "Task is disabled, drift inspection was skipped.")
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		var w mocksTmpl.Watcher
+		var c mocks.Client
+		tf := Terraform{
+			task: &Task{
+				enabled: true,
+			},
+			logger:  logging.NewNullLogger(),
+			watcher: &w,
+			client:  &c,
+		}
+
+		ctx := context.Background()
+		c.On("InspectDrift", ctx).Return(&tfjson.Plan{
+			ResourceDrift: []*tfjson.ResourceChange{{
+				Address: "local_file.drifted",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			}},
+			// changes of a rendered template that was not applied are not drift
+			ResourceChanges: []*tfjson.ResourceChange{{
+				Address: "local_file.changed",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			}},
+		}, nil).Once()
+		c.On("SetStdout", mock.Anything).Twice()
+
+		plan, err := tf.InspectDrift(ctx)
+		assert.NoError(t, err)
+		assert.True(t, plan.ChangesPresent)
+		require.NotNil(t, plan.Summary)
+		assert.Equal(t, 0, plan.Summary.Add)
+		assert.Equal(t, 1, plan.Summary.Change)
+		assert.Equal(t, []string{"local_file.drifted"}, plan.Summary.Resources)
+
+		// inspecting drift does not stop the task from being monitored
+		w.AssertNotCalled(t, "Deregister", mock.Anything)
+		c.AssertExpectations(t)
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		var c mocks.Client
+		tf := Terraform{
+			task: &Task{
+				enabled: true,
+			},
+			logger: logging.NewNullLogger(),
+			client: &c,
+		}
+
+		ctx := context.Background()
+		c.On("InspectDrift", ctx).Return(&tfjson.Plan{
+			ResourceChanges: []*tfjson.ResourceChange{{
+				Address: "local_file.changed",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			}},
+		}, nil).Once()
+		c.On("SetStdout", mock.Anything).Twice()
+
+		plan, err := tf.InspectDrift(ctx)
+		assert.NoError(t, err)
+		assert.False(t, plan.ChangesPresent)
+		require.NotNil(t, plan.Summary)
+		assert.Empty(t, plan.Summary.Resources)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var c mocks.Client
+		tf := Terraform{
+			task: &Task{
+				enabled: true,
+			},
+			logger: logging.NewNullLogger(),
+			client: &c,
+		}
+
+		ctx := context.Background()
+		c.On("InspectDrift", ctx).Return(nil, errors.New("plan error")).Once()
+		c.On("SetStdout", mock.Anything).Twice()
+
+		_, err := tf.InspectDrift(ctx)
+		assert.Error(t, err)
 	})
 }
 
@@ -197,7 +336,9 @@ func TestApplyTask(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			c := new(mocks.Client)
-			c.On("Apply", ctx).Return(tc.applyReturn).Once()
+			c.On("SetStdout", mock.Anything).Twice()
+			c.On("SavePlan", ctx).Return(&tfjson.Plan{}, nil).Once()
+			c.On("ApplyPlan", ctx).Return(tc.applyReturn).Once()
 
 			tf := &Terraform{
 				task:      &Task{name: "ApplyTaskTest", enabled: true, logger: logging.NewNullLogger()},
@@ -216,6 +357,103 @@ func TestApplyTask(t *testing.T) {
 	}
 }
 
+func TestPlanTask(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		plan          *tfjson.Plan
+		planErr       error
+		expectChanges bool
+		expectError   bool
+	}{
+		{
+			"changes",
+			&tfjson.Plan{
+				ResourceChanges: []*tfjson.ResourceChange{{
+					Address: "local_file.greeting",
+					Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+				}},
+			},
+			nil,
+			true,
+			false,
+		},
+		{
+			"no changes",
+			&tfjson.Plan{},
+			nil,
+			false,
+			false,
+		},
+		{
+			"error on plan",
+			nil,
+			errors.New("plan error"),
+			false,
+			true,
+		},
+	}
+	ctx := context.Background()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := new(mocks.Client)
+			c.On("SetStdout", mock.Anything).Twice()
+			c.On("SavePlan", ctx).Return(tc.plan, tc.planErr).Once()
+
+			tf := &Terraform{
+				task:   &Task{name: "PlanTaskTest", enabled: true, logger: logging.NewNullLogger()},
+				client: c,
+				logger: logging.NewNullLogger(),
+			}
+
+			changes, err := tf.PlanTask(ctx)
+			if !tc.expectError {
+				assert.NoError(t, err)
+				require.NotNil(t, tf.LastPlan())
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, tc.expectChanges, changes)
+			c.AssertNotCalled(t, "ApplyPlan", mock.Anything)
+		})
+	}
+}
+
+func TestApplyPlannedTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("applies saved plan", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("ApplyPlan", ctx).Return(nil).Once()
+
+		tf := &Terraform{
+			task:      &Task{name: "ApplyPlannedTaskTest", enabled: true, logger: logging.NewNullLogger()},
+			client:    c,
+			postApply: testHandler(false),
+			logger:    logging.NewNullLogger(),
+		}
+
+		assert.NoError(t, tf.ApplyPlannedTask(ctx))
+		c.AssertExpectations(t)
+		c.AssertNotCalled(t, "SavePlan", mock.Anything)
+	})
+
+	t.Run("disabled task", func(t *testing.T) {
+		c := new(mocks.Client)
+		tf := &Terraform{
+			task:   &Task{name: "ApplyPlannedTaskTest", enabled: false, logger: logging.NewNullLogger()},
+			client: c,
+			logger: logging.NewNullLogger(),
+		}
+
+		assert.NoError(t, tf.ApplyPlannedTask(ctx))
+		c.AssertNotCalled(t, "ApplyPlan", mock.Anything)
+	})
+}
+
 func TestUpdateTask(t *testing.T) {
 	t.Parallel()
 
@@ -306,11 +544,13 @@ func TestUpdateTask(t *testing.T) {
 
 			c := new(mocks.Client)
 			if tc.callInspect {
-				c.On("Plan", ctx).Return(true, nil).Once()
+				c.On("InspectPlan", ctx).Return(&tfjson.Plan{}, nil).Once()
 				c.On("SetStdout", mock.Anything).Twice()
 			}
 			if tc.callApply {
-				c.On("Apply", ctx).Return(nil).Once()
+				c.On("SetStdout", mock.Anything).Twice()
+				c.On("SavePlan", ctx).Return(&tfjson.Plan{}, nil).Once()
+				c.On("ApplyPlan", ctx).Return(nil).Once()
 			}
 
 			w := new(mocksTmpl.Watcher)
@@ -417,9 +657,10 @@ func TestUpdateTask(t *testing.T) {
 			c := new(mocks.Client)
 			c.On("Init", ctx).Return(nil).Once()
 			c.On("Validate", ctx).Return(nil).Once()
-			c.On("Plan", ctx).Return(true, tc.planErr).Once()
-			c.On("SetStdout", mock.Anything).Twice()
-			c.On("Apply", ctx).Return(tc.applyErr).Once()
+			c.On("InspectPlan", ctx).Return(&tfjson.Plan{}, tc.planErr).Once()
+			c.On("SetStdout", mock.Anything)
+			c.On("SavePlan", ctx).Return(&tfjson.Plan{}, nil).Once()
+			c.On("ApplyPlan", ctx).Return(tc.applyErr).Once()
 
 			w := new(mocksTmpl.Watcher)
 			w.On("Register", mock.Anything).Return(nil).Once()
@@ -490,7 +731,7 @@ func TestUpdateTask_Inspect(t *testing.T) {
 			c := new(mocks.Client)
 			c.On("Init", ctx).Return(nil).Once()
 			c.On("Validate", ctx).Return(nil).Once()
-			c.On("Plan", ctx).Return(true, nil)
+			c.On("InspectPlan", ctx).Return(&tfjson.Plan{}, nil)
 			c.On("SetStdout", mock.Anything)
 
 			w := new(mocksTmpl.Watcher)
@@ -853,6 +1094,37 @@ func TestTerraform_DestroyTask(t *testing.T) {
 	tf.DestroyTask(ctx)
 }
 
+// destroyerClient is a client that releases resources when it is closed or
+// when the task is destroyed
+type destroyerClient struct {
+	*mocks.Client
+	closed    bool
+	destroyed bool
+}
+
+func (c *destroyerClient) Close() {
+	c.closed = true
+}
+
+func (c *destroyerClient) Destroy(context.Context) error {
+	c.destroyed = true
+	return nil
+}
+
+func TestTerraform_DestroyTask_Client(t *testing.T) {
+	var w mocksTmpl.Watcher
+	c := &destroyerClient{Client: new(mocks.Client)}
+	tf := Terraform{
+		watcher: &w,
+		client:  c,
+	}
+
+	w.On("Deregister", mock.Anything).Return().Once()
+	tf.DestroyTask(context.Background())
+	assert.True(t, c.destroyed)
+	w.AssertExpectations(t)
+}
+
 func TestTerraform_TemplateIDs(t *testing.T) {
 	var tmpl mocksTmpl.Template
 	tf := Terraform{
@@ -901,6 +1173,45 @@ func TestGetServicesMetaData(t *testing.T) {
 				return sm
 			},
 		},
+		{
+			"meta-data configured in health-checks condition",
+			&Task{
+				condition: &config.HealthChecksConditionConfig{
+					HealthChecksMonitorConfig: config.HealthChecksMonitorConfig{
+						ServicesMonitorConfig: config.ServicesMonitorConfig{
+							CTSUserDefinedMeta: meta,
+						},
+					},
+				},
+			},
+			func() *tmplfunc.ServicesMeta {
+				sm := &tmplfunc.ServicesMeta{}
+				_ = sm.SetMeta(meta)
+				return sm
+			},
+		},
+		{
+			"meta-data configured in composite condition",
+			&Task{
+				condition: &config.CompositeConditionConfig{
+					CompositeMonitorConfig: config.CompositeMonitorConfig{
+						Conditions: config.ConditionConfigs{
+							&config.ConsulKVConditionConfig{},
+							&config.ServicesConditionConfig{
+								ServicesMonitorConfig: config.ServicesMonitorConfig{
+									CTSUserDefinedMeta: meta,
+								},
+							},
+						},
+					},
+				},
+			},
+			func() *tmplfunc.ServicesMeta {
+				sm := &tmplfunc.ServicesMeta{}
+				_ = sm.SetMeta(meta)
+				return sm
+			},
+		},
 		{
 			"meta-data configured in module_input",
 			&Task{
@@ -958,6 +1269,201 @@ func TestGetServicesMetaData(t *testing.T) {
 	}
 }
 
+func TestTerraform_TakeConditionsMet(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition config.ConditionConfig
+		expected  bool
+	}{
+		{
+			"composite and condition with schedule",
+			&config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Operator: config.String(config.CompositeOperatorAnd),
+					Conditions: config.ConditionConfigs{
+						&config.ConsulKVConditionConfig{},
+						&config.ScheduleConditionConfig{},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"composite or condition with schedule",
+			&config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Operator: config.String(config.CompositeOperatorOr),
+					Conditions: config.ConditionConfigs{
+						&config.ConsulKVConditionConfig{},
+						&config.ScheduleConditionConfig{},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"schedule condition",
+			&config.ScheduleConditionConfig{},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tf := &Terraform{task: &Task{condition: tc.condition}}
+			require.NoError(t, tf.setNotifier(new(mocksTmpl.Template)))
+			assert.Equal(t, tc.expected, tf.TakeConditionsMet())
+		})
+	}
+
+	t.Run("conditions met", func(t *testing.T) {
+		tf := &Terraform{task: &Task{
+			condition: &config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Operator: config.String(config.CompositeOperatorAnd),
+					Conditions: config.ConditionConfigs{
+						&config.ConsulKVConditionConfig{},
+						&config.ScheduleConditionConfig{},
+					},
+				},
+			},
+		}}
+		tmpl := new(mocksTmpl.Template)
+		tmpl.On("Notify", mock.Anything).Return(true)
+		require.NoError(t, tf.setNotifier(tmpl))
+		tf.onceNotifier.SetOnceDone()
+
+		tf.template.Notify([]*dep.KeyPair{})
+		assert.True(t, tf.TakeConditionsMet())
+		assert.False(t, tf.TakeConditionsMet())
+	})
+}
+
+func TestTerraform_setNotifier_ConsulKVPredicate(t *testing.T) {
+	tf := &Terraform{task: &Task{
+		condition: &config.ConsulKVConditionConfig{
+			ConsulKVMonitorConfig: config.ConsulKVMonitorConfig{
+				Path: config.String("config/feature-x"),
+			},
+			Predicate: &config.ConsulKVPredicateConfig{
+				Equals: config.String("enabled"),
+			},
+		},
+	}}
+	tmpl := new(mocksTmpl.Template)
+	tmpl.On("Notify", mock.Anything).Return(true)
+	require.NoError(t, tf.setNotifier(tmpl))
+	tf.onceNotifier.SetOnceDone()
+
+	pair := func(value string) *dep.KeyPair {
+		return &dep.KeyPair{Path: "config/feature-x", Value: value, Exists: true}
+	}
+	assert.False(t, tf.template.Notify(pair("disabled")))
+	assert.True(t, tf.template.Notify(pair("enabled")))
+	assert.False(t, tf.template.Notify(pair("enabled")))
+	tmpl.AssertNumberOfCalls(t, "Notify", 3)
+}
+
+func TestTerraform_RenderTemplate_SignificantFields(t *testing.T) {
+	tfvars := func(address, version string) []byte {
+		return []byte(fmt.Sprintf(`
+services = {
+  "api.node-a.default.dc1" = {
+    id      = "api"
+    name    = "api"
+    address = "%s"
+    meta    = {
+      version = "%s"
+    }
+  },
+}
+`, address, version))
+	}
+
+	r := new(mocksTmpl.Resolver)
+	tmpl := new(mocksTmpl.Template)
+	tmpl.On("Render", mock.Anything).Return(hcat.RenderResult{}, nil)
+
+	tf := &Terraform{
+		task: &Task{
+			name:    "SignificantFieldsTest",
+			enabled: true,
+			logger:  logging.NewNullLogger(),
+			condition: &config.ServicesConditionConfig{
+				ServicesMonitorConfig: config.ServicesMonitorConfig{
+					Names:             []string{"api"},
+					SignificantFields: []string{"address"},
+				},
+			},
+		},
+		resolver: r,
+		watcher:  new(mocksTmpl.Watcher),
+		logger:   logging.NewNullLogger(),
+	}
+	require.NoError(t, tf.setNotifier(tmpl))
+	require.NotNil(t, tf.significantFields,
+		"significant fields are resolved once with the notifier")
+
+	ctx := context.Background()
+	render := func(contents []byte) bool {
+		r.On("Run", mock.Anything, mock.Anything).Return(
+			hcat.ResolveEvent{Complete: true, Contents: contents}, nil).Once()
+		rendered, err := tf.RenderTemplate(ctx)
+		require.NoError(t, err)
+		return rendered
+	}
+
+	assert.True(t, render(tfvars("10.0.0.1", "v1")), "first render")
+	assert.False(t, render(tfvars("10.0.0.1", "v2")), "only meta changed")
+	assert.True(t, render(tfvars("10.0.0.2", "v2")), "address changed")
+	tmpl.AssertNumberOfCalls(t, "Render", 2)
+}
+
+func TestSignificantFieldsFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unconfigured", func(t *testing.T) {
+		task := &Task{
+			condition: &config.ServicesConditionConfig{
+				ServicesMonitorConfig: config.ServicesMonitorConfig{
+					Names: []string{"api"},
+				},
+			},
+		}
+		assert.Nil(t, significantFieldsFunc(task))
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		task := &Task{
+			condition: &config.CompositeConditionConfig{
+				CompositeMonitorConfig: config.CompositeMonitorConfig{
+					Conditions: config.ConditionConfigs{
+						&config.ServicesConditionConfig{
+							ServicesMonitorConfig: config.ServicesMonitorConfig{
+								Regexp:            config.String("^web"),
+								SignificantFields: []string{"address"},
+							},
+						},
+					},
+				},
+			},
+			moduleInputs: config.ModuleInputConfigs{
+				&config.ServicesModuleInputConfig{
+					ServicesMonitorConfig: config.ServicesMonitorConfig{
+						Names:             []string{"api"},
+						SignificantFields: []string{"port", "status"},
+					},
+				},
+			},
+		}
+		fields := significantFieldsFunc(task)
+		require.NotNil(t, fields)
+		assert.Equal(t, []string{"address"}, fields("web-v2"))
+		assert.Equal(t, []string{"port", "status"}, fields("api"))
+		assert.Nil(t, fields("db"))
+	})
+}
+
 // testHandler returns a fake handler that can return an error or not on Do()
 func testHandler(err bool) handler.Handler {
 	c := map[string]interface{}{
@@ -968,3 +1474,35 @@ func testHandler(err bool) handler.Handler {
 	h, _ := handler.NewFake(c)
 	return h
 }
+
+func TestVerifyPlugin(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	executable := filepath.Join(dir, "plugin")
+	require.NoError(t, os.WriteFile(executable, []byte("#!/bin/sh"), 0755))
+	file := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(file, []byte("text"), 0644))
+
+	cases := []struct {
+		name        string
+		path        string
+		expectError bool
+	}{
+		{"executable", executable, false},
+		{"not exist", filepath.Join(dir, "missing"), true},
+		{"directory", dir, true},
+		{"not executable", file, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyPlugin(tc.path)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -11,7 +11,7 @@
 # Task: my-task
 # Description: automate services for website X
 
-# Service definition protocol v0
+# Service definition protocol v1
 variable "services" {
   description = "Consul services monitored by Consul-Terraform-Sync"
   type = map(
@@ -24,6 +24,7 @@ variable "services" {
       meta      = map(string)
       tags      = list(string)
       namespace = string
+      peer      = string
       status    = string
 
       node                  = string
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metrics records the telemetry of the CTS daemon and its tasks and
+// exposes it in the Prometheus text format.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "cts"
+
+	// OutcomeSuccess and OutcomeFailure are the values of the outcome label
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+
+	// Values of the result label of Consul blocking queries
+	BlockingQuerySuccess = "success"
+	BlockingQueryError   = "error"
+	BlockingQueryTimeout = "timeout"
+)
+
+var (
+	taskRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "task_runs_total",
+		Help:      "Number of task runs by task and outcome.",
+	}, []string{"task_name", "outcome"})
+
+	taskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_run_duration_seconds",
+		Help:      "Duration of task runs by task and outcome.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200},
+	}, []string{"task_name", "outcome"})
+
+	templateRenderDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "template_render_duration_seconds",
+		Help:      "Duration of rendering the template of a task.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"task_name"})
+
+	bufferPeriodDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "buffer_period_delay_seconds",
+		Help: "Delay between a change being detected for a task and the " +
+			"task's template rendering once its buffer period elapsed.",
+		Buckets: []float64{1, 5, 10, 15, 30, 60, 120, 300},
+	}, []string{"task_name"})
+
+	consulBlockingQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "consul_blocking_queries_total",
+		Help:      "Number of Consul blocking queries made to watch dependencies by result.",
+	}, []string{"result"})
+
+	retryAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "retry_attempts_total",
+		Help:      "Number of retry attempts of failed operations by outcome.",
+	}, []string{"outcome"})
+
+	tasksDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "tasks"),
+		"Number of tasks by state.",
+		[]string{"state"}, nil,
+	)
+
+	registry = prometheus.NewRegistry()
+	tasks    = &tasksCollector{}
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		taskRuns,
+		taskRunDuration,
+		templateRenderDuration,
+		bufferPeriodDelay,
+		consulBlockingQueries,
+		retryAttempts,
+		tasks,
+	)
+}
+
+// Handler returns the handler that serves the metrics in the Prometheus text
+// format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveTaskRun records a run of a task and its duration. The run failed if
+// the error is not nil.
+func ObserveTaskRun(taskName string, err error, d time.Duration) {
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeFailure
+	}
+	taskRuns.WithLabelValues(taskName, outcome).Inc()
+	taskRunDuration.WithLabelValues(taskName, outcome).Observe(d.Seconds())
+}
+
+// ObserveTemplateRender records the duration of rendering a task's template
+func ObserveTemplateRender(taskName string, d time.Duration) {
+	templateRenderDuration.WithLabelValues(taskName).Observe(d.Seconds())
+}
+
+// ObserveBufferPeriodDelay records the delay between a change being detected
+// for a task and the task's template rendering after its buffer period
+func ObserveBufferPeriodDelay(taskName string, d time.Duration) {
+	bufferPeriodDelay.WithLabelValues(taskName).Observe(d.Seconds())
+}
+
+// IncConsulBlockingQuery counts a Consul blocking query by its result
+func IncConsulBlockingQuery(result string) {
+	consulBlockingQueries.WithLabelValues(result).Inc()
+}
+
+// IncRetryAttempt counts a retry attempt. The attempt failed if the error is
+// not nil.
+func IncRetryAttempt(err error) {
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeFailure
+	}
+	retryAttempts.WithLabelValues(outcome).Inc()
+}
+
+// DeleteTask removes the metrics of a deleted task
+func DeleteTask(taskName string) {
+	labels := prometheus.Labels{"task_name": taskName}
+	taskRuns.DeletePartialMatch(labels)
+	taskRunDuration.DeletePartialMatch(labels)
+	templateRenderDuration.DeletePartialMatch(labels)
+	bufferPeriodDelay.DeletePartialMatch(labels)
+}
+
+// SetTaskCounter sets the function that counts the enabled and disabled tasks
+// when the metrics are collected
+func SetTaskCounter(f func() (enabled, disabled int)) {
+	tasks.mu.Lock()
+	defer tasks.mu.Unlock()
+	tasks.count = f
+}
+
+// tasksCollector collects the number of tasks by state
+type tasksCollector struct {
+	mu    sync.RWMutex
+	count func() (enabled, disabled int)
+}
+
+// Describe implements prometheus.Collector
+func (c *tasksCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- tasksDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *tasksCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.count == nil {
+		return
+	}
+
+	enabled, disabled := c.count()
+	ch <- prometheus.MustNewConstMetric(tasksDesc, prometheus.GaugeValue,
+		float64(enabled), "enabled")
+	ch <- prometheus.MustNewConstMetric(tasksDesc, prometheus.GaugeValue,
+		float64(disabled), "disabled")
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	SetTaskCounter(func() (int, int) { return 2, 1 })
+	defer SetTaskCounter(nil)
+
+	ObserveTaskRun("metrics_task", nil, 2*time.Second)
+	ObserveTaskRun("metrics_task", errors.New("error"), time.Second)
+	ObserveTemplateRender("metrics_task", 10*time.Millisecond)
+	ObserveBufferPeriodDelay("metrics_task", 5*time.Second)
+	IncConsulBlockingQuery(BlockingQuerySuccess)
+	IncRetryAttempt(errors.New("error"))
+
+	body := scrape(t)
+	expected := []string{
+		`cts_task_runs_total{outcome="success",task_name="metrics_task"} 1`,
+		`cts_task_runs_total{outcome="failure",task_name="metrics_task"} 1`,
+		`cts_task_run_duration_seconds_count{outcome="success",task_name="metrics_task"} 1`,
+		`cts_template_render_duration_seconds_count{task_name="metrics_task"} 1`,
+		`cts_buffer_period_delay_seconds_count{task_name="metrics_task"} 1`,
+		`cts_consul_blocking_queries_total{result="success"}`,
+		`cts_retry_attempts_total{outcome="failure"}`,
+		`cts_tasks{state="enabled"} 2`,
+		`cts_tasks{state="disabled"} 1`,
+		`go_goroutines`,
+	}
+	for _, e := range expected {
+		assert.Contains(t, body, e)
+	}
+
+	t.Run("delete task", func(t *testing.T) {
+		DeleteTask("metrics_task")
+		assert.NotContains(t, scrape(t), `task_name="metrics_task"`)
+	})
+}
+
+func scrape(t *testing.T) string {
+	req := httptest.NewRequest(http.MethodGet, "/v1/metrics", nil)
+	resp := httptest.NewRecorder()
+	Handler().ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Header().Get("Content-Type"), "text/plain")
+
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(b)
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.3. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -16,6 +16,43 @@ type ClientWithResponsesInterface struct {
 	mock.Mock
 }
 
+// ApproveTaskPlanWithResponse provides a mock function with given fields: ctx, name, id, reqEditors
+func (_m *ClientWithResponsesInterface) ApproveTaskPlanWithResponse(ctx context.Context, name string, id string, reqEditors ...oapigen.RequestEditorFn) (*oapigen.ApproveTaskPlanResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveTaskPlanWithResponse")
+	}
+
+	var r0 *oapigen.ApproveTaskPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...oapigen.RequestEditorFn) (*oapigen.ApproveTaskPlanResponse, error)); ok {
+		return rf(ctx, name, id, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...oapigen.RequestEditorFn) *oapigen.ApproveTaskPlanResponse); ok {
+		r0 = rf(ctx, name, id, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.ApproveTaskPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, id, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTaskWithBodyWithResponse provides a mock function with given fields: ctx, params, contentType, body, reqEditors
 func (_m *ClientWithResponsesInterface) CreateTaskWithBodyWithResponse(ctx context.Context, params *oapigen.CreateTaskParams, contentType string, body io.Reader, reqEditors ...oapigen.RequestEditorFn) (*oapigen.CreateTaskResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -275,6 +312,117 @@ func (_m *ClientWithResponsesInterface) GetTaskByNameWithResponse(ctx context.Co
 	return r0, r1
 }
 
+// GetTaskEventsWithResponse provides a mock function with given fields: ctx, name, params, reqEditors
+func (_m *ClientWithResponsesInterface) GetTaskEventsWithResponse(ctx context.Context, name string, params *oapigen.GetTaskEventsParams, reqEditors ...oapigen.RequestEditorFn) (*oapigen.GetTaskEventsResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskEventsWithResponse")
+	}
+
+	var r0 *oapigen.GetTaskEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *oapigen.GetTaskEventsParams, ...oapigen.RequestEditorFn) (*oapigen.GetTaskEventsResponse, error)); ok {
+		return rf(ctx, name, params, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *oapigen.GetTaskEventsParams, ...oapigen.RequestEditorFn) *oapigen.GetTaskEventsResponse); ok {
+		r0 = rf(ctx, name, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.GetTaskEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *oapigen.GetTaskEventsParams, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskPlansWithResponse provides a mock function with given fields: ctx, name, reqEditors
+func (_m *ClientWithResponsesInterface) GetTaskPlansWithResponse(ctx context.Context, name string, reqEditors ...oapigen.RequestEditorFn) (*oapigen.GetTaskPlansResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskPlansWithResponse")
+	}
+
+	var r0 *oapigen.GetTaskPlansResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...oapigen.RequestEditorFn) (*oapigen.GetTaskPlansResponse, error)); ok {
+		return rf(ctx, name, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...oapigen.RequestEditorFn) *oapigen.GetTaskPlansResponse); ok {
+		r0 = rf(ctx, name, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.GetTaskPlansResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectTaskPlanWithResponse provides a mock function with given fields: ctx, name, id, reqEditors
+func (_m *ClientWithResponsesInterface) RejectTaskPlanWithResponse(ctx context.Context, name string, id string, reqEditors ...oapigen.RequestEditorFn) (*oapigen.RejectTaskPlanResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectTaskPlanWithResponse")
+	}
+
+	var r0 *oapigen.RejectTaskPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...oapigen.RequestEditorFn) (*oapigen.RejectTaskPlanResponse, error)); ok {
+		return rf(ctx, name, id, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...oapigen.RequestEditorFn) *oapigen.RejectTaskPlanResponse); ok {
+		r0 = rf(ctx, name, id, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oapigen.RejectTaskPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...oapigen.RequestEditorFn) error); ok {
+		r1 = rf(ctx, name, id, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewClientWithResponsesInterface creates a new instance of ClientWithResponsesInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientWithResponsesInterface(t interface {
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.3. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -7,6 +7,8 @@ import (
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 // Client is an autogenerated mock type for the Client type
@@ -32,6 +34,24 @@ func (_m *Client) Apply(ctx context.Context) error {
 	return r0
 }
 
+// ApplyPlan provides a mock function with given fields: ctx
+func (_m *Client) ApplyPlan(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GoString provides a mock function with no fields
 func (_m *Client) GoString() string {
 	ret := _m.Called()
@@ -68,6 +88,66 @@ func (_m *Client) Init(ctx context.Context) error {
 	return r0
 }
 
+// InspectDrift provides a mock function with given fields: ctx
+func (_m *Client) InspectDrift(ctx context.Context) (*tfjson.Plan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InspectDrift")
+	}
+
+	var r0 *tfjson.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*tfjson.Plan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *tfjson.Plan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InspectPlan provides a mock function with given fields: ctx
+func (_m *Client) InspectPlan(ctx context.Context) (*tfjson.Plan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InspectPlan")
+	}
+
+	var r0 *tfjson.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*tfjson.Plan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *tfjson.Plan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Plan provides a mock function with given fields: ctx
 func (_m *Client) Plan(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -96,6 +176,36 @@ func (_m *Client) Plan(ctx context.Context) (bool, error) {
 	return r0, r1
 }
 
+// SavePlan provides a mock function with given fields: ctx
+func (_m *Client) SavePlan(ctx context.Context) (*tfjson.Plan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePlan")
+	}
+
+	var r0 *tfjson.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*tfjson.Plan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *tfjson.Plan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetEnv provides a mock function with given fields: _a0
 func (_m *Client) SetEnv(_a0 map[string]string) error {
 	ret := _m.Called(_a0)
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.3. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -188,6 +188,126 @@ func (_c *ConsulClientInterface_GetLicense_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// KVDelete provides a mock function with given fields: ctx, key, q
+func (_m *ConsulClientInterface) KVDelete(ctx context.Context, key string, q *api.WriteOptions) (*api.WriteMeta, error) {
+	ret := _m.Called(ctx, key, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVDelete")
+	}
+
+	var r0 *api.WriteMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.WriteOptions) (*api.WriteMeta, error)); ok {
+		return rf(ctx, key, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.WriteOptions) *api.WriteMeta); ok {
+		r0 = rf(ctx, key, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.WriteMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.WriteOptions) error); ok {
+		r1 = rf(ctx, key, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsulClientInterface_KVDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KVDelete'
+type ConsulClientInterface_KVDelete_Call struct {
+	*mock.Call
+}
+
+// KVDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - q *api.WriteOptions
+func (_e *ConsulClientInterface_Expecter) KVDelete(ctx interface{}, key interface{}, q interface{}) *ConsulClientInterface_KVDelete_Call {
+	return &ConsulClientInterface_KVDelete_Call{Call: _e.mock.On("KVDelete", ctx, key, q)}
+}
+
+func (_c *ConsulClientInterface_KVDelete_Call) Run(run func(ctx context.Context, key string, q *api.WriteOptions)) *ConsulClientInterface_KVDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*api.WriteOptions))
+	})
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVDelete_Call) Return(_a0 *api.WriteMeta, _a1 error) *ConsulClientInterface_KVDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVDelete_Call) RunAndReturn(run func(context.Context, string, *api.WriteOptions) (*api.WriteMeta, error)) *ConsulClientInterface_KVDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KVDeleteTree provides a mock function with given fields: ctx, prefix, q
+func (_m *ConsulClientInterface) KVDeleteTree(ctx context.Context, prefix string, q *api.WriteOptions) (*api.WriteMeta, error) {
+	ret := _m.Called(ctx, prefix, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVDeleteTree")
+	}
+
+	var r0 *api.WriteMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.WriteOptions) (*api.WriteMeta, error)); ok {
+		return rf(ctx, prefix, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.WriteOptions) *api.WriteMeta); ok {
+		r0 = rf(ctx, prefix, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.WriteMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.WriteOptions) error); ok {
+		r1 = rf(ctx, prefix, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsulClientInterface_KVDeleteTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KVDeleteTree'
+type ConsulClientInterface_KVDeleteTree_Call struct {
+	*mock.Call
+}
+
+// KVDeleteTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - q *api.WriteOptions
+func (_e *ConsulClientInterface_Expecter) KVDeleteTree(ctx interface{}, prefix interface{}, q interface{}) *ConsulClientInterface_KVDeleteTree_Call {
+	return &ConsulClientInterface_KVDeleteTree_Call{Call: _e.mock.On("KVDeleteTree", ctx, prefix, q)}
+}
+
+func (_c *ConsulClientInterface_KVDeleteTree_Call) Run(run func(ctx context.Context, prefix string, q *api.WriteOptions)) *ConsulClientInterface_KVDeleteTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*api.WriteOptions))
+	})
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVDeleteTree_Call) Return(_a0 *api.WriteMeta, _a1 error) *ConsulClientInterface_KVDeleteTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVDeleteTree_Call) RunAndReturn(run func(context.Context, string, *api.WriteOptions) (*api.WriteMeta, error)) *ConsulClientInterface_KVDeleteTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // KVGet provides a mock function with given fields: ctx, key, q
 func (_m *ConsulClientInterface) KVGet(ctx context.Context, key string, q *api.QueryOptions) (*api.KVPair, *api.QueryMeta, error) {
 	ret := _m.Called(ctx, key, q)
@@ -257,6 +377,135 @@ func (_c *ConsulClientInterface_KVGet_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// KVList provides a mock function with given fields: ctx, prefix, q
+func (_m *ConsulClientInterface) KVList(ctx context.Context, prefix string, q *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error) {
+	ret := _m.Called(ctx, prefix, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVList")
+	}
+
+	var r0 api.KVPairs
+	var r1 *api.QueryMeta
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error)); ok {
+		return rf(ctx, prefix, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.QueryOptions) api.KVPairs); ok {
+		r0 = rf(ctx, prefix, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.KVPairs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.QueryOptions) *api.QueryMeta); ok {
+		r1 = rf(ctx, prefix, q)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*api.QueryMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *api.QueryOptions) error); ok {
+		r2 = rf(ctx, prefix, q)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ConsulClientInterface_KVList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KVList'
+type ConsulClientInterface_KVList_Call struct {
+	*mock.Call
+}
+
+// KVList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - q *api.QueryOptions
+func (_e *ConsulClientInterface_Expecter) KVList(ctx interface{}, prefix interface{}, q interface{}) *ConsulClientInterface_KVList_Call {
+	return &ConsulClientInterface_KVList_Call{Call: _e.mock.On("KVList", ctx, prefix, q)}
+}
+
+func (_c *ConsulClientInterface_KVList_Call) Run(run func(ctx context.Context, prefix string, q *api.QueryOptions)) *ConsulClientInterface_KVList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*api.QueryOptions))
+	})
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVList_Call) Return(_a0 api.KVPairs, _a1 *api.QueryMeta, _a2 error) *ConsulClientInterface_KVList_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVList_Call) RunAndReturn(run func(context.Context, string, *api.QueryOptions) (api.KVPairs, *api.QueryMeta, error)) *ConsulClientInterface_KVList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KVPut provides a mock function with given fields: ctx, p, q
+func (_m *ConsulClientInterface) KVPut(ctx context.Context, p *api.KVPair, q *api.WriteOptions) (*api.WriteMeta, error) {
+	ret := _m.Called(ctx, p, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVPut")
+	}
+
+	var r0 *api.WriteMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.KVPair, *api.WriteOptions) (*api.WriteMeta, error)); ok {
+		return rf(ctx, p, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.KVPair, *api.WriteOptions) *api.WriteMeta); ok {
+		r0 = rf(ctx, p, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.WriteMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.KVPair, *api.WriteOptions) error); ok {
+		r1 = rf(ctx, p, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsulClientInterface_KVPut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KVPut'
+type ConsulClientInterface_KVPut_Call struct {
+	*mock.Call
+}
+
+// KVPut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *api.KVPair
+//   - q *api.WriteOptions
+func (_e *ConsulClientInterface_Expecter) KVPut(ctx interface{}, p interface{}, q interface{}) *ConsulClientInterface_KVPut_Call {
+	return &ConsulClientInterface_KVPut_Call{Call: _e.mock.On("KVPut", ctx, p, q)}
+}
+
+func (_c *ConsulClientInterface_KVPut_Call) Run(run func(ctx context.Context, p *api.KVPair, q *api.WriteOptions)) *ConsulClientInterface_KVPut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*api.KVPair), args[2].(*api.WriteOptions))
+	})
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVPut_Call) Return(_a0 *api.WriteMeta, _a1 error) *ConsulClientInterface_KVPut_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsulClientInterface_KVPut_Call) RunAndReturn(run func(context.Context, *api.KVPair, *api.WriteOptions) (*api.WriteMeta, error)) *ConsulClientInterface_KVPut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Lock provides a mock function with given fields: l, stopCh
 func (_m *ConsulClientInterface) Lock(l *api.Lock, stopCh <-chan struct{}) (<-chan struct{}, error) {
 	ret := _m.Called(l, stopCh)
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.3. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -126,6 +126,43 @@ func (_m *TerraformExec) SetStdout(w io.Writer) {
 	_m.Called(w)
 }
 
+// ShowPlanFile provides a mock function with given fields: ctx, planPath, opts
+func (_m *TerraformExec) ShowPlanFile(ctx context.Context, planPath string, opts ...tfexec.ShowOption) (*tfjson.Plan, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, planPath)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShowPlanFile")
+	}
+
+	var r0 *tfjson.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...tfexec.ShowOption) (*tfjson.Plan, error)); ok {
+		return rf(ctx, planPath, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...tfexec.ShowOption) *tfjson.Plan); ok {
+		r0 = rf(ctx, planPath, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tfjson.Plan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...tfexec.ShowOption) error); ok {
+		r1 = rf(ctx, planPath, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validate provides a mock function with given fields: ctx
 func (_m *TerraformExec) Validate(ctx context.Context) (*tfjson.ValidateOutput, error) {
 	ret := _m.Called(ctx)
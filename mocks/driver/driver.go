@@ -14,6 +14,24 @@ type Driver struct {
 	mock.Mock
 }
 
+// ApplyPlannedTask provides a mock function with given fields: ctx
+func (_m *Driver) ApplyPlannedTask(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPlannedTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ApplyTask provides a mock function with given fields: ctx
 func (_m *Driver) ApplyTask(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -55,6 +73,34 @@ func (_m *Driver) InitTask(ctx context.Context) error {
 	return r0
 }
 
+// InspectDrift provides a mock function with given fields: ctx
+func (_m *Driver) InspectDrift(ctx context.Context) (driver.InspectPlan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InspectDrift")
+	}
+
+	var r0 driver.InspectPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (driver.InspectPlan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) driver.InspectPlan); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(driver.InspectPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InspectTask provides a mock function with given fields: ctx
 func (_m *Driver) InspectTask(ctx context.Context) (driver.InspectPlan, error) {
 	ret := _m.Called(ctx)
@@ -83,6 +129,34 @@ func (_m *Driver) InspectTask(ctx context.Context) (driver.InspectPlan, error) {
 	return r0, r1
 }
 
+// PlanTask provides a mock function with given fields: ctx
+func (_m *Driver) PlanTask(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanTask")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RenderTemplate provides a mock function with given fields: ctx
 func (_m *Driver) RenderTemplate(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package notification notifies webhooks when task runs finish.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+)
+
+const (
+	logSystemName = "notification"
+
+	// SignatureHeader is the header of the HMAC-SHA256 signature of the
+	// payload, formatted as "sha256=<hex digest>"
+	SignatureHeader = "X-CTS-Signature"
+
+	// defaultMaxRetry is the number of times a failed delivery is retried
+	defaultMaxRetry = 2
+
+	// defaultTimeout is the timeout of a single delivery attempt
+	defaultTimeout = 10 * time.Second
+)
+
+// Payload is the JSON body POSTed to the webhooks when a task run finishes
+type Payload struct {
+	TaskName  string        `json:"task_name"`
+	EventID   string        `json:"event_id"`
+	Type      string        `json:"type"`
+	Success   bool          `json:"success"`
+	Error     *event.Error  `json:"error"`
+	StartTime time.Time     `json:"start_time"`
+	EndTime   time.Time     `json:"end_time"`
+	Duration  float64       `json:"duration_seconds"`
+	Changes   *ChangeCounts `json:"changes"`
+}
+
+// ChangeCounts are the number of resources changed by the task run
+type ChangeCounts struct {
+	Add     int `json:"add"`
+	Change  int `json:"change"`
+	Destroy int `json:"destroy"`
+}
+
+// NewPayload returns the payload describing the task run of the event
+func NewPayload(ev event.Event) Payload {
+	p := Payload{
+		TaskName:  ev.TaskName,
+		EventID:   ev.ID,
+		Type:      ev.Type,
+		Success:   ev.Success,
+		Error:     ev.EventError,
+		StartTime: ev.StartTime,
+		EndTime:   ev.EndTime,
+		Duration:  ev.EndTime.Sub(ev.StartTime).Seconds(),
+	}
+	if p.Type == "" {
+		// events stored before event types were introduced are task runs
+		p.Type = event.TypeRun
+	}
+	if ev.Plan != nil {
+		p.Changes = &ChangeCounts{
+			Add:     ev.Plan.Add,
+			Change:  ev.Plan.Change,
+			Destroy: ev.Plan.Destroy,
+		}
+	}
+	return p
+}
+
+// Sign returns the HMAC-SHA256 signature of the body with the secret, as sent
+// in the SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Notifier delivers the notifications of finished task runs to webhooks
+type Notifier struct {
+	logger logging.Logger
+	client *http.Client
+
+	// newRetry returns the retry for a delivery. Each delivery has its own
+	// retry since deliveries are concurrent.
+	newRetry func() retry.Retry
+
+	wg sync.WaitGroup
+}
+
+// NewNotifier returns a new notifier
+func NewNotifier() *Notifier {
+	return &Notifier{
+		logger: logging.Global().Named(logSystemName),
+		client: &http.Client{Timeout: defaultTimeout},
+		newRetry: func() retry.Retry {
+			return retry.NewRetry(defaultMaxRetry, time.Now().UnixNano())
+		},
+	}
+}
+
+// Notify asynchronously notifies the webhooks of the configuration that the
+// task run of the event finished. Delivery errors are logged.
+func (n *Notifier) Notify(conf *config.NotificationConfig, ev event.Event) {
+	if !shouldNotify(conf, ev) {
+		return
+	}
+
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		if err := n.Send(context.Background(), conf, ev); err != nil {
+			n.logger.Error("error notifying webhooks of task run",
+				"task_name", ev.TaskName, "event_id", ev.ID, "error", err)
+		}
+	}()
+}
+
+// Wait blocks until the pending notifications are delivered or failed
+func (n *Notifier) Wait() {
+	n.wg.Wait()
+}
+
+// Send notifies the webhooks of the configuration that the task run of the
+// event finished. Each webhook is retried on failure. Returns the errors of the
+// webhooks that could not be notified.
+func (n *Notifier) Send(ctx context.Context, conf *config.NotificationConfig, ev event.Event) error {
+	if !shouldNotify(conf, ev) {
+		return nil
+	}
+
+	body, err := json.Marshal(NewPayload(ev))
+	if err != nil {
+		return err
+	}
+
+	var signature string
+	if secret := config.StringVal(conf.Secret); secret != "" {
+		signature = Sign(secret, body)
+	}
+
+	var errs []error
+	for _, url := range conf.URLs {
+		post := func(ctx context.Context) error {
+			return n.post(ctx, url, body, signature)
+		}
+		desc := fmt.Sprintf("notify %s of task %s", url, ev.TaskName)
+		if err := n.newRetry().Do(ctx, post, desc); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post delivers the body to a webhook. Client errors are not retried, apart
+// from too many requests.
+func (n *Notifier) post(ctx context.Context, url string, body []byte, signature string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &retry.NonRetryableError{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusTooManyRequests:
+		return &retry.NonRetryableError{
+			Err: fmt.Errorf("unexpected response status %s", resp.Status),
+		}
+	default:
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+}
+
+// shouldNotify returns whether the webhooks are notified of the event
+func shouldNotify(conf *config.NotificationConfig, ev event.Event) bool {
+	if conf == nil || len(conf.URLs) == 0 {
+		return false
+	}
+	return !ev.Success || !config.BoolVal(conf.FailuresOnly)
+}
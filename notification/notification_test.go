@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/retry"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWebhook is a webhook that records the requests it receives and responds
+// with the configured status codes in order
+type testWebhook struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newTestWebhook(t *testing.T, statuses ...int) (*testWebhook, *httptest.Server) {
+	wh := &testWebhook{statuses: statuses}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		wh.mu.Lock()
+		defer wh.mu.Unlock()
+		wh.requests = append(wh.requests, r)
+		wh.bodies = append(wh.bodies, body)
+
+		status := http.StatusOK
+		if len(wh.statuses) > 0 {
+			status = wh.statuses[0]
+			wh.statuses = wh.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return wh, srv
+}
+
+func newTestNotifier() *Notifier {
+	return &Notifier{
+		logger: logging.NewNullLogger(),
+		client: &http.Client{Timeout: time.Second},
+		newRetry: func() retry.Retry {
+			return retry.NewTestRetry(2)
+		},
+	}
+}
+
+func testEvent(success bool) event.Event {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ev := event.Event{
+		ID:        "01234567-89ab-cdef-0123-456789abcdef",
+		TaskName:  "task_a",
+		Success:   success,
+		StartTime: start,
+		EndTime:   start.Add(1500 * time.Millisecond),
+		Plan:      &event.Plan{Add: 1, Change: 2, Destroy: 3},
+	}
+	if !success {
+		ev.EventError = &event.Error{
+			Code:    event.ErrCodeTerraformApply,
+			Message: "apply error",
+		}
+	}
+	return ev
+}
+
+func TestNewPayload(t *testing.T) {
+	t.Parallel()
+
+	p := NewPayload(testEvent(false))
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	expected := `{
+		"task_name": "task_a",
+		"event_id": "01234567-89ab-cdef-0123-456789abcdef",
+		"type": "run",
+		"success": false,
+		"error": {"code": "terraform_apply_failure", "message": "apply error"},
+		"start_time": "2026-01-01T12:00:00Z",
+		"end_time": "2026-01-01T12:00:01.5Z",
+		"duration_seconds": 1.5,
+		"changes": {"add": 1, "change": 2, "destroy": 3}
+	}`
+	assert.JSONEq(t, expected, string(b))
+
+	t.Run("no plan", func(t *testing.T) {
+		ev := testEvent(true)
+		ev.Plan = nil
+		assert.Nil(t, NewPayload(ev).Changes)
+	})
+
+	t.Run("drift", func(t *testing.T) {
+		ev := testEvent(true)
+		ev.Type = event.TypeDrift
+		assert.Equal(t, event.TypeDrift, NewPayload(ev).Type)
+	})
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// echo -n 'body' | openssl dgst -sha256 -hmac 'secret'
+	assert.Equal(t,
+		"sha256=dc46983557fea127b43af721467eb9b3fde2338fe3e14f51952aa8478c13d355",
+		Sign("secret", []byte("body")))
+}
+
+func TestNotifier_Send(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		success       bool
+		failuresOnly  bool
+		statuses      []int
+		expectErr     bool
+		expectedCalls int
+	}{
+		{
+			"success",
+			true,
+			false,
+			nil,
+			false,
+			1,
+		},
+		{
+			"failures_only_skips_success",
+			true,
+			true,
+			nil,
+			false,
+			0,
+		},
+		{
+			"failures_only_sends_failure",
+			false,
+			true,
+			nil,
+			false,
+			1,
+		},
+		{
+			"retried_server_error",
+			true,
+			false,
+			[]int{http.StatusInternalServerError, http.StatusBadGateway},
+			false,
+			3,
+		},
+		{
+			"retries_exhausted",
+			true,
+			false,
+			[]int{http.StatusInternalServerError, http.StatusInternalServerError,
+				http.StatusInternalServerError},
+			true,
+			3,
+		},
+		{
+			"client_error_not_retried",
+			true,
+			false,
+			[]int{http.StatusBadRequest},
+			true,
+			1,
+		},
+		{
+			"too_many_requests_retried",
+			true,
+			false,
+			[]int{http.StatusTooManyRequests},
+			false,
+			2,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			wh, srv := newTestWebhook(t, tc.statuses...)
+			conf := &config.NotificationConfig{
+				URLs:         []string{srv.URL},
+				FailuresOnly: config.Bool(tc.failuresOnly),
+			}
+
+			n := newTestNotifier()
+			err := n.Send(context.Background(), conf, testEvent(tc.success))
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			wh.mu.Lock()
+			defer wh.mu.Unlock()
+			assert.Len(t, wh.requests, tc.expectedCalls)
+		})
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		wh, srv := newTestWebhook(t)
+		conf := &config.NotificationConfig{
+			URLs:   []string{srv.URL},
+			Secret: config.String("secret"),
+		}
+
+		n := newTestNotifier()
+		require.NoError(t, n.Send(context.Background(), conf, testEvent(true)))
+
+		wh.mu.Lock()
+		defer wh.mu.Unlock()
+		require.Len(t, wh.requests, 1)
+		r := wh.requests[0]
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, Sign("secret", wh.bodies[0]), r.Header.Get(SignatureHeader))
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		wh, srv := newTestWebhook(t)
+		conf := &config.NotificationConfig{URLs: []string{srv.URL}}
+
+		n := newTestNotifier()
+		require.NoError(t, n.Send(context.Background(), conf, testEvent(true)))
+
+		wh.mu.Lock()
+		defer wh.mu.Unlock()
+		require.Len(t, wh.requests, 1)
+		assert.Empty(t, wh.requests[0].Header.Get(SignatureHeader))
+	})
+
+	t.Run("multiple_urls", func(t *testing.T) {
+		wh1, srv1 := newTestWebhook(t, http.StatusBadRequest)
+		wh2, srv2 := newTestWebhook(t)
+		conf := &config.NotificationConfig{URLs: []string{srv1.URL, srv2.URL}}
+
+		n := newTestNotifier()
+		err := n.Send(context.Background(), conf, testEvent(true))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), srv1.URL)
+		assert.NotContains(t, err.Error(), srv2.URL)
+
+		wh1.mu.Lock()
+		defer wh1.mu.Unlock()
+		wh2.mu.Lock()
+		defer wh2.mu.Unlock()
+		assert.Len(t, wh1.requests, 1)
+		assert.Len(t, wh2.requests, 1)
+	})
+}
+
+func TestNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	wh, srv := newTestWebhook(t)
+	conf := &config.NotificationConfig{URLs: []string{srv.URL}}
+
+	n := newTestNotifier()
+	n.Notify(conf, testEvent(true))
+	n.Notify(nil, testEvent(true))
+	n.Wait()
+
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+	require.Len(t, wh.requests, 1)
+
+	var p Payload
+	require.NoError(t, json.Unmarshal(wh.bodies[0], &p))
+	assert.Equal(t, "task_a", p.TaskName)
+	assert.True(t, p.Success)
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// defaultStartTimeout is the time to wait for a plugin to complete the
+	// handshake after it is launched
+	defaultStartTimeout = time.Minute
+
+	// killTimeout is the time to wait for a plugin to exit after its stdin is
+	// closed before it is killed
+	killTimeout = 2 * time.Second
+)
+
+// ClientConfig configures the launch of a plugin
+type ClientConfig struct {
+	// Path is the path of the plugin executable
+	Path string
+
+	// Args are the arguments of the plugin executable
+	Args []string
+
+	// Env is the environment of the plugin, in addition to the environment
+	// of CTS
+	Env map[string]string
+
+	// StartTimeout is the time to wait for the plugin to complete the
+	// handshake. Defaults to 1 minute.
+	StartTimeout time.Duration
+}
+
+// Client manages the process of a plugin launched by CTS and the gRPC
+// connection to it.
+type Client struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	conn   *grpc.ClientConn
+	driver Driver
+	exited chan struct{}
+	logger logging.Logger
+}
+
+// Start launches the plugin, waits for the handshake of the plugin and
+// connects to it with mutual TLS. The certificates of CTS and the plugin are
+// generated for each launch.
+func Start(ctx context.Context, conf *ClientConfig) (*Client, error) {
+	logger := logging.Global().Named(logSystemName).With("plugin_path", conf.Path)
+
+	cert, certPEM, err := generateCert()
+	if err != nil {
+		return nil, fmt.Errorf("error generating the client certificate for "+
+			"plugin %s: %s", conf.Path, err)
+	}
+
+	cmd := exec.Command(conf.Path, conf.Args...)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", MagicCookieKey, MagicCookieValue),
+		fmt.Sprintf("%s=%s", ClientCertKey, certPEM))
+	for k, v := range conf.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error launching plugin %s: %s", conf.Path, err)
+	}
+	logger.Debug("launched plugin", "pid", cmd.Process.Pid)
+
+	c := &Client{
+		cmd:    cmd,
+		stdin:  stdin,
+		exited: make(chan struct{}),
+		logger: logger,
+	}
+
+	// Forward the plugin output to the CTS logs
+	go c.logOutput(stderr)
+	handshake := make(chan string, 1)
+	go func() {
+		r := bufio.NewReader(stdout)
+		line, err := r.ReadString('\n')
+		if err == nil {
+			handshake <- strings.TrimSpace(line)
+		}
+		c.logOutput(r)
+	}()
+
+	go func() {
+		err := cmd.Wait()
+		logger.Debug("plugin exited", "error", err)
+		close(c.exited)
+	}()
+
+	timeout := conf.StartTimeout
+	if timeout <= 0 {
+		timeout = defaultStartTimeout
+	}
+
+	var line string
+	select {
+	case line = <-handshake:
+	case <-c.exited:
+		return nil, fmt.Errorf("plugin %s exited before completing the handshake", conf.Path)
+	case <-time.After(timeout):
+		c.Kill()
+		return nil, fmt.Errorf("timed out waiting for the handshake of plugin %s", conf.Path)
+	case <-ctx.Done():
+		c.Kill()
+		return nil, ctx.Err()
+	}
+
+	target, serverCert, err := parseHandshake(line)
+	if err != nil {
+		c.Kill()
+		return nil, fmt.Errorf("error with the handshake of plugin %s: %s", conf.Path, err)
+	}
+
+	tlsConfig, err := clientTLSConfig(cert, serverCert)
+	if err != nil {
+		c.Kill()
+		return nil, fmt.Errorf("error with the certificate of plugin %s: %s", conf.Path, err)
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, target,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithBlock())
+	if err != nil {
+		c.Kill()
+		return nil, fmt.Errorf("error connecting to plugin %s: %s", conf.Path, err)
+	}
+
+	c.conn = conn
+	c.driver = &grpcClient{conn: conn}
+	return c, nil
+}
+
+// Driver returns the driver of the plugin
+func (c *Client) Driver() Driver {
+	return c.driver
+}
+
+// Exited returns whether the plugin process has exited
+func (c *Client) Exited() bool {
+	select {
+	case <-c.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kill closes the connection and stops the plugin. The plugin is given time
+// to exit gracefully after its stdin is closed before it is killed.
+func (c *Client) Kill() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	c.stdin.Close()
+
+	select {
+	case <-c.exited:
+		return
+	case <-time.After(killTimeout):
+	}
+
+	c.logger.Warn("plugin did not exit gracefully, killing plugin")
+	if err := c.cmd.Process.Kill(); err != nil {
+		c.logger.Error("error killing plugin", "error", err)
+	}
+	<-c.exited
+}
+
+func (c *Client) logOutput(r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		c.logger.Info(scanner.Text())
+	}
+}
+
+// parseHandshake parses the handshake line of a plugin and returns the gRPC
+// target and the PEM encoded certificate of the plugin
+func parseHandshake(line string) (string, []byte, error) {
+	parts := strings.Split(line, "|")
+	if len(parts) != 6 {
+		return "", nil, fmt.Errorf("unexpected handshake %q, expected the format "+
+			"CORE-PROTOCOL-VERSION|APP-PROTOCOL-VERSION|NETWORK|ADDRESS|PROTOCOL|CERTIFICATE", line)
+	}
+
+	if v, err := strconv.Atoi(parts[0]); err != nil || v != CoreProtocolVersion {
+		return "", nil, fmt.Errorf("unsupported core protocol version %q, expected %d",
+			parts[0], CoreProtocolVersion)
+	}
+
+	if v, err := strconv.Atoi(parts[1]); err != nil || v != ProtocolVersion {
+		return "", nil, fmt.Errorf("unsupported plugin protocol version %q, expected %d",
+			parts[1], ProtocolVersion)
+	}
+
+	if parts[4] != "grpc" {
+		return "", nil, fmt.Errorf("unsupported plugin protocol %q, expected grpc", parts[4])
+	}
+
+	cert, err := base64.StdEncoding.DecodeString(parts[5])
+	if err != nil || len(cert) == 0 {
+		return "", nil, fmt.Errorf("invalid plugin certificate %q, expected a "+
+			"base64 encoded PEM certificate", parts[5])
+	}
+
+	switch network, addr := parts[2], parts[3]; network {
+	case "tcp":
+		return addr, cert, nil
+	case "unix":
+		return "unix:" + addr, cert, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported network %q", network)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The service is described by hand instead of being generated from
+// plugin.proto since its messages are well-known types.
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*driverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InspectTask",
+			Handler: unaryHandler("InspectTask", func(s driverServer, ctx context.Context, in *structpb.Struct) (proto.Message, error) {
+				return s.inspectTask(ctx, in)
+			}),
+		},
+		{
+			MethodName: "ApplyTask",
+			Handler: unaryHandler("ApplyTask", func(s driverServer, ctx context.Context, in *structpb.Struct) (proto.Message, error) {
+				return s.applyTask(ctx, in)
+			}),
+		},
+		{
+			MethodName: "DestroyTask",
+			Handler: unaryHandler("DestroyTask", func(s driverServer, ctx context.Context, in *structpb.Struct) (proto.Message, error) {
+				return s.destroyTask(ctx, in)
+			}),
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin/plugin.proto",
+}
+
+// driverServer is the server side of the Driver service
+type driverServer interface {
+	inspectTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	applyTask(ctx context.Context, in *structpb.Struct) (*emptypb.Empty, error)
+	destroyTask(ctx context.Context, in *structpb.Struct) (*emptypb.Empty, error)
+}
+
+func unaryHandler(method string, fn func(driverServer, context.Context, *structpb.Struct) (proto.Message, error)) func(
+	interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(structpb.Struct)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return fn(srv.(driverServer), ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fmt.Sprintf("/%s/%s", ServiceName, method),
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return fn(srv.(driverServer), ctx, req.(*structpb.Struct))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// grpcServer serves a Driver implementation with gRPC
+type grpcServer struct {
+	impl Driver
+}
+
+func (s *grpcServer) inspectTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	var req TaskRequest
+	if err := fromStruct(in, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.impl.InspectTask(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		resp = &InspectResponse{}
+	}
+	return toStruct(resp)
+}
+
+func (s *grpcServer) applyTask(ctx context.Context, in *structpb.Struct) (*emptypb.Empty, error) {
+	var req TaskRequest
+	if err := fromStruct(in, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.impl.ApplyTask(ctx, &req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcServer) destroyTask(ctx context.Context, in *structpb.Struct) (*emptypb.Empty, error) {
+	var req DestroyRequest
+	if err := fromStruct(in, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.impl.DestroyTask(ctx, &req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// grpcClient is a Driver that calls a plugin with gRPC
+type grpcClient struct {
+	conn *grpc.ClientConn
+}
+
+var _ Driver = (*grpcClient)(nil)
+
+// InspectTask calls the InspectTask method of the plugin
+func (c *grpcClient) InspectTask(ctx context.Context, req *TaskRequest) (*InspectResponse, error) {
+	in, err := toStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(structpb.Struct)
+	if err := c.invoke(ctx, "InspectTask", in, out); err != nil {
+		return nil, err
+	}
+
+	var resp InspectResponse
+	if err := fromStruct(out, &resp); err != nil {
+		return nil, fmt.Errorf("invalid InspectTask response from plugin: %s", err)
+	}
+	return &resp, nil
+}
+
+// ApplyTask calls the ApplyTask method of the plugin
+func (c *grpcClient) ApplyTask(ctx context.Context, req *TaskRequest) error {
+	in, err := toStruct(req)
+	if err != nil {
+		return err
+	}
+	return c.invoke(ctx, "ApplyTask", in, new(emptypb.Empty))
+}
+
+// DestroyTask calls the DestroyTask method of the plugin
+func (c *grpcClient) DestroyTask(ctx context.Context, req *DestroyRequest) error {
+	in, err := toStruct(req)
+	if err != nil {
+		return err
+	}
+	return c.invoke(ctx, "DestroyTask", in, new(emptypb.Empty))
+}
+
+func (c *grpcClient) invoke(ctx context.Context, method string, in, out proto.Message) error {
+	err := c.conn.Invoke(ctx, fmt.Sprintf("/%s/%s", ServiceName, method), in, out)
+	if s, ok := status.FromError(err); ok && err != nil {
+		// Return the plugin's error message without the gRPC details
+		return fmt.Errorf("plugin %s: %s", method, s.Message())
+	}
+	return err
+}
+
+// toStruct converts a value to a Struct with the JSON structure of the value
+func toStruct(v interface{}) (*structpb.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(m)
+}
+
+// fromStruct converts a Struct to a value with the JSON structure of the
+// Struct
+func fromStruct(s *structpb.Struct, v interface{}) error {
+	b, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plugin implements the protocol between CTS and out-of-process
+// plugin drivers, and the functions to serve a plugin driver written in Go.
+//
+// A plugin is an executable that CTS launches as a subprocess for each task.
+// The protocol follows the handshake of hashicorp/go-plugin with automatic
+// mutual TLS: CTS sets the MagicCookieKey environment variable to
+// MagicCookieValue and the ClientCertKey environment variable to a PEM
+// certificate generated for the launch. The plugin generates its own
+// certificate, listens on a local address and writes a single handshake line
+// to stdout:
+//
+//	CORE-PROTOCOL-VERSION|APP-PROTOCOL-VERSION|NETWORK|ADDRESS|PROTOCOL|CERTIFICATE
+//
+// e.g. "1|1|tcp|127.0.0.1:5000|grpc|LS0tLS1CRUdJTi...", where CERTIFICATE is
+// the base64 encoded PEM certificate of the plugin. CTS then connects to the
+// address with gRPC over TLS and calls the Driver service defined in
+// plugin.proto. Each side only trusts the certificate of the other, so other
+// local processes cannot call the plugin. The messages of
+// the service are google.protobuf.Struct values with the JSON structure of
+// the request and response types of this package, so that plugins can be
+// written in any language with gRPC support. Plugins should exit when their
+// stdin is closed, which happens when CTS exits.
+package plugin
+
+import (
+	"context"
+)
+
+const (
+	logSystemName = "plugin"
+
+	// MagicCookieKey is the environment variable set by CTS when launching a
+	// plugin. Plugins verify it to provide a basic check that they are
+	// launched by CTS and not executed directly.
+	MagicCookieKey = "CTS_PLUGIN_MAGIC_COOKIE"
+
+	// MagicCookieValue is the value of the MagicCookieKey environment
+	// variable
+	MagicCookieValue = "d9c5a2a3c1a8476c9c3a53a0b3b1d0f5"
+
+	// ClientCertKey is the environment variable with the PEM certificate
+	// that CTS authenticates with when calling a plugin. Plugins must only
+	// accept TLS connections with this client certificate.
+	ClientCertKey = "CTS_PLUGIN_CLIENT_CERT"
+
+	// CoreProtocolVersion is the version of the handshake protocol
+	CoreProtocolVersion = 1
+
+	// ProtocolVersion is the version of the Driver service
+	ProtocolVersion = 1
+
+	// ServiceName is the full name of the gRPC service implemented by plugins
+	ServiceName = "consul_terraform_sync.plugin.v1.Driver"
+)
+
+// Driver is the interface implemented by plugin drivers. CTS renders the
+// module inputs of the task and calls the driver to inspect and apply the
+// changes of the task to the infrastructure.
+type Driver interface {
+	// InspectTask plans the changes of the task without applying them
+	InspectTask(ctx context.Context, req *TaskRequest) (*InspectResponse, error)
+
+	// ApplyTask applies the changes of the task
+	ApplyTask(ctx context.Context, req *TaskRequest) error
+
+	// DestroyTask cleans up the plugin's resources for a task that is
+	// deleted from CTS
+	DestroyTask(ctx context.Context, req *DestroyRequest) error
+}
+
+// Task is the information about the task of a request
+type Task struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Module      string   `json:"module"`
+	Version     string   `json:"version"`
+	Providers   []string `json:"providers"`
+}
+
+// TaskRequest is the request to inspect or apply a task
+type TaskRequest struct {
+	Task Task `json:"task"`
+
+	// Inputs are the rendered module inputs of the task by variable name,
+	// e.g. the "services" variable for tasks monitoring services
+	Inputs map[string]interface{} `json:"inputs"`
+
+	// Variables are the module variables configured for the task
+	Variables map[string]interface{} `json:"variables"`
+
+	// Providers are the provider configurations of the task by provider name
+	Providers map[string]interface{} `json:"providers"`
+}
+
+// DestroyRequest is the request to clean up the resources of a task
+type DestroyRequest struct {
+	Task Task `json:"task"`
+}
+
+// Resource change actions of an InspectResponse
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionReplace = "replace"
+)
+
+// InspectResponse is the response of inspecting a task
+type InspectResponse struct {
+	// Plan is the human-readable output of the inspection
+	Plan string `json:"plan"`
+
+	// ResourceChanges are the resources that the task changes when applied.
+	// The task has no changes if it is empty.
+	ResourceChanges []ResourceChange `json:"resource_changes"`
+}
+
+// ResourceChange is a change of a resource of the infrastructure
+type ResourceChange struct {
+	// Address identifies the resource
+	Address string `json:"address"`
+
+	// Action is the change to the resource: create, update, delete or replace
+	Action string `json:"action"`
+}
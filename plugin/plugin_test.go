@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// servePluginEnv is set to serve the test binary as a plugin
+const servePluginEnv = "CTS_TEST_SERVE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginEnv) != "" {
+		Serve(&fakeDriver{})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fakeDriver plans a change for each service of the services input
+type fakeDriver struct{}
+
+func (d *fakeDriver) InspectTask(_ context.Context, req *TaskRequest) (*InspectResponse, error) {
+	services, _ := req.Inputs["services"].(map[string]interface{})
+	resp := &InspectResponse{Plan: "task " + req.Task.Name}
+	for id := range services {
+		resp.ResourceChanges = append(resp.ResourceChanges, ResourceChange{
+			Address: id,
+			Action:  ActionCreate,
+		})
+	}
+	return resp, nil
+}
+
+func (d *fakeDriver) ApplyTask(_ context.Context, req *TaskRequest) error {
+	if req.Variables["fail"] == true {
+		return errors.New("apply failed")
+	}
+	return nil
+}
+
+func (d *fakeDriver) DestroyTask(_ context.Context, req *DestroyRequest) error {
+	if req.Task.Name == "" {
+		return errors.New("missing task name")
+	}
+	return nil
+}
+
+func TestStart(t *testing.T) {
+	c, err := Start(context.Background(), &ClientConfig{
+		Path: os.Args[0],
+		Env:  map[string]string{servePluginEnv: "true"},
+	})
+	require.NoError(t, err)
+	defer c.Kill()
+
+	ctx := context.Background()
+	req := &TaskRequest{
+		Task: Task{Name: "task", Providers: []string{"local"}},
+		Inputs: map[string]interface{}{
+			"services": map[string]interface{}{
+				"api.node.dc1": map[string]interface{}{"port": 8080},
+			},
+		},
+		Variables: map[string]interface{}{},
+	}
+
+	t.Run("inspect", func(t *testing.T) {
+		resp, err := c.Driver().InspectTask(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, &InspectResponse{
+			Plan: "task task",
+			ResourceChanges: []ResourceChange{
+				{Address: "api.node.dc1", Action: ActionCreate},
+			},
+		}, resp)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		assert.NoError(t, c.Driver().ApplyTask(ctx, req))
+
+		failReq := *req
+		failReq.Variables = map[string]interface{}{"fail": true}
+		err := c.Driver().ApplyTask(ctx, &failReq)
+		require.Error(t, err)
+		assert.Equal(t, "plugin ApplyTask: apply failed", err.Error())
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+		assert.NoError(t, c.Driver().DestroyTask(ctx, &DestroyRequest{Task: req.Task}))
+		assert.Error(t, c.Driver().DestroyTask(ctx, &DestroyRequest{}))
+	})
+
+	t.Run("kill", func(t *testing.T) {
+		assert.False(t, c.Exited())
+		c.Kill()
+		assert.True(t, c.Exited())
+	})
+}
+
+func TestStart_Errors(t *testing.T) {
+	t.Run("missing executable", func(t *testing.T) {
+		_, err := Start(context.Background(), &ClientConfig{
+			Path: "/path/does/not/exist",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("not a plugin", func(t *testing.T) {
+		// The test binary runs no tests and exits without a handshake
+		_, err := Start(context.Background(), &ClientConfig{
+			Path:         os.Args[0],
+			Args:         []string{"-test.run=^$"},
+			StartTimeout: 10 * time.Second,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseHandshake(t *testing.T) {
+	cert := base64.StdEncoding.EncodeToString([]byte("cert"))
+
+	cases := []struct {
+		name     string
+		line     string
+		expected string
+		isValid  bool
+	}{
+		{
+			"tcp",
+			"1|1|tcp|127.0.0.1:5000|grpc|" + cert,
+			"127.0.0.1:5000",
+			true,
+		}, {
+			"unix",
+			"1|1|unix|/tmp/plugin.sock|grpc|" + cert,
+			"unix:/tmp/plugin.sock",
+			true,
+		}, {
+			"invalid format",
+			"127.0.0.1:5000",
+			"",
+			false,
+		}, {
+			"missing certificate",
+			"1|1|tcp|127.0.0.1:5000|grpc",
+			"",
+			false,
+		}, {
+			"invalid certificate",
+			"1|1|tcp|127.0.0.1:5000|grpc|not-base64!",
+			"",
+			false,
+		}, {
+			"unsupported core protocol version",
+			"2|1|tcp|127.0.0.1:5000|grpc|" + cert,
+			"",
+			false,
+		}, {
+			"unsupported protocol version",
+			"1|2|tcp|127.0.0.1:5000|grpc|" + cert,
+			"",
+			false,
+		}, {
+			"unsupported protocol",
+			"1|1|tcp|127.0.0.1:5000|netrpc|" + cert,
+			"",
+			false,
+		}, {
+			"unsupported network",
+			"1|1|udp|127.0.0.1:5000|grpc|" + cert,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			target, serverCert, err := parseHandshake(tc.line)
+			if !tc.isValid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, target)
+			assert.Equal(t, []byte("cert"), serverCert)
+		})
+	}
+}
+
+func TestServe_Authentication(t *testing.T) {
+	t.Run("missing client certificate", func(t *testing.T) {
+		err := serve(&fakeDriver{}, nil, strings.NewReader(""), io.Discard)
+		assert.Error(t, err)
+	})
+
+	// Serve the driver with the certificate of one client
+	cert, certPEM, err := generateCert()
+	require.NoError(t, err)
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	go serve(&fakeDriver{}, certPEM, stdinR, stdoutW)
+	defer stdinW.Close()
+
+	line, err := bufio.NewReader(stdoutR).ReadString('\n')
+	require.NoError(t, err)
+	target, serverCert, err := parseHandshake(strings.TrimSpace(line))
+	require.NoError(t, err)
+
+	invoke := func(cert tls.Certificate) error {
+		tlsConfig, err := clientTLSConfig(cert, serverCert)
+		require.NoError(t, err)
+		conn, err := grpc.Dial(target,
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		c := &grpcClient{conn: conn}
+		return c.DestroyTask(ctx, &DestroyRequest{Task: Task{Name: "task"}})
+	}
+
+	t.Run("client certificate", func(t *testing.T) {
+		assert.NoError(t, invoke(cert))
+	})
+
+	t.Run("other certificate", func(t *testing.T) {
+		other, _, err := generateCert()
+		require.NoError(t, err)
+		assert.Error(t, invoke(other))
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		assert.Error(t, invoke(tls.Certificate{}))
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Serve serves the plugin driver and blocks until CTS closes the stdin of the
+// plugin. It is called by the main function of a plugin written in Go. Serve
+// exits the process if the plugin is not launched by CTS.
+func Serve(d Driver) {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		fmt.Fprintln(os.Stderr, "This binary is a plugin for Consul-Terraform-Sync. "+
+			"It is not meant to be executed directly, configure it as the "+
+			"path of the plugin driver instead.")
+		os.Exit(1)
+	}
+
+	clientCert := []byte(os.Getenv(ClientCertKey))
+	if err := serve(d, clientCert, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error serving plugin: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// serve listens on a local address, writes the handshake to stdout and serves
+// the driver with gRPC over mutual TLS until stdin is closed. Only
+// connections authenticated with the PEM encoded client certificate are
+// accepted.
+func serve(d Driver, clientCert []byte, stdin io.Reader, stdout io.Writer) error {
+	if len(clientCert) == 0 {
+		return fmt.Errorf("missing the client certificate of CTS, "+
+			"the %s environment variable is not set", ClientCertKey)
+	}
+
+	cert, certPEM, err := generateCert()
+	if err != nil {
+		return fmt.Errorf("error generating the plugin certificate: %s", err)
+	}
+	tlsConfig, err := serverTLSConfig(cert, clientCert)
+	if err != nil {
+		return fmt.Errorf("error with the client certificate of CTS: %s", err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	server.RegisterService(&serviceDesc, &grpcServer{impl: d})
+
+	// Stop serving when CTS exits or kills the plugin
+	go func() {
+		io.Copy(io.Discard, stdin)
+		server.Stop()
+	}()
+
+	_, err = fmt.Fprintf(stdout, "%d|%d|%s|%s|grpc|%s\n", CoreProtocolVersion,
+		ProtocolVersion, ln.Addr().Network(), ln.Addr().String(),
+		base64.StdEncoding.EncodeToString(certPEM))
+	if err != nil {
+		ln.Close()
+		return err
+	}
+
+	return server.Serve(ln)
+}
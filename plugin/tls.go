@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"time"
+)
+
+// certHost is the host name of the certificates of CTS and plugins
+const certHost = "localhost"
+
+// generateCert generates a self-signed certificate for one launch of a
+// plugin. Returns the certificate and its PEM encoding.
+func generateCert() (tls.Certificate, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   certHost,
+			Organization: []string{"Consul-Terraform-Sync"},
+		},
+		DNSNames:    []string{certHost},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:   now.Add(-30 * time.Second),
+		NotAfter:    now.Add(262980 * time.Hour), // 30 years
+		KeyUsage: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment |
+			x509.KeyUsageCertSign,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageClientAuth,
+			x509.ExtKeyUsageServerAuth,
+		},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	cert := tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return cert, certPEM, nil
+}
+
+// certPool returns a pool that only trusts the PEM encoded certificate
+func certPool(certPEM []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, errors.New("invalid certificate")
+	}
+	return pool, nil
+}
+
+// clientTLSConfig returns the TLS configuration of CTS to connect to a
+// plugin. CTS authenticates with its certificate and only trusts the
+// certificate of the plugin from the handshake.
+func clientTLSConfig(cert tls.Certificate, serverCertPEM []byte) (*tls.Config, error) {
+	pool, err := certPool(serverCertPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   certHost,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// serverTLSConfig returns the TLS configuration of a plugin. The plugin
+// only accepts connections authenticated with the certificate of CTS.
+func serverTLSConfig(cert tls.Certificate, clientCertPEM []byte) (*tls.Config, error) {
+	pool, err := certPool(clientCertPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"sync"
 
 	"github.com/hashicorp/consul-terraform-sync/client"
 	"github.com/hashicorp/consul-terraform-sync/config"
@@ -43,6 +44,11 @@ type ServiceRegistrationManager struct {
 	client  client.ConsulClientInterface
 	service *service
 
+	// mu guards the service tags and registered status, which can change
+	// after the manager has started
+	mu         sync.Mutex
+	registered bool
+
 	logger logging.Logger
 }
 
@@ -125,8 +131,29 @@ func (m *ServiceRegistrationManager) Start(ctx context.Context) error {
 	return ctx.Err()
 }
 
+// SetTags replaces the user configured tags of the CTS service with the given
+// tags. The default tags are retained. If CTS is already registered as a
+// service, then it is re-registered with Consul to update the tags.
+func (m *ServiceRegistrationManager) SetTags(ctx context.Context, tags []string) error {
+	m.mu.Lock()
+	newTags := make([]string, 0, len(defaultServiceTags)+len(tags))
+	newTags = append(newTags, defaultServiceTags...)
+	newTags = append(newTags, tags...)
+	m.service.tags = newTags
+	registered := m.registered
+	m.mu.Unlock()
+
+	if !registered {
+		return nil
+	}
+	return m.register(ctx)
+}
+
 // register registers Consul-Terraform-Sync with Consul
 func (m *ServiceRegistrationManager) register(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	s := m.service
 	logger := m.logger.With("service_name", m.service.name, "id", m.service.id)
 	r := &consulapi.AgentServiceRegistration{
@@ -156,6 +183,7 @@ func (m *ServiceRegistrationManager) register(ctx context.Context) error {
 
 		return err
 	}
+	m.registered = true
 
 	logger.Info("Consul-Terraform-Sync registered as a service with Consul")
 	logger.Info("to view registered services, navigate to the Services section in the Consul UI")
@@ -167,6 +195,9 @@ func (m *ServiceRegistrationManager) deregister(ctx context.Context) error {
 	logger := m.logger.With("service_name", m.service.name, "id", m.service.id)
 	logger.Info("deregistering Consul-Terraform-Sync from Consul")
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	q := &consulapi.QueryOptions{}
 	if m.service.namespace != "" {
 		q.Namespace = m.service.namespace
@@ -187,6 +218,7 @@ func (m *ServiceRegistrationManager) deregister(ctx context.Context) error {
 
 		return err
 	}
+	m.registered = false
 
 	logger.Info("Consul-Terraform-Sync deregistered from Consul")
 	return nil
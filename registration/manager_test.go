@@ -439,3 +439,46 @@ func TestServiceRegistrationManager_register(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceRegistrationManager_SetTags(t *testing.T) {
+	t.Parallel()
+
+	newManager := func(c *mocks.ConsulClientInterface) *ServiceRegistrationManager {
+		return &ServiceRegistrationManager{
+			client: c,
+			service: &service{
+				name: "cts-service",
+				id:   "cts-123",
+				tags: append([]string{}, defaultServiceTags...),
+			},
+			logger: logging.NewNullLogger(),
+		}
+	}
+	expectedTags := []string{"cts", "leader"}
+
+	t.Run("not_registered", func(t *testing.T) {
+		mockClient := new(mocks.ConsulClientInterface)
+		m := newManager(mockClient)
+
+		err := m.SetTags(context.Background(), []string{"leader"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedTags, m.service.tags)
+
+		// not re-registered
+		mockClient.AssertNotCalled(t, "RegisterService", mock.Anything, mock.Anything)
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		mockClient := new(mocks.ConsulClientInterface)
+		mockClient.On("RegisterService", mock.Anything,
+			mock.MatchedBy(func(r *consulapi.AgentServiceRegistration) bool {
+				return assert.ObjectsAreEqual(expectedTags, r.Tags)
+			})).Return(nil).Once()
+		m := newManager(mockClient)
+		m.registered = true
+
+		err := m.SetTags(context.Background(), []string{"leader"})
+		require.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/metrics"
 	"github.com/pkg/errors"
 )
 
@@ -90,7 +91,7 @@ func (r Retry) Do(ctx context.Context, f func(context.Context) error, desc strin
 		case <-ctx.Done():
 			logger.Info("stopping retry", "description", desc)
 			if errs != nil {
-				return fmt.Errorf("%v: %v", ctx.Err(), errs)
+				return fmt.Errorf("%w: %v", ctx.Err(), errs)
 			}
 			return ctx.Err()
 		case <-interval.C:
@@ -99,6 +100,7 @@ func (r Retry) Do(ctx context.Context, f func(context.Context) error, desc strin
 				logger.Warn("retrying", "attempt_number", attempt, "description", desc)
 			}
 			err := f(ctx)
+			metrics.IncRetryAttempt(err)
 			if err == nil {
 				return nil
 			}
@@ -0,0 +1,337 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/client"
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+const (
+	logSystemName = "state"
+
+	// tasksKVPath and eventsKVPath are the paths relative to the configured
+	// Consul KV path to store tasks and events. Each event is stored in its
+	// own key under the path of its task, i.e. state/events/<task>/<event ID>,
+	// to stay within the size limit of Consul KV values.
+	tasksKVPath  = "state/tasks/"
+	eventsKVPath = "state/events/"
+)
+
+var (
+	_ PersistentStore = (*ConsulKVStore)(nil)
+)
+
+// ConsulKVStore implements the CTS state Store interface. The state is stored
+// in memory and is also persisted to the Consul KV store so that tasks and
+// events can be restored when CTS restarts.
+type ConsulKVStore struct {
+	*InMemoryStore
+
+	client    client.ConsulClientInterface
+	kvPath    string
+	namespace string
+	logger    logging.Logger
+
+	// mu serializes writes to Consul KV so that the persisted state matches
+	// the in-memory state
+	mu sync.Mutex
+
+	// configTasks are the names of the tasks configured in the CTS
+	// configuration files as opposed to tasks created at runtime
+	configTasks map[string]bool
+
+	// eventIDs are the IDs of the events persisted for each task, which are
+	// deleted from Consul KV once they exceed the event retention
+	eventIDs map[string]map[string]bool // taskname => event IDs
+}
+
+// NewConsulKVStore returns a new store for CTS state that is persisted to
+// the Consul KV store under the configured Consul KV path. Load must be called
+// to restore any previously persisted state.
+func NewConsulKVStore(conf *config.Config, c client.ConsulClientInterface) *ConsulKVStore {
+	if conf == nil {
+		// expect nil config only for testing
+		conf = config.DefaultConfig()
+	}
+
+	kvPath := config.DefaultConsulKVPath
+	var namespace string
+	if conf.Consul != nil {
+		if p := config.StringVal(conf.Consul.KVPath); p != "" {
+			kvPath = p
+		}
+		namespace = config.StringVal(conf.Consul.KVNamespace)
+	}
+	if !strings.HasSuffix(kvPath, "/") {
+		kvPath += "/"
+	}
+
+	return &ConsulKVStore{
+		InMemoryStore: NewInMemoryStore(conf),
+		client:        c,
+		kvPath:        kvPath,
+		namespace:     namespace,
+		logger:        logging.Global().Named(logSystemName),
+		configTasks:   configTaskNames(conf),
+		eventIDs:      make(map[string]map[string]bool),
+	}
+}
+
+// Load restores the tasks and events persisted in Consul KV.
+//
+// Tasks configured in the CTS configuration files take precedence over
+// persisted tasks with the same name, though their persisted enabled status is
+// retained. Persisted tasks that were configured in the configuration files
+// but have since been removed from them are deleted. Tasks created at runtime
+// are restored as-is.
+func (s *ConsulKVStore) Load(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskPairs, _, err := s.client.KVList(ctx, s.kvPath+tasksKVPath, s.queryOptions())
+	if err != nil {
+		return fmt.Errorf("error loading tasks from Consul KV: %s", err)
+	}
+
+	for _, p := range taskPairs {
+		taskName := strings.TrimPrefix(p.Key, s.kvPath+tasksKVPath)
+		logger := s.logger.With("task_name", taskName)
+
+		var record taskRecord
+		if err := json.Unmarshal(p.Value, &record); err != nil {
+			return fmt.Errorf("error decoding task %q from Consul KV: %s",
+				taskName, err)
+		}
+		taskConf, err := record.taskConfig()
+		if err != nil {
+			return fmt.Errorf("error decoding task %q from Consul KV: %s",
+				taskName, err)
+		}
+
+		switch {
+		case s.configTasks[taskName]:
+			if taskConf.Enabled != nil {
+				logger.Debug("restoring enabled status of configured task",
+					"enabled", *taskConf.Enabled)
+				s.setTaskEnabled(taskName, *taskConf.Enabled)
+			}
+		case record.FromConfig:
+			logger.Info("task was removed from configuration, deleting " +
+				"persisted task")
+			if err := s.deleteKeys(ctx, taskName); err != nil {
+				return err
+			}
+		default:
+			logger.Debug("restoring task")
+			if err := s.InMemoryStore.SetTask(*taskConf); err != nil {
+				return err
+			}
+		}
+	}
+
+	eventPairs, _, err := s.client.KVList(ctx, s.kvPath+eventsKVPath, s.queryOptions())
+	if err != nil {
+		return fmt.Errorf("error loading events from Consul KV: %s", err)
+	}
+
+	taskEvents := make(map[string][]event.Event)
+	for _, p := range eventPairs {
+		key := strings.TrimPrefix(p.Key, s.kvPath+eventsKVPath)
+		taskName, eventID, ok := strings.Cut(key, "/")
+		if !ok {
+			s.logger.Warn("ignoring unexpected key in Consul KV", "key", p.Key)
+			continue
+		}
+		if _, ok := s.InMemoryStore.GetTask(taskName); !ok {
+			// events of a deleted task
+			continue
+		}
+
+		var e event.Event
+		if err := json.Unmarshal(p.Value, &e); err != nil {
+			return fmt.Errorf("error decoding event %q for task %q from "+
+				"Consul KV: %s", eventID, taskName, err)
+		}
+		taskEvents[taskName] = append(taskEvents[taskName], e)
+		s.addEventID(taskName, eventID)
+	}
+
+	for taskName, events := range taskEvents {
+		// Events are stored in memory in reverse chronological order
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].EndTime.After(events[j].EndTime)
+		})
+		s.setTaskEvents(taskName, events)
+	}
+
+	return nil
+}
+
+// SetTask adds a new task configuration or overwrites an existing task
+// configuration with the same name. The task configuration is persisted to
+// Consul KV before it is stored in memory.
+func (s *ConsulKVStore) SetTask(taskConf config.TaskConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskName := config.StringVal(taskConf.Name)
+	record, err := newTaskRecord(taskConf, s.configTasks[taskName])
+	if err != nil {
+		return fmt.Errorf("error encoding task %q: %s", taskName, err)
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error encoding task %q: %s", taskName, err)
+	}
+
+	if err = s.put(context.Background(), s.kvPath+tasksKVPath+taskName, value); err != nil {
+		return fmt.Errorf("error persisting task %q to Consul KV: %s", taskName, err)
+	}
+
+	return s.InMemoryStore.SetTask(taskConf)
+}
+
+// DeleteTask deletes the task config if it exists, both from Consul KV and
+// from memory.
+func (s *ConsulKVStore) DeleteTask(taskName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.client.KVDelete(context.Background(),
+		s.kvPath+tasksKVPath+taskName, s.writeOptions())
+	if err != nil {
+		return fmt.Errorf("error deleting task %q from Consul KV: %s",
+			taskName, err)
+	}
+
+	return s.InMemoryStore.DeleteTask(taskName)
+}
+
+// DeleteTaskEvents deletes all the events for a given task, both from Consul
+// KV and from memory.
+func (s *ConsulKVStore) DeleteTaskEvents(taskName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.client.KVDeleteTree(context.Background(),
+		s.eventsPrefix(taskName), s.writeOptions())
+	if err != nil {
+		return fmt.Errorf("error deleting events for task %q from Consul "+
+			"KV: %s", taskName, err)
+	}
+	delete(s.eventIDs, taskName)
+
+	return s.InMemoryStore.DeleteTaskEvents(taskName)
+}
+
+// AddTaskEvent adds an event to the store for the task configured in the
+// event. The event is persisted to Consul KV after it is added in memory, and
+// the persisted events of the task that are no longer retained are deleted.
+func (s *ConsulKVStore) AddTaskEvent(e event.Event) error {
+	if e.ID == "" {
+		return fmt.Errorf("error adding event: id cannot be empty %s", e.GoString())
+	}
+	if e.TaskName == "" {
+		return fmt.Errorf("error adding event: taskname cannot be empty %s", e.GoString())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("error encoding event for task %q: %s",
+			e.TaskName, err)
+	}
+
+	ctx := context.Background()
+	if err = s.put(ctx, s.eventsPrefix(e.TaskName)+e.ID, value); err != nil {
+		return fmt.Errorf("error persisting event for task %q to Consul "+
+			"KV: %s", e.TaskName, err)
+	}
+	s.addEventID(e.TaskName, e.ID)
+
+	if err := s.InMemoryStore.AddTaskEvent(e); err != nil {
+		return err
+	}
+
+	return s.deleteExpiredEvents(ctx, e.TaskName)
+}
+
+// deleteExpiredEvents deletes the persisted events of a task that are no
+// longer retained in memory
+func (s *ConsulKVStore) deleteExpiredEvents(ctx context.Context, taskName string) error {
+	retained := make(map[string]bool)
+	for _, e := range s.InMemoryStore.GetTaskEvents(taskName)[taskName] {
+		retained[e.ID] = true
+	}
+
+	for id := range s.eventIDs[taskName] {
+		if retained[id] {
+			continue
+		}
+		_, err := s.client.KVDelete(ctx, s.eventsPrefix(taskName)+id, s.writeOptions())
+		if err != nil {
+			return fmt.Errorf("error deleting event %q for task %q from "+
+				"Consul KV: %s", id, taskName, err)
+		}
+		delete(s.eventIDs[taskName], id)
+	}
+	return nil
+}
+
+// addEventID records the ID of an event persisted for a task
+func (s *ConsulKVStore) addEventID(taskName, id string) {
+	if _, ok := s.eventIDs[taskName]; !ok {
+		s.eventIDs[taskName] = make(map[string]bool)
+	}
+	s.eventIDs[taskName][id] = true
+}
+
+// eventsPrefix returns the Consul KV prefix of the events of a task
+func (s *ConsulKVStore) eventsPrefix(taskName string) string {
+	return s.kvPath + eventsKVPath + taskName + "/"
+}
+
+// deleteKeys deletes the persisted task and events of a task from Consul KV
+func (s *ConsulKVStore) deleteKeys(ctx context.Context, taskName string) error {
+	_, err := s.client.KVDelete(ctx, s.kvPath+tasksKVPath+taskName, s.writeOptions())
+	if err == nil {
+		_, err = s.client.KVDeleteTree(ctx, s.eventsPrefix(taskName), s.writeOptions())
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting task %q from Consul KV: %s",
+			taskName, err)
+	}
+	delete(s.eventIDs, taskName)
+	return nil
+}
+
+func (s *ConsulKVStore) put(ctx context.Context, key string, value []byte) error {
+	_, err := s.client.KVPut(ctx, &consulapi.KVPair{
+		Key:   key,
+		Value: value,
+	}, s.writeOptions())
+	return err
+}
+
+func (s *ConsulKVStore) queryOptions() *consulapi.QueryOptions {
+	return &consulapi.QueryOptions{Namespace: s.namespace}
+}
+
+func (s *ConsulKVStore) writeOptions() *consulapi.WriteOptions {
+	return &consulapi.WriteOptions{Namespace: s.namespace}
+}
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	mocks "github.com/hashicorp/consul-terraform-sync/mocks/client"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConsulKVStore_SetTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("persisted", func(t *testing.T) {
+		kv := newFakeKV()
+		store := NewConsulKVStore(testConsulKVStoreConfig(), kv)
+
+		taskConf := testTaskConfig("api_task")
+		require.NoError(t, store.SetTask(taskConf))
+
+		assert.Contains(t, kv.keys(), "cts/state/tasks/api_task")
+		actual, ok := store.GetTask("api_task")
+		require.True(t, ok)
+		assert.Equal(t, taskConf, actual)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		kv := newFakeKV()
+		kv.err = errors.New("kv error")
+		store := NewConsulKVStore(testConsulKVStoreConfig(), kv)
+
+		err := store.SetTask(testTaskConfig("api_task"))
+		assert.Error(t, err)
+
+		// not stored in memory when it failed to persist
+		_, ok := store.GetTask("api_task")
+		assert.False(t, ok)
+	})
+}
+
+func Test_ConsulKVStore_DeleteTask(t *testing.T) {
+	t.Parallel()
+
+	kv := newFakeKV()
+	store := NewConsulKVStore(testConsulKVStoreConfig(), kv)
+	require.NoError(t, store.SetTask(testTaskConfig("api_task")))
+	require.NoError(t, store.AddTaskEvent(event.Event{ID: "123", TaskName: "api_task"}))
+
+	require.NoError(t, store.DeleteTask("api_task"))
+	require.NoError(t, store.DeleteTaskEvents("api_task"))
+
+	assert.Empty(t, kv.keys())
+	_, ok := store.GetTask("api_task")
+	assert.False(t, ok)
+	assert.Empty(t, store.GetTaskEvents("api_task"))
+}
+
+func Test_ConsulKVStore_Load(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kv := newFakeKV()
+
+	// Persist state from a previous CTS instance
+	prevConf := testConsulKVStoreConfig()
+	configTask := testTaskConfig("config_task")
+	removedTask := testTaskConfig("removed_task")
+	prevConf.Tasks = &config.TaskConfigs{&configTask, &removedTask}
+	prev := NewConsulKVStore(prevConf, kv)
+	require.NoError(t, prev.Load(ctx))
+
+	apiTask := testTaskConfig("api_task")
+	require.NoError(t, prev.SetTask(apiTask))
+	disabled := testTaskConfig("config_task")
+	disabled.Enabled = config.Bool(false)
+	require.NoError(t, prev.SetTask(disabled))
+	require.NoError(t, prev.SetTask(testTaskConfig("removed_task")))
+
+	e := event.Event{
+		ID:        "123",
+		TaskName:  "api_task",
+		Success:   false,
+		StartTime: time.Now().Round(0).UTC(),
+		EndTime:   time.Now().Round(0).UTC(),
+		EventError: &event.Error{
+			Message: "error",
+		},
+	}
+	require.NoError(t, prev.AddTaskEvent(e))
+	require.NoError(t, prev.AddTaskEvent(event.Event{ID: "456", TaskName: "removed_task"}))
+
+	// Restart with a configuration that no longer includes removed_task
+	conf := testConsulKVStoreConfig()
+	conf.Tasks = &config.TaskConfigs{configTask.Copy()}
+	store := NewConsulKVStore(conf, kv)
+	require.NoError(t, store.Load(ctx))
+
+	t.Run("runtime task restored", func(t *testing.T) {
+		actual, ok := store.GetTask("api_task")
+		require.True(t, ok)
+		assert.Equal(t, apiTask, actual)
+
+		events := store.GetTaskEvents("api_task")
+		assert.Equal(t, map[string][]event.Event{"api_task": {e}}, events)
+	})
+
+	t.Run("configured task enabled status restored", func(t *testing.T) {
+		actual, ok := store.GetTask("config_task")
+		require.True(t, ok)
+		assert.False(t, *actual.Enabled)
+	})
+
+	t.Run("removed configured task deleted", func(t *testing.T) {
+		_, ok := store.GetTask("removed_task")
+		assert.False(t, ok)
+		assert.Empty(t, store.GetTaskEvents("removed_task"))
+		assert.NotContains(t, kv.keys(), "cts/state/tasks/removed_task")
+		assert.NotContains(t, kv.keys(), "cts/state/events/removed_task/456")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		kv := newFakeKV()
+		kv.err = errors.New("kv error")
+		store := NewConsulKVStore(testConsulKVStoreConfig(), kv)
+		assert.Error(t, store.Load(ctx))
+	})
+}
+
+func Test_ConsulKVStore_AddTaskEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("event per key", func(t *testing.T) {
+		kv := newFakeKV()
+		conf := testConsulKVStoreConfig()
+		conf.EventRetention = &config.EventRetentionConfig{Count: config.Int(2)}
+		store := NewConsulKVStore(conf, kv)
+		require.NoError(t, store.Load(ctx))
+		require.NoError(t, store.SetTask(testTaskConfig("api_task")))
+
+		// Events with large plan outputs exceeding the Consul KV value size
+		// limit when stored together
+		output := strings.Repeat("a", 300*1024)
+		now := time.Now().Round(0).UTC()
+		for i, id := range []string{"1", "2", "3"} {
+			require.NoError(t, store.AddTaskEvent(event.Event{
+				ID:       id,
+				TaskName: "api_task",
+				EndTime:  now.Add(time.Duration(i) * time.Second),
+				Plan:     &event.Plan{Output: output},
+			}))
+		}
+
+		// The event exceeding the retention is deleted
+		assert.Equal(t, []string{
+			"cts/state/events/api_task/2",
+			"cts/state/events/api_task/3",
+			"cts/state/tasks/api_task",
+		}, kv.keys())
+		for _, key := range kv.keys() {
+			assert.Less(t, len(kv.kv[key]), 512*1024, key)
+		}
+
+		// Events are restored in reverse chronological order
+		restored := NewConsulKVStore(conf, kv)
+		require.NoError(t, restored.Load(ctx))
+		events := restored.GetTaskEvents("api_task")["api_task"]
+		require.Len(t, events, 2)
+		assert.Equal(t, "3", events[0].ID)
+		assert.Equal(t, "2", events[1].ID)
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		store := NewConsulKVStore(testConsulKVStoreConfig(), newFakeKV())
+		err := store.AddTaskEvent(event.Event{TaskName: "api_task"})
+		assert.Error(t, err)
+		assert.Empty(t, store.GetTaskEvents("api_task"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		kv := newFakeKV()
+		kv.err = errors.New("kv error")
+		store := NewConsulKVStore(testConsulKVStoreConfig(), kv)
+		err := store.AddTaskEvent(event.Event{ID: "1", TaskName: "api_task"})
+		assert.Error(t, err)
+
+		// not stored in memory when it failed to persist
+		assert.Empty(t, store.GetTaskEvents("api_task"))
+	})
+
+	t.Run("error does not delete retained events", func(t *testing.T) {
+		kv := newFakeKV()
+		conf := testConsulKVStoreConfig()
+		conf.EventRetention = &config.EventRetentionConfig{Count: config.Int(1)}
+		store := NewConsulKVStore(conf, kv)
+		require.NoError(t, store.Load(ctx))
+
+		now := time.Now().Round(0).UTC()
+		require.NoError(t, store.AddTaskEvent(event.Event{
+			ID: "1", TaskName: "api_task", EndTime: now,
+		}))
+
+		// The retained event is kept when the new event fails to persist
+		kv.err = errors.New("kv error")
+		err := store.AddTaskEvent(event.Event{
+			ID: "2", TaskName: "api_task", EndTime: now.Add(time.Second),
+		})
+		assert.Error(t, err)
+		kv.err = nil
+
+		events := store.GetTaskEvents("api_task")["api_task"]
+		require.Len(t, events, 1)
+		assert.Equal(t, "1", events[0].ID)
+		assert.Equal(t, []string{"cts/state/events/api_task/1"}, kv.keys())
+	})
+}
+
+func testConsulKVStoreConfig() *config.Config {
+	conf := config.DefaultConfig()
+	conf.Consul.KVPath = config.String("cts")
+	return conf
+}
+
+func testTaskConfig(name string) config.TaskConfig {
+	return config.TaskConfig{
+		Name:      config.String(name),
+		Module:    config.String("path"),
+		Enabled:   config.Bool(true),
+		Providers: []string{"local"},
+		Condition: &config.ServicesConditionConfig{
+			ServicesMonitorConfig: config.ServicesMonitorConfig{
+				Names: []string{"api"},
+			},
+		},
+	}
+}
+
+// fakeKV is a Consul client with an in-memory KV store
+type fakeKV struct {
+	*mocks.ConsulClientInterface
+
+	mu  sync.Mutex
+	kv  map[string][]byte
+	err error
+}
+
+func newFakeKV() *fakeKV {
+	return &fakeKV{
+		ConsulClientInterface: new(mocks.ConsulClientInterface),
+		kv:                    make(map[string][]byte),
+	}
+}
+
+func (f *fakeKV) KVList(_ context.Context, prefix string, _ *consulapi.QueryOptions) (consulapi.KVPairs, *consulapi.QueryMeta, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	var pairs consulapi.KVPairs
+	for k, v := range f.kv {
+		if strings.HasPrefix(k, prefix) {
+			pairs = append(pairs, &consulapi.KVPair{Key: k, Value: v})
+		}
+	}
+	return pairs, &consulapi.QueryMeta{}, nil
+}
+
+func (f *fakeKV) KVPut(_ context.Context, p *consulapi.KVPair, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.kv[p.Key] = p.Value
+	return &consulapi.WriteMeta{}, nil
+}
+
+func (f *fakeKV) KVDelete(_ context.Context, key string, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	delete(f.kv, key)
+	return &consulapi.WriteMeta{}, nil
+}
+
+func (f *fakeKV) KVDeleteTree(_ context.Context, prefix string, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	for k := range f.kv {
+		if strings.HasPrefix(k, prefix) {
+			delete(f.kv, k)
+		}
+	}
+	return &consulapi.WriteMeta{}, nil
+}
+
+func (f *fakeKV) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	keys := make([]string, 0, len(f.kv))
+	for k := range f.kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Error codes classify the failure of an event. The codes are stable and can
+// be relied upon for filtering and alerting.
+const (
+	// ErrCodeTemplateRender is the code for failures to fetch the dependencies
+	// of a task or to render the task's template
+	ErrCodeTemplateRender = "template_render_failure"
+
+	// ErrCodeTerraformInit is the code for failures to initialize or validate
+	// the Terraform workspace of a task
+	ErrCodeTerraformInit = "terraform_init_failure"
+
+	// ErrCodeTerraformPlan is the code for failures to plan a task's changes
+	ErrCodeTerraformPlan = "terraform_plan_failure"
+
+	// ErrCodeTerraformApply is the code for failures to apply a task's changes
+	ErrCodeTerraformApply = "terraform_apply_failure"
+
+	// ErrCodeHandler is the code for failures of the out-of-band handlers that
+	// run after a task is applied
+	ErrCodeHandler = "handler_failure"
+
+	// ErrCodeProviderAuth is the code for Terraform failures caused by a
+	// provider being unable to authenticate
+	ErrCodeProviderAuth = "provider_auth_failure"
+
+	// ErrCodeTimeout is the code for failures caused by a deadline exceeding
+	ErrCodeTimeout = "timeout"
+
+	// ErrCodeCancelled is the code for failures caused by the task's execution
+	// being cancelled
+	ErrCodeCancelled = "cancelled"
+
+	// ErrCodeUnknown is the code for failures that could not be classified
+	ErrCodeUnknown = "unknown"
+)
+
+var (
+	// authFailureRegexp matches error messages of failed authentication or
+	// authorization
+	authFailureRegexp = regexp.MustCompile(`(?i)(unauthori[sz]ed|forbidden|` +
+		`authentication failed|access denied|invalid (api )?token|` +
+		`invalid credentials|no valid credential|permission denied|status(code)?:? ?40[13])`)
+
+	// providerScopeRegexp matches Terraform diagnostics of a provider or of
+	// its credentials
+	providerScopeRegexp = regexp.MustCompile(`(?i)(\bprovider\b|credential)`)
+
+	// providerInstallRegexp matches Terraform diagnostics of installing
+	// providers, which fail locally or with the registry rather than with the
+	// provider's credentials
+	providerInstallRegexp = regexp.MustCompile(`(?i)(failed to (install|query|` +
+		`download|instantiate)|provider packages|plugin.cache)`)
+)
+
+// CodedError is an error that is classified by an error code
+type CodedError struct {
+	Code string
+	Err  error
+}
+
+// NewCodedError returns the error classified by the code. Returns nil if the
+// error is nil.
+func NewCodedError(code string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{
+		Code: code,
+		Err:  err,
+	}
+}
+
+// Error returns an error string
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode returns the code that classifies the error. Errors caused by a
+// timeout or cancellation are classified as such regardless of where they
+// occurred, and Terraform errors are classified as provider authentication
+// failures when the error output indicates so. Returns an empty string for a
+// nil error and ErrCodeUnknown for errors that could not be classified.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrCodeTimeout
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrCodeCancelled
+	}
+
+	var codedErr *CodedError
+	if !errors.As(err, &codedErr) {
+		return ErrCodeUnknown
+	}
+
+	switch codedErr.Code {
+	case ErrCodeTerraformInit, ErrCodeTerraformPlan, ErrCodeTerraformApply:
+		if isProviderAuthFailure(codedErr.Error()) {
+			return ErrCodeProviderAuth
+		}
+	}
+	return codedErr.Code
+}
+
+// isProviderAuthFailure returns whether the Terraform error output has a
+// diagnostic of a provider that failed to authenticate. Only diagnostics that
+// are scoped to a provider or its credentials are considered, so that local
+// failures, e.g. a working directory that is not writable, keep the code of
+// the Terraform command that failed.
+func isProviderAuthFailure(output string) bool {
+	for _, diag := range strings.Split(output, "Error:") {
+		if authFailureRegexp.MatchString(diag) &&
+			providerScopeRegexp.MatchString(diag) &&
+			!providerInstallRegexp.MatchString(diag) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrorCode returns whether the error or any error it wraps has already
+// been classified by an error code
+func HasErrorCode(err error) bool {
+	var codedErr *CodedError
+	return errors.As(err, &codedErr)
+}
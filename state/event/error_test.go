@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCodedError(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewCodedError(ErrCodeHandler, nil))
+
+	err := errors.New("error")
+	codedErr := NewCodedError(ErrCodeHandler, err)
+	assert.Equal(t, "error", codedErr.Error())
+	assert.True(t, errors.Is(codedErr, err))
+	assert.True(t, HasErrorCode(fmt.Errorf("wrapped: %w", codedErr)))
+	assert.False(t, HasErrorCode(err))
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"",
+		},
+		{
+			"unclassified",
+			errors.New("error"),
+			ErrCodeUnknown,
+		},
+		{
+			"template_render",
+			NewCodedError(ErrCodeTemplateRender, errors.New("error")),
+			ErrCodeTemplateRender,
+		},
+		{
+			"wrapped",
+			pkgerrors.Wrap(fmt.Errorf("retry attempt #1 failed '%w'",
+				NewCodedError(ErrCodeTerraformApply, errors.New("error"))), "error"),
+			ErrCodeTerraformApply,
+		},
+		{
+			"terraform_init",
+			NewCodedError(ErrCodeTerraformInit, errors.New("error")),
+			ErrCodeTerraformInit,
+		},
+		{
+			"provider_auth",
+			NewCodedError(ErrCodeTerraformApply, errors.New(
+				"Error: Unauthorized: invalid token for provider")),
+			ErrCodeProviderAuth,
+		},
+		{
+			"provider_auth_status_code",
+			NewCodedError(ErrCodeTerraformPlan, errors.New(
+				"Error: configuring Terraform AWS Provider: validating provider "+
+					"credentials: retrieving caller identity from STS: https "+
+					"response error StatusCode: 403, api error "+
+					"InvalidClientTokenId")),
+			ErrCodeProviderAuth,
+		},
+		{
+			"provider_auth_diagnostic",
+			NewCodedError(ErrCodeTerraformApply, errors.New(`exit status 1
+
+Error: Unexpected response code: 403 (Permission denied)
+
+  with provider["registry.terraform.io/hashicorp/consul"],
+  on main.tf line 1, in provider "consul":
+`)),
+			ErrCodeProviderAuth,
+		},
+		{
+			"credentials",
+			NewCodedError(ErrCodeTerraformPlan, errors.New(
+				"Error: invalid credentials for the request")),
+			ErrCodeProviderAuth,
+		},
+		{
+			"resource_status_code",
+			NewCodedError(ErrCodeTerraformPlan, errors.New(
+				"Error: reading resource: StatusCode: 403")),
+			ErrCodeTerraformPlan,
+		},
+		{
+			"working_dir_permission_denied",
+			NewCodedError(ErrCodeTerraformInit, errors.New(
+				"Error: open /opt/cts/sync-tasks/task/.terraform/environment: "+
+					"permission denied")),
+			ErrCodeTerraformInit,
+		},
+		{
+			"plugin_cache_permission_denied",
+			NewCodedError(ErrCodeTerraformInit, errors.New(`exit status 1
+
+Error: Failed to install provider
+
+Error while installing hashicorp/local v2.2.3: mkdir
+/root/.terraform.d/plugin-cache/registry.terraform.io: permission denied
+`)),
+			ErrCodeTerraformInit,
+		},
+		{
+			"module_registry_forbidden",
+			NewCodedError(ErrCodeTerraformInit, errors.New(`exit status 1
+
+Error: Failed to download module
+
+Could not download module "task" (main.tf:1) source code from
+"https://registry.example.com/modules/task.zip": bad response code: 403.
+`)),
+			ErrCodeTerraformInit,
+		},
+		{
+			"separate_diagnostics",
+			NewCodedError(ErrCodeTerraformApply, errors.New(`exit status 1
+
+Error: open terraform.tfstate: permission denied
+
+Error: Invalid provider configuration
+`)),
+			ErrCodeTerraformApply,
+		},
+		{
+			"handler_auth_message",
+			NewCodedError(ErrCodeHandler, errors.New("forbidden")),
+			ErrCodeHandler,
+		},
+		{
+			"timeout",
+			NewCodedError(ErrCodeTerraformApply,
+				fmt.Errorf("error: %w", context.DeadlineExceeded)),
+			ErrCodeTimeout,
+		},
+		{
+			"cancelled",
+			fmt.Errorf("%w: %v", context.Canceled, errors.New("error")),
+			ErrCodeCancelled,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.name), func(t *testing.T) {
+			assert.Equal(t, tc.expected, ErrorCode(tc.err))
+		})
+	}
+}
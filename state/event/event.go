@@ -14,6 +14,15 @@ import (
 
 const (
 	logSystemName = "event"
+
+	// TypeRun is the type of events for the runs of a task that apply the
+	// task's changes
+	TypeRun = "run"
+
+	// TypeDrift is the type of events for the drift detection runs of a task
+	// that detected changes made to the task's infrastructure outside of CTS,
+	// or failed to detect drift
+	TypeDrift = "drift"
 )
 
 // Event captures the series of actions that needs to happen to update network
@@ -26,7 +35,9 @@ type Event struct {
 	StartTime  time.Time `json:"start_time"`
 	EndTime    time.Time `json:"end_time"`
 	TaskName   string    `json:"task_name"`
+	Type       string    `json:"type,omitempty"`
 	EventError *Error    `json:"error"`
+	Plan       *Plan     `json:"plan,omitempty"`
 
 	// Config is deprecated in v0.5. This is configuration details about the
 	// task rather than status information. Users should switch to using the
@@ -37,10 +48,28 @@ type Event struct {
 
 // Error captures an event's error information
 type Error struct {
-	// Code    string `json:"code"` TODO: future work
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// Plan summarizes the changes of the Terraform plan applied by an event
+type Plan struct {
+	Add       int      `json:"add"`
+	Change    int      `json:"change"`
+	Destroy   int      `json:"destroy"`
+	Resources []string `json:"resources"`
+
+	// Output is the plan output, truncated to a maximum length. Truncated is
+	// set if the output was truncated.
+	Output    string `json:"output"`
+	Truncated bool   `json:"output_truncated"`
+}
+
+// HasChanges returns whether the plan adds, changes or destroys any resources
+func (p *Plan) HasChanges() bool {
+	return p != nil && p.Add+p.Change+p.Destroy > 0
+}
+
 // Config provides details on an event's task configuration. It is deprecated
 // in v0.5 and should be removed in 0.8
 type Config struct {
@@ -49,8 +78,8 @@ type Config struct {
 	Source    string   `json:"source"`
 }
 
-// NewEvent configures a new event with a task name and any relevant information
-// that the task is configured with
+// NewEvent configures a new run event with a task name and any relevant
+// information that the task is configured with
 func NewEvent(taskName string, config *Config) (*Event, error) {
 	if taskName == "" {
 		return nil, errors.New("error creating new event: taskname cannot be empty")
@@ -63,10 +92,16 @@ func NewEvent(taskName string, config *Config) (*Event, error) {
 	return &Event{
 		ID:       id,
 		TaskName: taskName,
+		Type:     TypeRun,
 		Config:   config,
 	}, nil
 }
 
+// IsDrift returns whether the event is for a drift detection run of a task
+func (e *Event) IsDrift() bool {
+	return e.Type == TypeDrift
+}
+
 // Start sets the start time on an event. Can only be called once.
 func (e *Event) Start() {
 	if !e.StartTime.IsZero() {
@@ -92,6 +127,7 @@ func (e *Event) End(err error) {
 
 	e.Success = false
 	e.EventError = &Error{
+		Code:    ErrorCode(err),
 		Message: err.Error(),
 	}
 }
@@ -113,6 +149,27 @@ func (c *Config) GoString() string {
 	)
 }
 
+// GoString defines the printable version of this struct.
+func (p *Plan) GoString() string {
+	if p == nil {
+		return "(*Plan)(nil)"
+	}
+
+	return fmt.Sprintf("&Plan{"+
+		"Add:%d, "+
+		"Change:%d, "+
+		"Destroy:%d, "+
+		"Resources:%s, "+
+		"Truncated:%t"+
+		"}",
+		p.Add,
+		p.Change,
+		p.Destroy,
+		p.Resources,
+		p.Truncated,
+	)
+}
+
 // GoString defines the printable version of this struct.
 func (e *Event) GoString() string {
 	if e == nil {
@@ -122,18 +179,22 @@ func (e *Event) GoString() string {
 	return fmt.Sprintf("&Event{"+
 		"ID:%s, "+
 		"TaskName:%s, "+
+		"Type:%s, "+
 		"Success:%t, "+
 		"StartTime:%s, "+
 		"EndTime:%s, "+
 		"EventError:%s, "+
+		"Plan:%s, "+
 		"Config:%s"+
 		"}",
 		e.ID,
 		e.TaskName,
+		e.Type,
 		e.Success,
 		e.StartTime,
 		e.EndTime,
 		e.EventError,
+		e.Plan.GoString(),
 		e.Config.GoString(),
 	)
 }
@@ -62,7 +62,7 @@ func ExampleEvent() {
 	// Example: Event captures task erroring
 	// Task Name: task_fail
 	// Success: false
-	// Error: &{error}
+	// Error: &{unknown error}
 	//
 	// Example: Event captures task succeeding
 	// Task Name: task_success
@@ -119,6 +119,8 @@ func TestNewEvent(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, event)
 				assert.Equal(t, tc.taskName, event.TaskName)
+				assert.Equal(t, TypeRun, event.Type)
+				assert.False(t, event.IsDrift())
 				assertEqualConfig(t, tc.config, event.Config)
 			}
 		})
@@ -180,6 +182,7 @@ func TestEvent_End(t *testing.T) {
 				assert.False(t, event.Success)
 				assert.NotNil(t, event.EventError)
 				assert.Equal(t, tc.err.Error(), event.EventError.Message)
+				assert.Equal(t, ErrCodeUnknown, event.EventError.Code)
 			}
 
 			// test that calling End() again does not reset end time
@@ -206,8 +209,10 @@ func TestEvent_GoString(t *testing.T) {
 			&Event{
 				ID:       "123",
 				TaskName: "happy",
+				Type:     TypeRun,
 				Success:  false,
 				EventError: &Error{
+					Code:    ErrCodeUnknown,
 					Message: "error!",
 				},
 				Config: &Config{
@@ -216,11 +221,32 @@ func TestEvent_GoString(t *testing.T) {
 					Source:    "/my-module",
 				},
 			},
-			"&Event{ID:123, TaskName:happy, Success:false, " +
+			"&Event{ID:123, TaskName:happy, Type:run, Success:false, " +
 				"StartTime:0001-01-01 00:00:00 +0000 UTC, " +
-				"EndTime:0001-01-01 00:00:00 +0000 UTC, EventError:&{error!}, " +
+				"EndTime:0001-01-01 00:00:00 +0000 UTC, EventError:&{unknown error!}, " +
+				"Plan:(*Plan)(nil), " +
 				"Config:&Config{Providers:[local], Services:[web api], Source:/my-module}}",
 		},
+		{
+			"plan",
+			&Event{
+				ID:       "123",
+				TaskName: "plan",
+				Type:     TypeDrift,
+				Success:  true,
+				Plan: &Plan{
+					Add:       1,
+					Destroy:   1,
+					Resources: []string{"local_file.a"},
+					Output:    "Plan: 1 to add, 0 to change, 1 to destroy.",
+				},
+			},
+			"&Event{ID:123, TaskName:plan, Type:drift, Success:true, " +
+				"StartTime:0001-01-01 00:00:00 +0000 UTC, " +
+				"EndTime:0001-01-01 00:00:00 +0000 UTC, EventError:%!s(*event.Error=<nil>), " +
+				"Plan:&Plan{Add:1, Change:0, Destroy:1, Resources:[local_file.a], Truncated:false}, " +
+				"Config:(*Config)(nil)}",
+		},
 	}
 
 	for _, tc := range cases {
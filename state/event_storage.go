@@ -6,30 +6,122 @@ package state
 import (
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
 )
 
-const defaultEventCountLimit = 5
+const defaultEventCountLimit = config.DefaultEventRetentionCount
 
 // eventStorage is the storage for events
 type eventStorage struct {
 	mu *sync.RWMutex
 
 	events map[string][]event.Event // taskname => events
+
+	// limit and maxAge are the default number of events and maximum age of
+	// events retained per task
 	limit  int
+	maxAge time.Duration
+
+	// retention is the event retention configured for individual tasks, which
+	// overrides the default values
+	retention map[string]*config.EventRetentionConfig // taskname => retention
 }
 
 // newEventStorage returns a new storage for event
 func newEventStorage() *eventStorage {
 	return &eventStorage{
-		mu:     &sync.RWMutex{},
-		events: make(map[string][]event.Event),
-		limit:  defaultEventCountLimit,
+		mu:        &sync.RWMutex{},
+		events:    make(map[string][]event.Event),
+		limit:     defaultEventCountLimit,
+		maxAge:    config.DefaultEventRetentionMaxAge,
+		retention: make(map[string]*config.EventRetentionConfig),
+	}
+}
+
+// SetRetention sets the default event retention for all tasks. Values that are
+// not configured are left unchanged.
+func (s *eventStorage) SetRetention(conf *config.EventRetentionConfig) {
+	if conf == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conf.Count != nil {
+		s.limit = *conf.Count
+	}
+	if conf.MaxAge != nil {
+		s.maxAge = *conf.MaxAge
+	}
+	for taskName, events := range s.events {
+		s.events[taskName] = s.prune(taskName, events)
+	}
+}
+
+// SetTaskRetention sets the event retention for a task, which overrides the
+// default event retention. A nil configuration resets the task to the default
+// event retention. Existing events exceeding the retention are removed.
+func (s *eventStorage) SetTaskRetention(taskName string, conf *config.EventRetentionConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conf == nil {
+		delete(s.retention, taskName)
+	} else {
+		s.retention[taskName] = conf.Copy()
+	}
+	if events, ok := s.events[taskName]; ok {
+		s.events[taskName] = s.prune(taskName, events)
 	}
 }
 
-// Add adds an event and manages the limit of number of events stored per task.
+// taskRetention returns the number of events and maximum age of events
+// retained for a task. Expects the caller to hold the lock.
+func (s *eventStorage) taskRetention(taskName string) (int, time.Duration) {
+	limit, maxAge := s.limit, s.maxAge
+	if conf, ok := s.retention[taskName]; ok {
+		if conf.Count != nil {
+			limit = *conf.Count
+		}
+		if conf.MaxAge != nil {
+			maxAge = *conf.MaxAge
+		}
+	}
+	return limit, maxAge
+}
+
+// prune returns the events for a task without the events that exceed the
+// retention of the task. Events are expected to be sorted in reverse
+// chronological order. Expects the caller to hold the lock.
+func (s *eventStorage) prune(taskName string, events []event.Event) []event.Event {
+	limit, maxAge := s.taskRetention(taskName)
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	if maxAge <= 0 {
+		return events
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	for i, e := range events {
+		if isExpired(e, cutoff) {
+			return events[:i]
+		}
+	}
+	return events
+}
+
+// isExpired returns true if the event ended before the cutoff time. Events
+// that have not ended are never expired.
+func isExpired(e event.Event, cutoff time.Time) bool {
+	return !e.EndTime.IsZero() && e.EndTime.Before(cutoff)
+}
+
+// Add adds an event and manages the number and age of events stored per task.
 func (s *eventStorage) Add(e event.Event) error {
 	if e.TaskName == "" {
 		return fmt.Errorf("error adding event: taskname cannot be empty %s", e.GoString())
@@ -40,16 +132,14 @@ func (s *eventStorage) Add(e event.Event) error {
 
 	events := s.events[e.TaskName]
 	events = append([]event.Event{e}, events...) // prepend
-	if len(events) > s.limit {
-		events = events[:len(events)-1]
-	}
-	s.events[e.TaskName] = events
+	s.events[e.TaskName] = s.prune(e.TaskName, events)
 	return nil
 }
 
 // Read returns events for a task name. If no task name is specified, return
 // events for all tasks. Returned events are sorted in reverse chronological
-// order based on the end time.
+// order based on the end time. Events older than the retained maximum age are
+// not returned.
 func (s *eventStorage) Read(taskName string) map[string][]event.Event {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -63,8 +153,19 @@ func (s *eventStorage) Read(taskName string) map[string][]event.Event {
 		data = s.events
 	}
 
+	now := time.Now()
 	ret := make(map[string][]event.Event)
 	for k, v := range data {
+		_, maxAge := s.taskRetention(k)
+		if maxAge > 0 {
+			cutoff := now.Add(-maxAge)
+			for i, e := range v {
+				if isExpired(e, cutoff) {
+					v = v[:i]
+					break
+				}
+			}
+		}
 		events := make([]event.Event, len(v))
 		copy(events, v)
 		ret[k] = events
@@ -83,11 +184,12 @@ func (s *eventStorage) Delete(taskName string) {
 }
 
 // Set overwrites all events for a task name.
-// Any events exceeding the configured limit will be removed.
+// Any events exceeding the configured retention will be removed.
 func (s *eventStorage) Set(taskName string, events []event.Event) {
-	if len(events) > s.limit {
-		events = events[0:s.limit]
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events = s.prune(taskName, events)
 	eventsCopy := make([]event.Event, len(events))
 	copy(eventsCopy, events)
 	s.events[taskName] = eventsCopy
@@ -6,7 +6,9 @@ package state
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -254,3 +256,82 @@ func Test_eventStorage_Set(t *testing.T) {
 		})
 	}
 }
+
+func Test_eventStorage_Retention(t *testing.T) {
+	now := time.Now()
+	makeEvents := func(taskName string, ages ...time.Duration) []event.Event {
+		events := make([]event.Event, len(ages))
+		for i, age := range ages {
+			events[i].TaskName = taskName
+			events[i].ID = fmt.Sprintf("%v-%v", taskName, i)
+			events[i].EndTime = now.Add(-age)
+		}
+		return events
+	}
+
+	t.Run("default retention", func(t *testing.T) {
+		storage := newEventStorage()
+		storage.SetRetention(&config.EventRetentionConfig{
+			Count:  config.Int(2),
+			MaxAge: config.TimeDuration(time.Hour),
+		})
+
+		storage.Set("task", makeEvents("task", time.Minute, 2*time.Minute,
+			3*time.Minute))
+		assert.Equal(t, makeEvents("task", time.Minute, 2*time.Minute),
+			storage.events["task"])
+
+		storage.Set("task", makeEvents("task", time.Minute, 2*time.Hour))
+		assert.Equal(t, makeEvents("task", time.Minute), storage.events["task"])
+	})
+
+	t.Run("task retention overrides default", func(t *testing.T) {
+		storage := newEventStorage()
+		storage.SetRetention(&config.EventRetentionConfig{
+			Count:  config.Int(2),
+			MaxAge: config.TimeDuration(time.Hour),
+		})
+		storage.SetTaskRetention("task_a", &config.EventRetentionConfig{
+			Count: config.Int(3),
+		})
+
+		ages := []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute,
+			2 * time.Hour}
+		storage.Set("task_a", makeEvents("task_a", ages...))
+		storage.Set("task_b", makeEvents("task_b", ages...))
+		assert.Len(t, storage.events["task_a"], 3)
+		assert.Len(t, storage.events["task_b"], 2)
+
+		// resetting the task retention prunes existing events
+		storage.SetTaskRetention("task_a", nil)
+		assert.Len(t, storage.events["task_a"], 2)
+	})
+
+	t.Run("add prunes expired events", func(t *testing.T) {
+		storage := newEventStorage()
+		storage.SetTaskRetention("task", &config.EventRetentionConfig{
+			MaxAge: config.TimeDuration(time.Hour),
+		})
+		storage.events["task"] = makeEvents("task", 30*time.Minute, 2*time.Hour)
+
+		err := storage.Add(event.Event{ID: "new", TaskName: "task", EndTime: now})
+		require.NoError(t, err)
+
+		events := storage.events["task"]
+		require.Len(t, events, 2)
+		assert.Equal(t, "new", events[0].ID)
+		assert.Equal(t, "task-0", events[1].ID)
+	})
+
+	t.Run("read excludes expired events", func(t *testing.T) {
+		storage := newEventStorage()
+		storage.SetRetention(&config.EventRetentionConfig{
+			MaxAge: config.TimeDuration(time.Hour),
+		})
+		storage.events["task"] = makeEvents("task", 30*time.Minute, 2*time.Hour)
+
+		assert.Equal(t, map[string][]event.Event{
+			"task": makeEvents("task", 30*time.Minute),
+		}, storage.Read("task"))
+	})
+}
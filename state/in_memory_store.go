@@ -33,9 +33,20 @@ func NewInMemoryStore(conf *config.Config) *InMemoryStore {
 		conf = config.DefaultConfig()
 	}
 
+	events := newEventStorage()
+	events.SetRetention(conf.EventRetention)
+	if conf.Tasks != nil {
+		for _, taskConf := range *conf.Tasks {
+			if taskConf.EventRetention != nil {
+				events.SetTaskRetention(config.StringVal(taskConf.Name),
+					taskConf.EventRetention)
+			}
+		}
+	}
+
 	return &InMemoryStore{
 		conf:   &configStorage{Config: *conf.Copy()},
-		events: newEventStorage(),
+		events: events,
 	}
 }
 
@@ -89,6 +100,7 @@ func (s *InMemoryStore) SetTask(newTaskConf config.TaskConfig) error {
 	defer s.conf.mu.Unlock()
 
 	newTaskName := config.StringVal(newTaskConf.Name)
+	s.events.SetTaskRetention(newTaskName, newTaskConf.EventRetention)
 
 	taskConfs := s.conf.Tasks
 	if taskConfs == nil {
@@ -115,6 +127,8 @@ func (s *InMemoryStore) DeleteTask(taskName string) error {
 	s.conf.mu.Lock()
 	defer s.conf.mu.Unlock()
 
+	s.events.SetTaskRetention(taskName, nil)
+
 	taskConfs := s.conf.Tasks
 	if taskConfs == nil {
 		// expect nil only for testing
@@ -154,3 +168,19 @@ func (s *InMemoryStore) AddTaskEvent(event event.Event) error {
 func (s *InMemoryStore) setTaskEvents(taskName string, events []event.Event) {
 	s.events.Set(taskName, events)
 }
+
+// setTaskEnabled sets the enabled status of a task stored in memory.
+func (s *InMemoryStore) setTaskEnabled(taskName string, enabled bool) {
+	s.conf.mu.Lock()
+	defer s.conf.mu.Unlock()
+
+	if s.conf.Tasks == nil {
+		return
+	}
+	for _, taskConf := range *s.conf.Tasks {
+		if config.StringVal(taskConf.Name) == taskName {
+			taskConf.Enabled = config.Bool(enabled)
+			return
+		}
+	}
+}
@@ -466,3 +466,36 @@ func Test_InMemoryStore_AddTaskEvent(t *testing.T) {
 		})
 	}
 }
+
+func Test_InMemoryStore_EventRetention(t *testing.T) {
+	t.Parallel()
+
+	conf := config.DefaultConfig()
+	conf.EventRetention = &config.EventRetentionConfig{Count: config.Int(1)}
+	conf.Tasks = &config.TaskConfigs{
+		{
+			Name:           config.String("task_a"),
+			EventRetention: &config.EventRetentionConfig{Count: config.Int(2)},
+		},
+		{Name: config.String("task_b")},
+	}
+	store := NewInMemoryStore(conf)
+
+	addEvents := func(taskName string) {
+		for i := 0; i < 3; i++ {
+			require.NoError(t, store.AddTaskEvent(event.Event{TaskName: taskName}))
+		}
+	}
+	addEvents("task_a")
+	addEvents("task_b")
+	assert.Len(t, store.GetTaskEvents("task_a")["task_a"], 2)
+	assert.Len(t, store.GetTaskEvents("task_b")["task_b"], 1)
+
+	// updating the task updates its event retention
+	require.NoError(t, store.SetTask(config.TaskConfig{
+		Name:           config.String("task_b"),
+		EventRetention: &config.EventRetentionConfig{Count: config.Int(3)},
+	}))
+	addEvents("task_b")
+	assert.Len(t, store.GetTaskEvents("task_b")["task_b"], 3)
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+)
+
+const (
+	// localStateSchemaVersion is the version of the format of the local state
+	// file. It is incremented when a change to the format is not backwards
+	// compatible, so that older versions of CTS do not load state that they
+	// cannot interpret.
+	localStateSchemaVersion = 1
+
+	localStateFilePerms = 0600
+	localStateDirPerms  = 0750
+)
+
+var (
+	_ PersistentStore = (*LocalFileStore)(nil)
+)
+
+// localState is the format of the local state file
+type localState struct {
+	SchemaVersion int                      `json:"schema_version"`
+	Tasks         map[string]taskRecord    `json:"tasks"`
+	Events        map[string][]event.Event `json:"events"`
+}
+
+// LocalFileStore implements the CTS state Store interface. The state is stored
+// in memory and is also persisted to a file on the local filesystem so that
+// tasks and events can be restored when CTS restarts.
+//
+// The whole state is rewritten on each change. Writes are atomic: the state is
+// written to a temporary file which then replaces the state file, so a crash
+// mid-write leaves the previous state intact.
+type LocalFileStore struct {
+	*InMemoryStore
+
+	path   string
+	logger logging.Logger
+
+	// mu serializes writes to the state file so that the persisted state
+	// matches the in-memory state
+	mu sync.Mutex
+
+	// tasks are the persisted task records
+	tasks map[string]taskRecord
+
+	// configTasks are the names of the tasks configured in the CTS
+	// configuration files as opposed to tasks created at runtime
+	configTasks map[string]bool
+}
+
+// NewLocalFileStore returns a new store for CTS state that is persisted to the
+// file at the given path. Load must be called to restore any previously
+// persisted state.
+func NewLocalFileStore(conf *config.Config, path string) *LocalFileStore {
+	if conf == nil {
+		// expect nil config only for testing
+		conf = config.DefaultConfig()
+	}
+
+	return &LocalFileStore{
+		InMemoryStore: NewInMemoryStore(conf),
+		path:          path,
+		logger:        logging.Global().Named(logSystemName),
+		tasks:         make(map[string]taskRecord),
+		configTasks:   configTaskNames(conf),
+	}
+}
+
+// Load restores the tasks and events persisted in the local state file. A
+// missing state file is treated as empty state.
+//
+// Tasks configured in the CTS configuration files take precedence over
+// persisted tasks with the same name, though their persisted enabled status is
+// retained. Persisted tasks that were configured in the configuration files
+// but have since been removed from them are deleted. Tasks created at runtime
+// are restored as-is.
+func (s *LocalFileStore) Load(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	logger := s.logger.With("path", s.path)
+
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.Debug("no local state file found")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading local state file: %s", err)
+	}
+
+	var st localState
+	if err := json.Unmarshal(b, &st); err != nil {
+		return fmt.Errorf("error decoding local state file %q: %s", s.path, err)
+	}
+	if st.SchemaVersion != localStateSchemaVersion {
+		return fmt.Errorf("unsupported schema version %d of local state "+
+			"file %q, expected version %d", st.SchemaVersion, s.path,
+			localStateSchemaVersion)
+	}
+
+	var removed bool
+	for taskName, record := range st.Tasks {
+		logger := logger.With("task_name", taskName)
+
+		taskConf, err := record.taskConfig()
+		if err != nil {
+			return fmt.Errorf("error decoding task %q from local state "+
+				"file: %s", taskName, err)
+		}
+
+		switch {
+		case s.configTasks[taskName]:
+			if taskConf.Enabled != nil {
+				logger.Debug("restoring enabled status of configured task",
+					"enabled", *taskConf.Enabled)
+				s.setTaskEnabled(taskName, *taskConf.Enabled)
+			}
+		case record.FromConfig:
+			logger.Info("task was removed from configuration, deleting " +
+				"persisted task")
+			removed = true
+			continue
+		default:
+			logger.Debug("restoring task")
+			if err := s.InMemoryStore.SetTask(*taskConf); err != nil {
+				return err
+			}
+		}
+		s.tasks[taskName] = record
+	}
+
+	for taskName, events := range st.Events {
+		if _, ok := s.InMemoryStore.GetTask(taskName); !ok {
+			// events of a deleted task
+			removed = true
+			continue
+		}
+		s.setTaskEvents(taskName, events)
+	}
+
+	if removed {
+		return s.write(s.tasks, s.InMemoryStore.GetTaskEvents(""))
+	}
+	return nil
+}
+
+// SetTask adds a new task configuration or overwrites an existing task
+// configuration with the same name. The task configuration is persisted to
+// the local state file before it is stored in memory.
+func (s *LocalFileStore) SetTask(taskConf config.TaskConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskName := config.StringVal(taskConf.Name)
+	record, err := newTaskRecord(taskConf, s.configTasks[taskName])
+	if err != nil {
+		return fmt.Errorf("error encoding task %q: %s", taskName, err)
+	}
+
+	tasks := s.copyTasks()
+	tasks[taskName] = record
+	if err := s.write(tasks, s.InMemoryStore.GetTaskEvents("")); err != nil {
+		return fmt.Errorf("error persisting task %q: %s", taskName, err)
+	}
+	s.tasks = tasks
+
+	return s.InMemoryStore.SetTask(taskConf)
+}
+
+// DeleteTask deletes the task config if it exists, both from the local state
+// file and from memory.
+func (s *LocalFileStore) DeleteTask(taskName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tasks := s.copyTasks()
+	delete(tasks, taskName)
+	if err := s.write(tasks, s.InMemoryStore.GetTaskEvents("")); err != nil {
+		return fmt.Errorf("error deleting persisted task %q: %s", taskName, err)
+	}
+	s.tasks = tasks
+
+	return s.InMemoryStore.DeleteTask(taskName)
+}
+
+// DeleteTaskEvents deletes all the events for a given task, both from the
+// local state file and from memory.
+func (s *LocalFileStore) DeleteTaskEvents(taskName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := s.InMemoryStore.GetTaskEvents("")
+	delete(events, taskName)
+	if err := s.write(s.tasks, events); err != nil {
+		return fmt.Errorf("error deleting persisted events for task %q: %s",
+			taskName, err)
+	}
+
+	return s.InMemoryStore.DeleteTaskEvents(taskName)
+}
+
+// AddTaskEvent adds an event to the store for the task configured in the
+// event. The events are persisted to the local state file after the event is
+// added in memory.
+func (s *LocalFileStore) AddTaskEvent(e event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.InMemoryStore.AddTaskEvent(e); err != nil {
+		return err
+	}
+
+	if err := s.write(s.tasks, s.InMemoryStore.GetTaskEvents("")); err != nil {
+		return fmt.Errorf("error persisting events for task %q: %s",
+			e.TaskName, err)
+	}
+	return nil
+}
+
+func (s *LocalFileStore) copyTasks() map[string]taskRecord {
+	tasks := make(map[string]taskRecord, len(s.tasks))
+	for k, v := range s.tasks {
+		tasks[k] = v
+	}
+	return tasks
+}
+
+// write persists the state to the local state file
+func (s *LocalFileStore) write(tasks map[string]taskRecord,
+	events map[string][]event.Event) error {
+
+	b, err := json.Marshal(localState{
+		SchemaVersion: localStateSchemaVersion,
+		Tasks:         tasks,
+		Events:        events,
+	})
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.path, b)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory as the
+// file at path and then renames the temporary file to path. The rename is
+// atomic, so the file at path either has its previous content or the new
+// content even if the process crashes during the write.
+func writeFileAtomic(path string, data []byte) (err error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, localStateDirPerms); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	if err = f.Chmod(localStateFilePerms); err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+
+	// sync the directory so that the rename is durable
+	d, err := os.Open(dir)
+	if err != nil {
+		return nil
+	}
+	defer d.Close()
+	d.Sync()
+	return nil
+}
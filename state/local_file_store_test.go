@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/state/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalFileStore_SetTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("persisted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state", "cts-state.db")
+		store := NewLocalFileStore(nil, path)
+
+		taskConf := testTaskConfig("api_task")
+		require.NoError(t, store.SetTask(taskConf))
+
+		st := readLocalState(t, path)
+		assert.Equal(t, localStateSchemaVersion, st.SchemaVersion)
+		assert.Contains(t, st.Tasks, "api_task")
+
+		actual, ok := store.GetTask("api_task")
+		require.True(t, ok)
+		assert.Equal(t, taskConf, actual)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(localStateFilePerms), info.Mode().Perm())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// path is a directory so the state file cannot be written
+		path := t.TempDir()
+		store := NewLocalFileStore(nil, path)
+
+		err := store.SetTask(testTaskConfig("api_task"))
+		assert.Error(t, err)
+
+		// not stored in memory when it failed to persist
+		_, ok := store.GetTask("api_task")
+		assert.False(t, ok)
+
+		// temporary file is cleaned up
+		entries, err := os.ReadDir(path)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func Test_LocalFileStore_DeleteTask(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cts-state.db")
+	store := NewLocalFileStore(nil, path)
+	require.NoError(t, store.SetTask(testTaskConfig("api_task")))
+	require.NoError(t, store.AddTaskEvent(event.Event{TaskName: "api_task"}))
+
+	require.NoError(t, store.DeleteTask("api_task"))
+	require.NoError(t, store.DeleteTaskEvents("api_task"))
+
+	st := readLocalState(t, path)
+	assert.Empty(t, st.Tasks)
+	assert.Empty(t, st.Events)
+	_, ok := store.GetTask("api_task")
+	assert.False(t, ok)
+	assert.Empty(t, store.GetTaskEvents("api_task"))
+}
+
+func Test_LocalFileStore_Load(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cts-state.db")
+
+	// Persist state from a previous CTS instance
+	prevConf := config.DefaultConfig()
+	configTask := testTaskConfig("config_task")
+	removedTask := testTaskConfig("removed_task")
+	prevConf.Tasks = &config.TaskConfigs{&configTask, &removedTask}
+	prev := NewLocalFileStore(prevConf, path)
+	require.NoError(t, prev.Load(ctx))
+
+	apiTask := testTaskConfig("api_task")
+	require.NoError(t, prev.SetTask(apiTask))
+	disabled := testTaskConfig("config_task")
+	disabled.Enabled = config.Bool(false)
+	require.NoError(t, prev.SetTask(disabled))
+	require.NoError(t, prev.SetTask(testTaskConfig("removed_task")))
+
+	e := event.Event{
+		ID:        "123",
+		TaskName:  "api_task",
+		Success:   false,
+		StartTime: time.Now().Round(0).UTC(),
+		EndTime:   time.Now().Round(0).UTC(),
+		EventError: &event.Error{
+			Message: "error",
+		},
+	}
+	require.NoError(t, prev.AddTaskEvent(e))
+	require.NoError(t, prev.AddTaskEvent(event.Event{ID: "456", TaskName: "removed_task"}))
+
+	// Restart with a configuration that no longer includes removed_task
+	conf := config.DefaultConfig()
+	conf.Tasks = &config.TaskConfigs{configTask.Copy()}
+	store := NewLocalFileStore(conf, path)
+	require.NoError(t, store.Load(ctx))
+
+	t.Run("runtime task restored", func(t *testing.T) {
+		actual, ok := store.GetTask("api_task")
+		require.True(t, ok)
+		assert.Equal(t, apiTask, actual)
+
+		events := store.GetTaskEvents("api_task")
+		assert.Equal(t, map[string][]event.Event{"api_task": {e}}, events)
+	})
+
+	t.Run("configured task enabled status restored", func(t *testing.T) {
+		actual, ok := store.GetTask("config_task")
+		require.True(t, ok)
+		assert.False(t, *actual.Enabled)
+	})
+
+	t.Run("removed configured task deleted", func(t *testing.T) {
+		_, ok := store.GetTask("removed_task")
+		assert.False(t, ok)
+		assert.Empty(t, store.GetTaskEvents("removed_task"))
+
+		st := readLocalState(t, path)
+		assert.NotContains(t, st.Tasks, "removed_task")
+		assert.NotContains(t, st.Events, "removed_task")
+	})
+
+	t.Run("no state file", func(t *testing.T) {
+		store := NewLocalFileStore(nil, filepath.Join(t.TempDir(), "none.db"))
+		assert.NoError(t, store.Load(ctx))
+		assert.Empty(t, store.GetAllTasks())
+	})
+
+	t.Run("unsupported schema version", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cts-state.db")
+		b, err := json.Marshal(localState{SchemaVersion: localStateSchemaVersion + 1})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, b, localStateFilePerms))
+
+		store := NewLocalFileStore(nil, path)
+		err = store.Load(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported schema version")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cts-state.db")
+		require.NoError(t, os.WriteFile(path, []byte("{"), localStateFilePerms))
+
+		store := NewLocalFileStore(nil, path)
+		assert.Error(t, store.Load(ctx))
+	})
+}
+
+func readLocalState(t *testing.T, path string) localState {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var st localState
+	require.NoError(t, json.Unmarshal(b, &st))
+	return st
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+)
+
+// taskRecord is the value that is persisted for a task
+type taskRecord struct {
+	// FromConfig is true when the task is configured in the CTS configuration
+	// files. When false, the task was created at runtime e.g. through the API.
+	FromConfig bool `json:"from_config"`
+
+	// Task is the task configuration in the JSON configuration file format
+	Task json.RawMessage `json:"task"`
+}
+
+// newTaskRecord encodes a task configuration into a record to persist
+func newTaskRecord(taskConf config.TaskConfig, fromConfig bool) (taskRecord, error) {
+	b, err := config.MarshalTaskConfigJSON(taskConf)
+	if err != nil {
+		return taskRecord{}, err
+	}
+	return taskRecord{FromConfig: fromConfig, Task: b}, nil
+}
+
+// taskConfig decodes the task configuration of the record
+func (r taskRecord) taskConfig() (*config.TaskConfig, error) {
+	return config.UnmarshalTaskConfigJSON(r.Task)
+}
+
+// configTaskNames returns the names of the tasks configured in the CTS
+// configuration files
+func configTaskNames(conf *config.Config) map[string]bool {
+	names := make(map[string]bool)
+	if conf.Tasks != nil {
+		for _, t := range *conf.Tasks {
+			names[config.StringVal(t.Name)] = true
+		}
+	}
+	return names
+}
@@ -4,6 +4,8 @@
 package state
 
 import (
+	"context"
+
 	"github.com/hashicorp/consul-terraform-sync/config"
 	"github.com/hashicorp/consul-terraform-sync/state/event"
 )
@@ -41,3 +43,13 @@ type Store interface {
 	// event
 	AddTaskEvent(event event.Event) error
 }
+
+// PersistentStore is a Store that persists the CTS state outside of the CTS
+// process so that the state can be restored when CTS restarts
+type PersistentStore interface {
+	Store
+
+	// Load restores the persisted state into the store. Load is expected to
+	// be called once before the store is used.
+	Load(ctx context.Context) error
+}
@@ -132,6 +132,34 @@ func TestNewFiles(t *testing.T) {
 				TerraformVersion: goVersion.Must(goVersion.NewSemver("0.99.9")),
 				Task:             task,
 			},
+		}, {
+			Name:   "variables.tf (nodes - render var)",
+			Func:   newVariablesTF,
+			Golden: "testdata/nodes/variables.tf",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&NodesTemplate{
+						Datacenter: "dc1",
+						RenderVar:  true,
+					},
+				},
+				TerraformVersion: goVersion.Must(goVersion.NewSemver("0.99.9")),
+				Task:             task,
+			},
+		}, {
+			Name:   "variables.tf (gateways - render var)",
+			Func:   newVariablesTF,
+			Golden: "testdata/gateways/variables.tf",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&GatewaysTemplate{
+						Names:     []string{"mesh-gateway"},
+						RenderVar: true,
+					},
+				},
+				TerraformVersion: goVersion.Must(goVersion.NewSemver("0.99.9")),
+				Task:             task,
+			},
 		}, {
 			Name:   "variables.tf (consul-kv - render var)",
 			Func:   newVariablesTF,
@@ -366,6 +394,76 @@ func TestNewFiles(t *testing.T) {
 				},
 				Task: task,
 			},
+		}, {
+			Name:   "terraform.tfvars.tmpl (nodes - render var)",
+			Func:   newTFVarsTmpl,
+			Golden: "testdata/nodes/terraform_with_var.tfvars.tmpl",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&NodesTemplate{
+						Datacenter: "dc1",
+						NodeMeta:   map[string]string{"rack": "rack-1", "env": "prod"},
+						Filter:     "Node != \"worker-01\"",
+						RenderVar:  true,
+					},
+				},
+				Task: task,
+			},
+		}, {
+			Name:   "terraform.tfvars.tmpl (prepared-query)",
+			Func:   newTFVarsTmpl,
+			Golden: "testdata/prepared-query/terraform.tfvars.tmpl",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&PreparedQueryTemplate{
+						Name:       "api-failover",
+						Datacenter: "dc1",
+						Near:       "_agent",
+						RenderVar:  true,
+					},
+				},
+				Task: task,
+			},
+		}, {
+			Name:   "terraform.tfvars.tmpl (gateways - render var)",
+			Func:   newTFVarsTmpl,
+			Golden: "testdata/gateways/terraform_with_var.tfvars.tmpl",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&GatewaysTemplate{
+						Names:      []string{"mesh-gateway", "ingress-gateway"},
+						Datacenter: "dc1",
+						RenderVar:  true,
+					},
+				},
+				Task: task,
+			},
+		}, {
+			Name:   "terraform.tfvars.tmpl (gateways - no var)",
+			Func:   newTFVarsTmpl,
+			Golden: "testdata/gateways/terraform.tfvars.tmpl",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&GatewaysTemplate{
+						Names:     []string{"mesh-gateway"},
+						RenderVar: false,
+					},
+				},
+				Task: task,
+			},
+		}, {
+			Name:   "terraform.tfvars.tmpl (nodes - no var)",
+			Func:   newTFVarsTmpl,
+			Golden: "testdata/nodes/terraform.tfvars.tmpl",
+			Input: RootModuleInputData{
+				Templates: []Template{
+					&NodesTemplate{
+						Datacenter: "dc1",
+						RenderVar:  false,
+					},
+				},
+				Task: task,
+			},
 		}, {
 			Name:   "terraform.tfvars.tmpl (consul-kv w recurse - render var)",
 			Func:   newTFVarsTmpl,
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat/dep"
 )
 
@@ -39,6 +41,27 @@ func logDependency(logger logging.Logger, dependency interface{}) {
 		}
 		logger.Debug("received dependency",
 			"variable", "consul_kv", "recurse", true, "keys", keys)
+	case []*api.Intention:
+		intentions := make([]string, len(d))
+		for ix, ixn := range d {
+			intentions[ix] = ixn.String()
+		}
+		logger.Debug("received dependency",
+			"variable", "intentions", "intentions", intentions)
+	case []*dep.Node:
+		nodes := make([]string, len(d))
+		for ix, n := range d {
+			nodes[ix] = n.Node
+		}
+		logger.Debug("received dependency",
+			"variable", "nodes", "nodes", nodes)
+	case []*tmplfunc.Gateway:
+		gatewayIDs := make([]string, len(d))
+		for ix, g := range d {
+			gatewayIDs[ix] = g.ID
+		}
+		logger.Debug("received dependency",
+			"variable", "gateways", "ids", gatewayIDs)
 	default:
 		logger.Debug("received unknown dependency",
 			"variable", fmt.Sprintf("%T", dependency))
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/consul-terraform-sync/templates/tftmpl/tmplfunc"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat/dep"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,6 +52,29 @@ func TestNotifier_logDependency(t *testing.T) {
 			},
 			`received dependency: variable=consul_kv recurse=true keys=["key_a", "key_b"]`,
 		},
+		{
+			"intentions",
+			[]*api.Intention{
+				{SourceName: "api", DestinationName: "db", Action: "allow"},
+			},
+			`received dependency: variable=intentions intentions=["api => db (allow)"]`,
+		},
+		{
+			"nodes",
+			[]*dep.Node{
+				{Node: "worker-01"},
+				{Node: "worker-02"},
+			},
+			`received dependency: variable=nodes nodes=["worker-01", "worker-02"]`,
+		},
+		{
+			"gateways",
+			[]*tmplfunc.Gateway{
+				{HealthService: &dep.HealthService{ID: "mesh-gateway-1"}},
+				{HealthService: &dep.HealthService{ID: "mesh-gateway-2"}},
+			},
+			`received dependency: variable=gateways ids=["mesh-gateway-1", "mesh-gateway-2"]`,
+		},
 		{
 			"unknown",
 			[]string{"data_a", "data_b"},
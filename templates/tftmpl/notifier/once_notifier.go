@@ -4,10 +4,13 @@
 package notifier
 
 import (
+	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/consul-terraform-sync/templates"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat/dep"
 )
 
@@ -31,6 +34,10 @@ type OnceNotifier struct {
 	mu           sync.Mutex
 	triggerCheck TriggerCheck
 	onceDone     bool
+
+	// triggeredAt is the time of the first trigger since TakeTriggeredAt was
+	// last called
+	triggeredAt time.Time
 }
 
 func NewOnceNotifier(triggerCheck TriggerCheck, template templates.Template) *OnceNotifier {
@@ -63,7 +70,21 @@ func (n *OnceNotifier) Notify(d interface{}) bool {
 	}
 	// Trigger task if once mode is not completed or if the trigger indicates.
 	// The task will check and prevent execution if the template isn't ready.
-	return trigger || !n.onceDone
+	triggered := trigger || !n.onceDone
+	if triggered && n.triggeredAt.IsZero() {
+		n.triggeredAt = time.Now()
+	}
+	return triggered
+}
+
+// TakeTriggeredAt returns the time of the first trigger since the last call
+// and resets it. Returns the zero time if there was no trigger.
+func (n *OnceNotifier) TakeTriggeredAt() time.Time {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	t := n.triggeredAt
+	n.triggeredAt = time.Time{}
+	return t
 }
 
 // TriggerCheckSuppress never triggers a task execution but renders on every call.
@@ -79,12 +100,62 @@ func TriggerCheckConsulKV(d interface{}) (render, trigger bool) {
 	return ok, ok
 }
 
+// MakeTriggerCheckConsulKVPredicate creates a function that tracks the values
+// of KV pairs between calls. It renders on every KV change and only triggers
+// when the value of a KV pair changes, including a KV pair being created, and
+// the new value satisfies the match function.
+func MakeTriggerCheckConsulKVPredicate(match func(value string) bool) TriggerCheck {
+	var mu sync.Mutex
+	var oldValues map[string]string
+	return func(d interface{}) (render, trigger bool) {
+		var pairs []*dep.KeyPair
+		switch v := d.(type) {
+		case *dep.KeyPair:
+			if v != nil && v.Exists {
+				pairs = []*dep.KeyPair{v}
+			}
+		case []*dep.KeyPair:
+			pairs = v
+		default:
+			return false, false
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		newValues := make(map[string]string)
+		for _, p := range pairs {
+			if p == nil {
+				continue
+			}
+			newValues[p.Path] = p.Value
+			if old, ok := oldValues[p.Path]; (!ok || old != p.Value) && match(p.Value) {
+				trigger = true
+			}
+		}
+		oldValues = newValues
+		return true, trigger
+	}
+}
+
 // TriggerCheckService triggers and renders on every service change.
 func TriggerCheckService(d interface{}) (render, trigger bool) {
 	_, ok := d.([]*dep.HealthService)
 	return ok, ok
 }
 
+// TriggerCheckIntentions triggers and renders on every intentions change.
+func TriggerCheckIntentions(d interface{}) (render, trigger bool) {
+	_, ok := d.([]*api.Intention)
+	return ok, ok
+}
+
+// TriggerCheckNodes triggers and renders on every nodes change.
+func TriggerCheckNodes(d interface{}) (render, trigger bool) {
+	_, ok := d.([]*dep.Node)
+	return ok, ok
+}
+
 // MakeTriggerCheckCatalogService creates a function that tracks
 // catalog service state between calls. If any change is detected
 // to the service names, then it will trigger and render. Otherwise,
@@ -121,3 +192,194 @@ func MakeTriggerCheckCatalogService() TriggerCheck {
 		return false, false
 	}
 }
+
+// MakeTriggerCheckHealthChecks creates a function that tracks the health of
+// service instances between calls. If the aggregate status of an instance or
+// the status of one of its checks changes, including instances being registered
+// or deregistered, then it will trigger and render. Changes to other instance
+// information should not trigger or render.
+func MakeTriggerCheckHealthChecks() TriggerCheck {
+	var mu sync.Mutex
+	var oldStatuses map[string]string
+	return func(d interface{}) (render, trigger bool) {
+		services, ok := d.([]*dep.HealthService)
+		if !ok {
+			return false, false
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		newStatuses := make(map[string]string)
+		for _, s := range services {
+			instance := fmt.Sprintf("%s/%s/%s", s.Node, s.Namespace, s.ID)
+			newStatuses[instance] = s.Status
+			for _, c := range s.Checks {
+				newStatuses[instance+"/"+c.CheckID] = c.Status
+			}
+		}
+
+		changed := !equalStatuses(oldStatuses, newStatuses)
+		oldStatuses = newStatuses
+		return changed, changed
+	}
+}
+
+// MakeTriggerCheckCriticalThreshold creates a function that tracks, between
+// calls, which services have more than the threshold percentage of their
+// instances critical. If a service crosses the threshold in either direction,
+// then it will trigger and render. Otherwise, no trigger or render should
+// occur.
+func MakeTriggerCheckCriticalThreshold(percent int) TriggerCheck {
+	var mu sync.Mutex
+	var oldExceeded map[string]string
+	return func(d interface{}) (render, trigger bool) {
+		services, ok := d.([]*dep.HealthService)
+		if !ok {
+			return false, false
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		total := make(map[string]int)
+		critical := make(map[string]int)
+		for _, s := range services {
+			total[s.Name]++
+			if s.Status == api.HealthCritical {
+				critical[s.Name]++
+			}
+		}
+
+		newExceeded := make(map[string]string)
+		for name, n := range total {
+			if critical[name]*100 > percent*n {
+				newExceeded[name] = api.HealthCritical
+			}
+		}
+
+		changed := !equalStatuses(oldExceeded, newExceeded)
+		oldExceeded = newExceeded
+		return changed, changed
+	}
+}
+
+// equalStatuses returns whether two maps of statuses are equal
+func equalStatuses(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if s, ok := b[k]; !ok || s != v {
+			return false
+		}
+	}
+	return true
+}
+
+// CompositeTriggerCheck combines the trigger checks of the conditions of a
+// composite condition. It renders whenever one of the checks renders and
+// tracks which checks have triggered since the task last ran:
+//   - if all is false, the task is triggered when one of the checks
+//     triggers.
+//   - if all is true, the task is triggered once all of the checks have
+//     triggered. For scheduled tasks, the task is never triggered and instead
+//     TakeConditionsMet reports whether the task should run on schedule.
+type CompositeTriggerCheck struct {
+	mu        sync.Mutex
+	checks    []TriggerCheck
+	all       bool
+	scheduled bool
+
+	// pending tracks, per check, whether the check has triggered since the
+	// task last ran
+	pending []bool
+}
+
+// NewCompositeTriggerCheck creates a composite of the trigger checks. Set all
+// to require all of the checks to trigger and scheduled if the task is
+// triggered on a schedule.
+func NewCompositeTriggerCheck(checks []TriggerCheck, all, scheduled bool) *CompositeTriggerCheck {
+	return &CompositeTriggerCheck{
+		checks:    checks,
+		all:       all,
+		scheduled: scheduled,
+		pending:   make([]bool, len(checks)),
+	}
+}
+
+// TriggerCheck calls each of the trigger checks and combines their results. It
+// satisfies the TriggerCheck func type.
+func (c *CompositeTriggerCheck) TriggerCheck(d interface{}) (render, trigger bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var triggered bool
+	for i, check := range c.checks {
+		r, t := check(d)
+		render = render || r
+		if t {
+			c.pending[i] = true
+			triggered = true
+		}
+	}
+
+	switch {
+	case !c.all:
+		return render, triggered
+	case c.scheduled:
+		return render, false
+	case c.allPending():
+		c.reset()
+		return render, true
+	default:
+		return render, false
+	}
+}
+
+// TakeConditionsMet returns whether the conditions are met for a scheduled task
+// to run and, if so, resets the tracked triggers. Returns true when the checks
+// do not all need to trigger.
+func (c *CompositeTriggerCheck) TakeConditionsMet() bool {
+	if c == nil {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.all || !c.scheduled {
+		return true
+	}
+	if !c.allPending() {
+		return false
+	}
+	c.reset()
+	return true
+}
+
+// Reset forgets the tracked triggers e.g. after the task ran for the first time
+func (c *CompositeTriggerCheck) Reset() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset()
+}
+
+func (c *CompositeTriggerCheck) allPending() bool {
+	for _, p := range c.pending {
+		if !p {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *CompositeTriggerCheck) reset() {
+	for i := range c.pending {
+		c.pending[i] = false
+	}
+}
@@ -4,9 +4,11 @@
 package notifier
 
 import (
+	"fmt"
 	"testing"
 
 	mocks "github.com/hashicorp/consul-terraform-sync/mocks/templates"
+	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/hcat/dep"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +37,22 @@ func TestOnceNotifier(t *testing.T) {
 		tmpl.EXPECT().Notify(nil).Return(false)
 		assert.True(t, n.Notify(nil))
 	})
+	t.Run("take triggered at", func(t *testing.T) {
+		tmpl := &mocks.Template{}
+		n := NewOnceNotifier(allFalse, tmpl)
+		assert.True(t, n.TakeTriggeredAt().IsZero())
+
+		tmpl.EXPECT().Notify(nil).Return(false)
+		n.Notify(nil)
+		first := n.TakeTriggeredAt()
+		assert.False(t, first.IsZero())
+		assert.True(t, n.TakeTriggeredAt().IsZero())
+
+		// triggers are not recorded when the trigger func returns false
+		n.SetOnceDone()
+		n.Notify(nil)
+		assert.True(t, n.TakeTriggeredAt().IsZero())
+	})
 }
 
 func TestTriggerCheckConsulKV(t *testing.T) {
@@ -49,6 +67,74 @@ func TestTriggerCheckConsulKV(t *testing.T) {
 	assert.False(t, tr)
 }
 
+func TestMakeTriggerCheckConsulKVPredicate(t *testing.T) {
+	enabled := func(value string) bool { return value == "enabled" }
+	pair := func(path, value string) *dep.KeyPair {
+		return &dep.KeyPair{Path: path, Value: value, Exists: true}
+	}
+
+	t.Run("only trigger on kv pairs", func(t *testing.T) {
+		check := MakeTriggerCheckConsulKVPredicate(enabled)
+		re, tr := check(nil)
+		assert.False(t, re)
+		assert.False(t, tr)
+	})
+	t.Run("trigger when value becomes a match", func(t *testing.T) {
+		check := MakeTriggerCheckConsulKVPredicate(enabled)
+
+		// key does not exist
+		re, tr := check(&dep.KeyPair{Path: "config/feature-x"})
+		assert.True(t, re)
+		assert.False(t, tr)
+
+		// key is created with a value that does not match
+		re, tr = check(pair("config/feature-x", "disabled"))
+		assert.True(t, re)
+		assert.False(t, tr)
+
+		// value becomes a match
+		re, tr = check(pair("config/feature-x", "enabled"))
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// unchanged value, e.g. only the modify index changed, does not
+		// trigger
+		re, tr = check(pair("config/feature-x", "enabled"))
+		assert.True(t, re)
+		assert.False(t, tr)
+
+		// value no longer matches
+		re, tr = check(pair("config/feature-x", "disabled"))
+		assert.True(t, re)
+		assert.False(t, tr)
+	})
+	t.Run("trigger when a recursed value becomes a match", func(t *testing.T) {
+		check := MakeTriggerCheckConsulKVPredicate(enabled)
+
+		re, tr := check([]*dep.KeyPair{
+			pair("config/feature-x", "disabled"),
+		})
+		assert.True(t, re)
+		assert.False(t, tr)
+
+		// new key that matches
+		re, tr = check([]*dep.KeyPair{
+			pair("config/feature-x", "disabled"),
+			pair("config/feature-y", "enabled"),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// change to a key that does not match
+		re, tr = check([]*dep.KeyPair{
+			pair("config/feature-x", "off"),
+			pair("config/feature-y", "enabled"),
+		})
+		assert.True(t, re)
+		assert.False(t, tr)
+	})
+}
+
 func TestTriggerCheckService(t *testing.T) {
 	re, tr := TriggerCheckService(([]*dep.HealthService)(nil))
 	assert.True(t, re)
@@ -58,6 +144,24 @@ func TestTriggerCheckService(t *testing.T) {
 	assert.False(t, tr)
 }
 
+func TestTriggerCheckIntentions(t *testing.T) {
+	re, tr := TriggerCheckIntentions(([]*api.Intention)(nil))
+	assert.True(t, re)
+	assert.True(t, tr)
+	re, tr = TriggerCheckIntentions(([]*dep.HealthService)(nil))
+	assert.False(t, re)
+	assert.False(t, tr)
+}
+
+func TestTriggerCheckNodes(t *testing.T) {
+	re, tr := TriggerCheckNodes(([]*dep.Node)(nil))
+	assert.True(t, re)
+	assert.True(t, tr)
+	re, tr = TriggerCheckNodes(([]*dep.HealthService)(nil))
+	assert.False(t, re)
+	assert.False(t, tr)
+}
+
 func TestMakeTriggerCheckCatalogService(t *testing.T) {
 	t.Run("only trigger on snippets", func(t *testing.T) {
 		check := MakeTriggerCheckCatalogService()
@@ -91,3 +195,203 @@ func TestMakeTriggerCheckCatalogService(t *testing.T) {
 		assert.True(t, tr)
 	})
 }
+
+func TestMakeTriggerCheckHealthChecks(t *testing.T) {
+	instance := func(id, status string, checks ...*api.HealthCheck) *dep.HealthService {
+		return &dep.HealthService{
+			Node:   "node",
+			ID:     id,
+			Name:   "api",
+			Status: status,
+			Checks: checks,
+		}
+	}
+	check := func(id, status string) *api.HealthCheck {
+		return &api.HealthCheck{CheckID: id, Status: status}
+	}
+
+	t.Run("only trigger on health services", func(t *testing.T) {
+		check := MakeTriggerCheckHealthChecks()
+		re, tr := check(nil)
+		assert.False(t, re)
+		assert.False(t, tr)
+	})
+	t.Run("trigger when status transitions", func(t *testing.T) {
+		trigger := MakeTriggerCheckHealthChecks()
+		re, tr := trigger([]*dep.HealthService{
+			instance("api-1", api.HealthPassing, check("serf", api.HealthPassing)),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// metadata churn does not trigger
+		churn := instance("api-1", api.HealthPassing, check("serf", api.HealthPassing))
+		churn.Tags = dep.ServiceTags{"v2"}
+		churn.ServiceMeta = map[string]string{"key": "value"}
+		re, tr = trigger([]*dep.HealthService{churn})
+		assert.False(t, re)
+		assert.False(t, tr)
+
+		// check transition triggers
+		re, tr = trigger([]*dep.HealthService{
+			instance("api-1", api.HealthPassing, check("serf", api.HealthWarning)),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// aggregate transition triggers
+		re, tr = trigger([]*dep.HealthService{
+			instance("api-1", api.HealthCritical, check("serf", api.HealthWarning)),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// new instance triggers
+		re, tr = trigger([]*dep.HealthService{
+			instance("api-1", api.HealthCritical, check("serf", api.HealthWarning)),
+			instance("api-2", api.HealthPassing),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// deregistered instance triggers
+		re, tr = trigger([]*dep.HealthService{
+			instance("api-2", api.HealthPassing),
+		})
+		assert.True(t, re)
+		assert.True(t, tr)
+	})
+}
+
+func TestMakeTriggerCheckCriticalThreshold(t *testing.T) {
+	services := func(statuses ...string) []*dep.HealthService {
+		s := make([]*dep.HealthService, len(statuses))
+		for ix, status := range statuses {
+			s[ix] = &dep.HealthService{
+				ID:     fmt.Sprintf("api-%d", ix),
+				Name:   "api",
+				Status: status,
+			}
+		}
+		return s
+	}
+
+	t.Run("only trigger on health services", func(t *testing.T) {
+		check := MakeTriggerCheckCriticalThreshold(50)
+		re, tr := check(nil)
+		assert.False(t, re)
+		assert.False(t, tr)
+	})
+	t.Run("trigger when threshold crossed", func(t *testing.T) {
+		check := MakeTriggerCheckCriticalThreshold(50)
+		re, tr := check(services(api.HealthPassing, api.HealthPassing,
+			api.HealthPassing, api.HealthPassing))
+		assert.False(t, re)
+		assert.False(t, tr)
+
+		// 50% critical does not exceed the threshold
+		re, tr = check(services(api.HealthCritical, api.HealthCritical,
+			api.HealthPassing, api.HealthPassing))
+		assert.False(t, re)
+		assert.False(t, tr)
+
+		// 75% critical exceeds the threshold
+		re, tr = check(services(api.HealthCritical, api.HealthCritical,
+			api.HealthCritical, api.HealthWarning))
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// remains exceeded
+		re, tr = check(services(api.HealthCritical, api.HealthCritical,
+			api.HealthCritical, api.HealthCritical))
+		assert.False(t, re)
+		assert.False(t, tr)
+
+		// recovers
+		re, tr = check(services(api.HealthCritical, api.HealthPassing,
+			api.HealthPassing, api.HealthPassing))
+		assert.True(t, re)
+		assert.True(t, tr)
+	})
+	t.Run("zero threshold", func(t *testing.T) {
+		check := MakeTriggerCheckCriticalThreshold(0)
+		re, tr := check(services(api.HealthPassing, api.HealthWarning))
+		assert.False(t, re)
+		assert.False(t, tr)
+
+		re, tr = check(services(api.HealthPassing, api.HealthCritical))
+		assert.True(t, re)
+		assert.True(t, tr)
+	})
+}
+
+func TestCompositeTriggerCheck(t *testing.T) {
+	kv := TriggerCheckConsulKV
+	svc := TriggerCheckService
+	kvData := []*dep.KeyPair{}
+	svcData := []*dep.HealthService{}
+
+	t.Run("or", func(t *testing.T) {
+		c := NewCompositeTriggerCheck([]TriggerCheck{kv, svc}, false, false)
+		re, tr := c.TriggerCheck(kvData)
+		assert.True(t, re)
+		assert.True(t, tr)
+		re, tr = c.TriggerCheck(svcData)
+		assert.True(t, re)
+		assert.True(t, tr)
+		re, tr = c.TriggerCheck(nil)
+		assert.False(t, re)
+		assert.False(t, tr)
+		assert.True(t, c.TakeConditionsMet())
+	})
+
+	t.Run("and", func(t *testing.T) {
+		c := NewCompositeTriggerCheck([]TriggerCheck{kv, svc}, true, false)
+		re, tr := c.TriggerCheck(kvData)
+		assert.True(t, re)
+		assert.False(t, tr)
+		re, tr = c.TriggerCheck(kvData)
+		assert.True(t, re)
+		assert.False(t, tr)
+		re, tr = c.TriggerCheck(svcData)
+		assert.True(t, re)
+		assert.True(t, tr)
+
+		// triggers are reset after the task is triggered
+		re, tr = c.TriggerCheck(svcData)
+		assert.True(t, re)
+		assert.False(t, tr)
+	})
+
+	t.Run("and reset", func(t *testing.T) {
+		c := NewCompositeTriggerCheck([]TriggerCheck{kv, svc}, true, false)
+		c.TriggerCheck(kvData)
+		c.Reset()
+		_, tr := c.TriggerCheck(svcData)
+		assert.False(t, tr)
+	})
+
+	t.Run("and scheduled", func(t *testing.T) {
+		c := NewCompositeTriggerCheck([]TriggerCheck{kv, svc}, true, true)
+		assert.False(t, c.TakeConditionsMet())
+
+		re, tr := c.TriggerCheck(kvData)
+		assert.True(t, re)
+		assert.False(t, tr)
+		assert.False(t, c.TakeConditionsMet())
+
+		re, tr = c.TriggerCheck(svcData)
+		assert.True(t, re)
+		assert.False(t, tr)
+		assert.True(t, c.TakeConditionsMet())
+
+		// conditions are reset after they are taken
+		assert.False(t, c.TakeConditionsMet())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var c *CompositeTriggerCheck
+		assert.True(t, c.TakeConditionsMet())
+		c.Reset()
+	})
+}
@@ -27,6 +27,10 @@ const (
 	// modules.
 	TerraformRequiredVersion = version.CompatibleTerraformVersionConstraint
 
+	// OpenTofuRequiredVersion is the version constraint pinned to the generated
+	// root module when tasks are executed with OpenTofu.
+	OpenTofuRequiredVersion = version.CompatibleOpenTofuVersionConstraint
+
 	// RootFilename is the file name for the root module.
 	RootFilename = "main.tf"
 
@@ -105,12 +109,15 @@ type tfFileFunc func(io.Writer, string, *RootModuleInputData) error
 // RootModuleInputData is the input data used to generate the root module
 type RootModuleInputData struct {
 	TerraformVersion *goVersion.Version
-	Backend          map[string]interface{}
-	Providers        []hcltmpl.NamedBlock
-	ProviderInfo     map[string]interface{}
-	Task             Task
-	Variables        hcltmpl.Variables
-	Templates        []Template
+	// OpenTofu is whether the root module is generated for OpenTofu instead of
+	// Terraform
+	OpenTofu     bool
+	Backend      map[string]interface{}
+	Providers    []hcltmpl.NamedBlock
+	ProviderInfo map[string]interface{}
+	Task         Task
+	Variables    hcltmpl.Variables
+	Templates    []Template
 
 	Path      string
 	FilePerms os.FileMode
@@ -211,8 +218,13 @@ func newMainTF(w io.Writer, filename string, input *RootModuleInputData) error {
 
 	hclFile := hclwrite.NewEmptyFile()
 	rootBody := hclFile.Body()
+	requiredVersion := TerraformRequiredVersion
+	if input.OpenTofu {
+		requiredVersion = OpenTofuRequiredVersion
+	}
+
 	rootBody.AppendNewline()
-	appendRootTerraformBlock(rootBody, input.backend, input.ProviderInfo)
+	appendRootTerraformBlock(rootBody, requiredVersion, input.backend, input.ProviderInfo)
 	rootBody.AppendNewline()
 	appendRootProviderBlocks(rootBody, input.Providers)
 	rootBody.AppendNewline()
@@ -227,12 +239,12 @@ func newMainTF(w io.Writer, filename string, input *RootModuleInputData) error {
 
 // appendRootTerraformBlock appends the Terraform block with version constraint
 // and backend.
-func appendRootTerraformBlock(body *hclwrite.Body, backend *hcltmpl.NamedBlock,
-	providerInfo map[string]interface{}) {
+func appendRootTerraformBlock(body *hclwrite.Body, requiredVersion string,
+	backend *hcltmpl.NamedBlock, providerInfo map[string]interface{}) {
 
 	tfBlock := body.AppendNewBlock("terraform", nil)
 	tfBody := tfBlock.Body()
-	tfBody.SetAttributeValue("required_version", cty.StringVal(TerraformRequiredVersion))
+	tfBody.SetAttributeValue("required_version", cty.StringVal(requiredVersion))
 
 	if len(providerInfo) != 0 {
 		requiredProvidersBody := tfBody.AppendNewBlock("required_providers", nil).Body()
@@ -104,7 +104,7 @@ func TestAppendRootTerraformBlock_backend(t *testing.T) {
 				b := hcltmpl.NewNamedBlock(tc.rawBackend)
 				backend = &b
 			}
-			appendRootTerraformBlock(body, backend, nil)
+			appendRootTerraformBlock(body, TerraformRequiredVersion, backend, nil)
 
 			content := hclFile.Bytes()
 			content = hclwrite.Format(content)
@@ -113,6 +113,26 @@ func TestAppendRootTerraformBlock_backend(t *testing.T) {
 	}
 }
 
+func TestAppendRootTerraformBlock_openTofu(t *testing.T) {
+	backend := hcltmpl.NewNamedBlock(map[string]interface{}{
+		"local": map[string]interface{}{
+			"path": "terraform.tfstate",
+		},
+	})
+
+	hclFile := hclwrite.NewEmptyFile()
+	appendRootTerraformBlock(hclFile.Body(), OpenTofuRequiredVersion, &backend, nil)
+
+	expected := `terraform {
+  required_version = ">= 1.6.0, <= 1.10.6"
+  backend "local" {
+    path = "terraform.tfstate"
+  }
+}
+`
+	assert.Equal(t, expected, string(hclwrite.Format(hclFile.Bytes())))
+}
+
 func TestAppendRootProviderBlocks(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// SignificantFieldsFunc returns the significant fields of the instances of a
+// service by the service name, e.g. "address" and "port". Returns nil when all
+// of the fields of the instances are significant.
+type SignificantFieldsFunc func(service string) []string
+
+// SignificantChange compares the contents of two rendered terraform.tfvars
+// files and returns whether they differ in a significant way. Changes to the
+// instances of the services variable are only significant when an instance is
+// added or removed, or when one of the significant fields of the instance
+// changes. Changes to the other variables are always significant.
+func SignificantChange(prev, next []byte, fields SignificantFieldsFunc) (bool, error) {
+	prevVars, err := parseTFVars(prev)
+	if err != nil {
+		return true, err
+	}
+	nextVars, err := parseTFVars(next)
+	if err != nil {
+		return true, err
+	}
+
+	if len(prevVars) != len(nextVars) {
+		return true, nil
+	}
+	for name, nextVal := range nextVars {
+		prevVal, ok := prevVars[name]
+		if !ok {
+			return true, nil
+		}
+		if name == "services" {
+			if significantServicesChange(prevVal, nextVal, fields) {
+				return true, nil
+			}
+			continue
+		}
+		if !prevVal.RawEquals(nextVal) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseTFVars parses the contents of a terraform.tfvars file into the values
+// of its variables
+func parseTFVars(content []byte) (map[string]cty.Value, error) {
+	file, diags := hclsyntax.ParseConfig(content, TFVarsFilename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("unable to parse %s: %s", TFVarsFilename, diags.Error())
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("unable to parse %s: %s", TFVarsFilename, diags.Error())
+	}
+
+	vars := make(map[string]cty.Value, len(attrs))
+	for name, attr := range attrs {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("unable to evaluate variable %q: %s",
+				name, diags.Error())
+		}
+		vars[name] = val
+	}
+	return vars, nil
+}
+
+// significantServicesChange returns whether the instances of the services
+// variable differ in a significant way
+func significantServicesChange(prev, next cty.Value, fields SignificantFieldsFunc) bool {
+	prevInstances := instancesByKey(prev)
+	nextInstances := instancesByKey(next)
+	if prevInstances == nil || nextInstances == nil {
+		return !prev.RawEquals(next)
+	}
+
+	if len(prevInstances) != len(nextInstances) {
+		return true
+	}
+	for key, nextInst := range nextInstances {
+		prevInst, ok := prevInstances[key]
+		if !ok {
+			return true
+		}
+
+		significant := fields(instanceName(nextInst))
+		if significant == nil {
+			if !prevInst.RawEquals(nextInst) {
+				return true
+			}
+			continue
+		}
+		for _, field := range significant {
+			if !attributeEquals(prevInst, nextInst, field) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// instancesByKey returns the service instances of the services variable by
+// their key. Returns nil if the value is not a map or object of instances.
+func instancesByKey(v cty.Value) map[string]cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+
+	instances := make(map[string]cty.Value)
+	for it := v.ElementIterator(); it.Next(); {
+		k, inst := it.Element()
+		if k.Type() != cty.String {
+			return nil
+		}
+		instances[k.AsString()] = inst
+	}
+	return instances
+}
+
+// instanceName returns the name of the service of an instance, or an empty
+// string if the name is not rendered
+func instanceName(inst cty.Value) string {
+	if !inst.Type().IsObjectType() || !inst.Type().HasAttribute("name") {
+		return ""
+	}
+	name := inst.GetAttr("name")
+	if name.IsNull() || !name.IsKnown() || name.Type() != cty.String {
+		return ""
+	}
+	return name.AsString()
+}
+
+// attributeEquals returns whether an attribute of two instances is equal,
+// including when the attribute is missing from both
+func attributeEquals(a, b cty.Value, name string) bool {
+	aOK := a.Type().IsObjectType() && a.Type().HasAttribute(name)
+	bOK := b.Type().IsObjectType() && b.Type().HasAttribute(name)
+	if aOK != bOK {
+		return false
+	}
+	if !aOK {
+		return true
+	}
+	return a.GetAttr(name).RawEquals(b.GetAttr(name))
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignificantChange(t *testing.T) {
+	t.Parallel()
+
+	// renders a terraform.tfvars file with an instance of the api service and
+	// an instance of the web service
+	tfvars := func(apiAddress, apiNodeMeta, webAddress, consulKV string) []byte {
+		return []byte(fmt.Sprintf(`
+# This file is generated by Consul-Terraform-Sync.
+
+services = {
+  "api.node-a.default.dc1" = {
+    id        = "api"
+    name      = "api"
+    address   = "%s"
+    port      = 8080
+    meta      = {}
+    tags      = ["v1"]
+    status    = "passing"
+    node_meta = {
+      rack = "%s"
+    }
+  },
+  "web.node-a.default.dc1" = {
+    id      = "web"
+    name    = "web"
+    address = "%s"
+    port    = 80
+    status  = "passing"
+  },
+}
+
+consul_kv = {
+  "key" = "%s"
+}
+`, apiAddress, apiNodeMeta, webAddress, consulKV))
+	}
+	prev := tfvars("10.0.0.1", "r1", "10.0.0.2", "value")
+
+	apiAddressOnly := func(service string) []string {
+		if service == "api" {
+			return []string{"address"}
+		}
+		return nil
+	}
+
+	cases := []struct {
+		name      string
+		next      []byte
+		fields    SignificantFieldsFunc
+		expected  bool
+		expectErr bool
+	}{
+		{
+			"no change",
+			prev,
+			apiAddressOnly,
+			false,
+			false,
+		},
+		{
+			"insignificant field changed",
+			tfvars("10.0.0.1", "r2", "10.0.0.2", "value"),
+			apiAddressOnly,
+			false,
+			false,
+		},
+		{
+			"significant field changed",
+			tfvars("10.0.0.9", "r1", "10.0.0.2", "value"),
+			apiAddressOnly,
+			true,
+			false,
+		},
+		{
+			"service without significant fields changed",
+			tfvars("10.0.0.1", "r1", "10.0.0.9", "value"),
+			apiAddressOnly,
+			true,
+			false,
+		},
+		{
+			"other variable changed",
+			tfvars("10.0.0.1", "r1", "10.0.0.2", "new-value"),
+			apiAddressOnly,
+			true,
+			false,
+		},
+		{
+			"instance deregistered",
+			[]byte(`
+services = {
+  "api.node-a.default.dc1" = {
+    id        = "api"
+    name      = "api"
+    address   = "10.0.0.1"
+    port      = 8080
+    meta      = {}
+    tags      = ["v1"]
+    status    = "passing"
+    node_meta = {
+      rack = "r1"
+    }
+  },
+}
+
+consul_kv = {
+  "key" = "value"
+}
+`),
+			apiAddressOnly,
+			true,
+			false,
+		},
+		{
+			"variable removed",
+			[]byte(`
+services = {
+}
+`),
+			apiAddressOnly,
+			true,
+			false,
+		},
+		{
+			"invalid contents",
+			[]byte(`services = {`),
+			apiAddressOnly,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, err := SignificantChange(prev, tc.next, tc.fields)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, changed)
+		})
+	}
+}
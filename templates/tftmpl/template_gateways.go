@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*GatewaysTemplate)(nil)
+)
+
+// GatewaysTemplate handles the template for the gateways variable for the
+// template function: `{{ gateways }}`. The function is called once for each
+// gateway name and the results are combined into one variable.
+type GatewaysTemplate struct {
+	Names      []string
+	Datacenter string
+	Namespace  string
+
+	// RenderVar informs whether the template should render the variable or not.
+	// Aligns with the task condition configuration `UseAsModuleInput``
+	RenderVar bool
+}
+
+// IsServicesVar returns false because the template returns a gateways
+// variable, not a services variable
+func (t GatewaysTemplate) IsServicesVar() bool {
+	return false
+}
+
+func (t GatewaysTemplate) RendersVar() bool {
+	return t.RenderVar
+}
+
+func (t GatewaysTemplate) appendModuleAttribute(body *hclwrite.Body) {
+	body.SetAttributeTraversal("gateways", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "gateways"},
+	})
+}
+
+func (t GatewaysTemplate) appendTemplate(w io.Writer) error {
+	baseTmpl := gatewaysEmptyTmpl
+	if t.RenderVar {
+		baseTmpl = gatewaysBaseTmpl
+	}
+
+	var tmpl strings.Builder
+	for _, q := range t.hcatQueries() {
+		fmt.Fprintf(&tmpl, baseTmpl, q)
+	}
+
+	if t.RenderVar {
+		_, err := fmt.Fprintf(w, gatewaysSetVarTmpl, tmpl.String())
+		if err != nil {
+			err = fmt.Errorf("unable to write gateways template with variable, error: %v", err)
+			return err
+		}
+		return nil
+	}
+
+	if _, err := fmt.Fprint(w, tmpl.String()); err != nil {
+		err = fmt.Errorf("unable to write gateways empty template, error %v", err)
+		return err
+	}
+	return nil
+}
+
+func (t GatewaysTemplate) appendVariable(w io.Writer) error {
+	_, err := w.Write(variableGateways)
+	return err
+}
+
+// hcatQueries returns the options of the template function for each gateway
+func (t GatewaysTemplate) hcatQueries() []string {
+	queries := make([]string, 0, len(t.Names))
+	for _, n := range t.Names {
+		name := strings.ReplaceAll(n, `"`, `\"`)
+		opts := []string{fmt.Sprintf("name=%s", name)}
+
+		if t.Datacenter != "" {
+			opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+		}
+
+		if t.Namespace != "" {
+			opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
+		}
+
+		queries = append(queries, `"`+strings.Join(opts, `" "`)+`"`)
+	}
+	return queries
+}
+
+const gatewaysSetVarTmpl = `
+gateways = {%s}
+`
+
+const gatewaysBaseTmpl = `
+{{- with $gws := gateways %s }}
+  {{- range $g := $gws }}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLGateway $g | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+`
+
+const gatewaysEmptyTmpl = `
+{{- with $gws := gateways %s }}
+  {{- range $g := $gws }}
+  {{- /* Empty template. Detects changes in gateways */ -}}
+  {{- end}}
+{{- end}}
+`
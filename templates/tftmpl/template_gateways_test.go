@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewaysTemplate_hcatQueries(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *GatewaysTemplate
+		exp  []string
+	}{
+		{
+			"empty",
+			&GatewaysTemplate{},
+			[]string{},
+		},
+		{
+			"names",
+			&GatewaysTemplate{
+				Names: []string{"mesh-gateway", "ingress-gateway"},
+			},
+			[]string{
+				`"name=mesh-gateway"`,
+				`"name=ingress-gateway"`,
+			},
+		},
+		{
+			"all_parameters",
+			&GatewaysTemplate{
+				Names:      []string{"mesh-gateway"},
+				Datacenter: "dc1",
+				Namespace:  "ns1",
+			},
+			[]string{`"name=mesh-gateway" "dc=dc1" "ns=ns1"`},
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQueries()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*IntentionsTemplate)(nil)
+)
+
+// IntentionsTemplate handles the template for the intentions variable for the
+// template function: `{{ intentions }}`
+type IntentionsTemplate struct {
+	SourceServices      []string
+	DestinationServices []string
+	Datacenter          string
+	Namespace           string
+
+	// RenderVar informs whether the template should render the variable or not.
+	// Aligns with the task condition configuration `UseAsModuleInput``
+	RenderVar bool
+}
+
+// IsServicesVar returns false because the template returns an intentions
+// variable, not a services variable
+func (t IntentionsTemplate) IsServicesVar() bool {
+	return false
+}
+
+func (t IntentionsTemplate) RendersVar() bool {
+	return t.RenderVar
+}
+
+func (t IntentionsTemplate) appendModuleAttribute(body *hclwrite.Body) {
+	body.SetAttributeTraversal("intentions", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "intentions"},
+	})
+}
+
+func (t IntentionsTemplate) appendTemplate(w io.Writer) error {
+	q := t.hcatQuery()
+
+	if t.RenderVar {
+		_, err := fmt.Fprintf(w, intentionsSetVarTmpl, q)
+		if err != nil {
+			err = fmt.Errorf("unable to write intentions template with variable, error: %v", err)
+			return err
+		}
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w, intentionsEmptyTmpl, q); err != nil {
+		err = fmt.Errorf("unable to write intentions empty template, error %v", err)
+		return err
+	}
+	return nil
+}
+
+func (t IntentionsTemplate) appendVariable(w io.Writer) error {
+	_, err := w.Write(variableIntentions)
+	return err
+}
+
+func (t IntentionsTemplate) hcatQuery() string {
+	var opts []string
+
+	for _, s := range t.SourceServices {
+		opts = append(opts, fmt.Sprintf("source=%s", s))
+	}
+
+	for _, s := range t.DestinationServices {
+		opts = append(opts, fmt.Sprintf("destination=%s", s))
+	}
+
+	if t.Datacenter != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+	}
+
+	if t.Namespace != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
+	}
+
+	if len(opts) > 0 {
+		return `"` + strings.Join(opts, `" "`) + `" ` // deliberate space at end
+	}
+	return ""
+}
+
+var intentionsSetVarTmpl = fmt.Sprintf(`
+intentions = {%s}
+`, intentionsBaseTmpl)
+
+// intentionsBaseTmpl keys each intention by its source and destination since
+// intentions managed as config entries do not have an ID.
+const intentionsBaseTmpl = `
+{{- with $intentions := intentions %s}}
+  {{- range $ixn := $intentions }}
+  "{{ joinStrings "/" $ixn.SourcePeer $ixn.SourcePartition $ixn.SourceNS $ixn.SourceName }}:{{ joinStrings "/" $ixn.DestinationPartition $ixn.DestinationNS $ixn.DestinationName }}" = {
+{{ HCLIntention $ixn | indent 4 }}
+  },
+{{- end}}{{- end}}
+`
+
+const intentionsEmptyTmpl = `
+{{- with $intentions := intentions %s}}
+  {{- range $ixn := $intentions }}
+    {{- /* Empty template. Detects changes in intentions */ -}}
+{{- end}}{{- end}}
+`
+
+// variableIntentions is required for modules that include intentions
+// information. It is versioned to track compatibility between the generated
+// root module and modules that include intentions.
+var variableIntentions = []byte(`
+# Intentions definition protocol v0
+variable "intentions" {
+  description = "Consul intentions between source and destination services"
+  type = map(
+    object({
+      id                    = string
+      description           = string
+      source_name           = string
+      source_namespace      = string
+      source_partition      = string
+      source_peer           = string
+      source_type           = string
+      destination_name      = string
+      destination_namespace = string
+      destination_partition = string
+      action                = string
+      precedence            = number
+      meta                  = map(string)
+    })
+  )
+}
+`)
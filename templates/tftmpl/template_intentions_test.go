@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntentionsTemplate_appendTemplate(t *testing.T) {
+	testcases := []struct {
+		name string
+		c    *IntentionsTemplate
+		exp  string
+	}{
+		{
+			"fully configured & render var",
+			&IntentionsTemplate{
+				SourceServices:      []string{"api"},
+				DestinationServices: []string{"db"},
+				Datacenter:          "dc1",
+				Namespace:           "ns1",
+				RenderVar:           true,
+			},
+			`
+intentions = {
+{{- with $intentions := intentions "source=api" "destination=db" "dc=dc1" "ns=ns1" }}
+  {{- range $ixn := $intentions }}
+  "{{ joinStrings "/" $ixn.SourcePeer $ixn.SourcePartition $ixn.SourceNS $ixn.SourceName }}:{{ joinStrings "/" $ixn.DestinationPartition $ixn.DestinationNS $ixn.DestinationName }}" = {
+{{ HCLIntention $ixn | indent 4 }}
+  },
+{{- end}}{{- end}}
+}
+`,
+		},
+		{
+			"fully configured & no var",
+			&IntentionsTemplate{
+				SourceServices:      []string{"api"},
+				DestinationServices: []string{"db"},
+				Datacenter:          "dc1",
+				Namespace:           "ns1",
+				RenderVar:           false,
+			},
+			`
+{{- with $intentions := intentions "source=api" "destination=db" "dc=dc1" "ns=ns1" }}
+  {{- range $ixn := $intentions }}
+    {{- /* Empty template. Detects changes in intentions */ -}}
+{{- end}}{{- end}}
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			err := tc.c.appendTemplate(w)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, w.String())
+		})
+	}
+}
+
+func TestIntentionsTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *IntentionsTemplate
+		exp  string
+	}{
+		{
+			"empty",
+			&IntentionsTemplate{},
+			"",
+		},
+		{
+			"sources only",
+			&IntentionsTemplate{
+				SourceServices: []string{"api", "web"},
+			},
+			`"source=api" "source=web" `,
+		},
+		{
+			"all_parameters",
+			&IntentionsTemplate{
+				SourceServices:      []string{"api"},
+				DestinationServices: []string{"db"},
+				Datacenter:          "datacenter",
+				Namespace:           "namespace",
+			},
+			`"source=api" "destination=db" "dc=datacenter" "ns=namespace" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *NodesTemplate
+		exp  string
+	}{
+		{
+			"empty",
+			&NodesTemplate{},
+			"",
+		},
+		{
+			"node-meta sorted",
+			&NodesTemplate{
+				NodeMeta: map[string]string{"rack": "rack-1", "env": "prod"},
+			},
+			`"node-meta=env:prod" "node-meta=rack:rack-1" `,
+		},
+		{
+			"escaped filter",
+			&NodesTemplate{
+				Filter: "Node != \"worker-01\"\n",
+			},
+			`"Node != \"worker-01\"" `,
+		},
+		{
+			"all_parameters",
+			&NodesTemplate{
+				Datacenter: "datacenter",
+				NodeMeta:   map[string]string{"key": "value"},
+				Filter:     "Meta.env == prod",
+			},
+			`"dc=datacenter" "node-meta=key:value" "Meta.env == prod" `,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
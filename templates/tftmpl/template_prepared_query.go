@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/consul-terraform-sync/logging"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var (
+	_ Template = (*PreparedQueryTemplate)(nil)
+)
+
+// PreparedQueryTemplate handles the template for the services variable for the
+// template function: `{{ preparedQuery }}`. The results of the prepared query
+// are rendered in the same format as the other services templates.
+type PreparedQueryTemplate struct {
+	Name       string
+	Datacenter string
+	Near       string
+
+	// RenderVar informs whether the template should render the variable or not.
+	// Aligns with the task condition configuration `UseAsModuleInput``
+	RenderVar bool
+}
+
+// IsServicesVar returns true because the template is for the services variable
+func (t PreparedQueryTemplate) IsServicesVar() bool {
+	return true
+}
+
+func (t PreparedQueryTemplate) appendModuleAttribute(*hclwrite.Body) {}
+
+func (t PreparedQueryTemplate) appendTemplate(w io.Writer) error {
+	q := t.hcatQuery()
+
+	tmpl := ""
+	if t.RenderVar {
+		tmpl = fmt.Sprintf(preparedQuerySetVarTmpl, q)
+	} else {
+		tmpl = fmt.Sprintf(preparedQueryEmptyTmpl, q)
+	}
+
+	if _, err := fmt.Fprint(w, tmpl); err != nil {
+		logging.Global().Named(logSystemName).Named(tftmplSubsystemName).Error(
+			"unable to write prepared query template", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (t PreparedQueryTemplate) appendVariable(io.Writer) error {
+	return nil
+}
+
+func (t PreparedQueryTemplate) RendersVar() bool {
+	return t.RenderVar
+}
+
+func (t PreparedQueryTemplate) hcatQuery() string {
+	name := strings.ReplaceAll(t.Name, `"`, `\"`)
+	opts := []string{fmt.Sprintf("name=%s", name)}
+
+	if t.Datacenter != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+	}
+
+	if t.Near != "" {
+		opts = append(opts, fmt.Sprintf("near=%s", t.Near))
+	}
+
+	return `"` + strings.Join(opts, `" "`) + `"`
+}
+
+var preparedQuerySetVarTmpl = fmt.Sprintf(`
+services = {%s}
+`, preparedQueryBaseTmpl)
+
+const preparedQueryBaseTmpl = `
+{{- with $srv := preparedQuery %s }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+`
+
+const preparedQueryEmptyTmpl = `
+{{- with $srv := preparedQuery %s }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
+`
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tftmpl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreparedQueryTemplate_appendTemplate(t *testing.T) {
+	testcases := []struct {
+		name string
+		c    *PreparedQueryTemplate
+		exp  string
+	}{
+		{
+			"fully configured & render var",
+			&PreparedQueryTemplate{
+				Name:       "api-failover",
+				Datacenter: "dc1",
+				Near:       "_agent",
+				RenderVar:  true,
+			},
+			`
+services = {
+{{- with $srv := preparedQuery "name=api-failover" "dc=dc1" "near=_agent" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+}
+`,
+		},
+		{
+			"fully configured & no var",
+			&PreparedQueryTemplate{
+				Name:       "api-failover",
+				Datacenter: "dc1",
+				Near:       "_agent",
+				RenderVar:  false,
+			},
+			`
+{{- with $srv := preparedQuery "name=api-failover" "dc=dc1" "near=_agent" }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			err := tc.c.appendTemplate(w)
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, w.String())
+		})
+	}
+}
+
+func TestPreparedQueryTemplate_hcatQuery(t *testing.T) {
+	testcase := []struct {
+		name string
+		c    *PreparedQueryTemplate
+		exp  string
+	}{
+		{
+			"name only",
+			&PreparedQueryTemplate{
+				Name: "api-failover",
+			},
+			`"name=api-failover"`,
+		},
+		{
+			"all_parameters",
+			&PreparedQueryTemplate{
+				Name:       "api-failover",
+				Datacenter: "datacenter",
+				Near:       "_agent",
+			},
+			`"name=api-failover" "dc=datacenter" "near=_agent"`,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.c.hcatQuery()
+			assert.Equal(t, tc.exp, actual)
+		})
+	}
+}
@@ -33,6 +33,12 @@ type ServicesTemplate struct {
 	Namespace  string
 	Filter     string
 
+	// Optional sources to aggregate the service instances from. The instances
+	// are queried from each of the Datacenters instead of Datacenter, and
+	// additionally from each of the cluster Peers.
+	Datacenters []string
+	Peers       []string
+
 	// Deprecated in 0.5 - optional per service filtering configured through the
 	// task's services list. Not all services configured in Names must have
 	// filtering configured. Services or the set of {Datacenter,
@@ -78,7 +84,8 @@ func (t ServicesTemplate) appendTemplate(w io.Writer) error {
 func (t ServicesTemplate) concatServiceTemplates() (string, error) {
 	// double-check that service query parameter is configured in only one way
 	// the current way or the deprecated way
-	isCurrent := t.Datacenter != "" || t.Namespace != "" || t.Filter != ""
+	isCurrent := t.Datacenter != "" || t.Namespace != "" || t.Filter != "" ||
+		len(t.Datacenters) > 0 || len(t.Peers) > 0
 	isDeprecated := t.Services != nil
 
 	if isCurrent && isDeprecated {
@@ -95,18 +102,29 @@ func (t ServicesTemplate) concatServiceTemplates() (string, error) {
 
 	tmpl := ""
 	for _, n := range t.Names {
-		var query string
-		if t.Services == nil {
-			query = t.hcatQuery(n, t.Datacenter, t.Namespace, t.Filter)
-		} else {
+		if t.Services != nil {
 			s := t.Services[n]
-			query = t.hcatQuery(n, s.Datacenter, s.Namespace, s.Filter)
+			query := t.hcatQuery(n, s.Datacenter, s.Namespace, s.Filter)
+			tmpl += t.serviceTemplate(query)
+			continue
 		}
 
-		if t.RenderVar {
-			tmpl += fmt.Sprintf(serviceBaseTmpl, query)
-		} else {
-			tmpl += fmt.Sprintf(serviceEmptyTmpl, query)
+		dcs := t.Datacenters
+		if len(dcs) == 0 {
+			dcs = []string{t.Datacenter}
+		}
+		for _, dc := range dcs {
+			query := t.hcatQuery(n, dc, t.Namespace, t.Filter)
+			tmpl += t.serviceTemplate(query)
+		}
+
+		for _, peer := range t.Peers {
+			query := t.hcatPeerQuery(n, peer, t.Datacenter, t.Namespace, t.Filter)
+			if t.RenderVar {
+				tmpl += fmt.Sprintf(peerServiceBaseTmpl, query, escapeQuotes(peer))
+			} else {
+				tmpl += fmt.Sprintf(peerServiceEmptyTmpl, query)
+			}
 		}
 	}
 
@@ -116,6 +134,14 @@ func (t ServicesTemplate) concatServiceTemplates() (string, error) {
 	return tmpl, nil
 }
 
+// serviceTemplate returns the template for a single service query
+func (t ServicesTemplate) serviceTemplate(query string) string {
+	if t.RenderVar {
+		return fmt.Sprintf(serviceBaseTmpl, query)
+	}
+	return fmt.Sprintf(serviceEmptyTmpl, query)
+}
+
 func (t ServicesTemplate) appendVariable(io.Writer) error {
 	return nil
 }
@@ -149,6 +175,31 @@ func (t ServicesTemplate) hcatQuery(name, dc, ns, filter string) string {
 	return ""
 }
 
+func (t ServicesTemplate) hcatPeerQuery(name, peer, dc, ns, filter string) string {
+	opts := []string{name, fmt.Sprintf("peer=%s", escapeQuotes(peer))}
+
+	if dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", dc))
+	}
+
+	if ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", ns))
+	}
+
+	if filter != "" {
+		filter := strings.ReplaceAll(filter, `"`, `\"`)
+		filter = strings.Trim(filter, "\n")
+		opts = append(opts, filter)
+	}
+
+	return `"` + strings.Join(opts, `" "`) + `"`
+}
+
+// escapeQuotes escapes the double quotes of a string literal in a template
+func escapeQuotes(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
 // servicesSetVarTmpl expects a concatenation of serviceBaseTmpl or
 // serviceEmptyTmpl for each monitored service at '%s'
 const servicesSetVarTmpl = `
@@ -178,3 +229,27 @@ const serviceEmptyTmpl = `
   {{- /* Empty template. Detects changes in Services */ -}}
   {{- end}}
 {{- end}}`
+
+// peerServiceBaseTmpl is a template for a single monitored service imported
+// from a cluster peer. Each service is annotated with the name of the peer,
+// which is also included in the key to keep the instances of the peers unique.
+// There is no newline at the end of this template to prevent a gap in the
+// templates
+const peerServiceBaseTmpl = `
+{{- with $srv := peerService %[1]s }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter "%[2]s" }}" = {
+{{ HCLService $s "%[2]s" | indent 4 }}
+  },
+  {{- end}}
+{{- end}}`
+
+// peerServiceEmptyTmpl is a template for a single monitored service imported
+// from a cluster peer. There is no newline at the end of this template to
+// prevent a gap in the templates
+const peerServiceEmptyTmpl = `
+{{- with $srv := peerService %s }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}`
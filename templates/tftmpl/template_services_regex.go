@@ -24,6 +24,16 @@ type ServicesRegexTemplate struct {
 	Namespace  string
 	Filter     string
 
+	// Optional sources to aggregate the service instances from. The instances
+	// are queried from each of the Datacenters instead of Datacenter, and
+	// additionally from each of the cluster Peers.
+	Datacenters []string
+	Peers       []string
+
+	// AllStatuses queries the service instances of any health status instead
+	// of only the passing instances, e.g. to monitor health transitions
+	AllStatuses bool
+
 	// RenderVar informs whether the template should render the variable or not.
 	// Aligns with the task condition configuration `UseAsModuleInput``
 	RenderVar bool
@@ -37,13 +47,16 @@ func (t ServicesRegexTemplate) IsServicesVar() bool {
 func (t ServicesRegexTemplate) appendModuleAttribute(*hclwrite.Body) {}
 
 func (t ServicesRegexTemplate) appendTemplate(w io.Writer) error {
-	q := t.hcatQuery()
-
 	tmpl := ""
-	if t.RenderVar {
-		tmpl = fmt.Sprintf(servicesRegexSetVarTmpl, q)
+	if len(t.Datacenters) == 0 && len(t.Peers) == 0 {
+		q := t.hcatQuery()
+		if t.RenderVar {
+			tmpl = fmt.Sprintf(servicesRegexSetVarTmpl, q)
+		} else {
+			tmpl = fmt.Sprintf(servicesRegexEmptyTmpl, q)
+		}
 	} else {
-		tmpl = fmt.Sprintf(servicesRegexEmptyTmpl, q)
+		tmpl = t.aggregateTemplate()
 	}
 
 	if _, err := fmt.Fprint(w, tmpl); err != nil {
@@ -62,20 +75,70 @@ func (t ServicesRegexTemplate) RendersVar() bool {
 	return t.RenderVar
 }
 
+// aggregateTemplate returns the template that concatenates a query for each
+// of the datacenters and peers to aggregate the service instances from
+func (t ServicesRegexTemplate) aggregateTemplate() string {
+	dcs := t.Datacenters
+	if len(dcs) == 0 {
+		dcs = []string{t.Datacenter}
+	}
+
+	tmpl := ""
+	for _, dc := range dcs {
+		q := t.query(dc, "")
+		if t.RenderVar {
+			tmpl += fmt.Sprintf(servicesRegexBaseTmpl, q)
+		} else {
+			tmpl += fmt.Sprintf(servicesRegexEmptyTmpl, q)
+		}
+	}
+
+	for _, peer := range t.Peers {
+		q := t.query(t.Datacenter, peer)
+		if t.RenderVar {
+			tmpl += fmt.Sprintf(servicesRegexPeerBaseTmpl, q, escapeQuotes(peer))
+		} else {
+			tmpl += fmt.Sprintf(servicesRegexEmptyTmpl, q)
+		}
+	}
+
+	if t.RenderVar {
+		return fmt.Sprintf(servicesSetVarTmpl, tmpl)
+	}
+	return tmpl
+}
+
 func (t ServicesRegexTemplate) hcatQuery() string {
+	return t.query(t.Datacenter, "")
+}
+
+// query returns the query options of the template function for the
+// datacenter and peer
+func (t ServicesRegexTemplate) query(dc, peer string) string {
 	var opts []string
 
-	// Support regexp == "" (same as a wildcard)
-	opts = append(opts, fmt.Sprintf("regexp=%s", t.Regexp))
+	// Support regexp == "" (same as a wildcard). Escape the regexp since it is
+	// a string literal within the template e.g. regexp=^api\.v1$
+	regexp := strings.ReplaceAll(t.Regexp, `\`, `\\`)
+	regexp = strings.ReplaceAll(regexp, `"`, `\"`)
+	opts = append(opts, fmt.Sprintf("regexp=%s", regexp))
 
-	if t.Datacenter != "" {
-		opts = append(opts, fmt.Sprintf("dc=%s", t.Datacenter))
+	if dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", dc))
 	}
 
 	if t.Namespace != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", t.Namespace))
 	}
 
+	if peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", escapeQuotes(peer)))
+	}
+
+	if t.AllStatuses {
+		opts = append(opts, "status=any")
+	}
+
 	if t.Filter != "" {
 		filter := strings.ReplaceAll(t.Filter, `"`, `\"`)
 		filter = strings.Trim(filter, "\n")
@@ -102,6 +165,19 @@ const servicesRegexBaseTmpl = `
 {{- end}}
 `
 
+// servicesRegexPeerBaseTmpl is the template for the services imported from a
+// cluster peer. Each service is annotated with the name of the peer, which is
+// also included in the key to keep the instances of the peers unique.
+const servicesRegexPeerBaseTmpl = `
+{{- with $srv := servicesRegex %[1]s }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter "%[2]s" }}" = {
+{{ HCLService $s "%[2]s" | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+`
+
 const servicesRegexEmptyTmpl = `
 {{- with $srv := servicesRegex %s }}
   {{- range $s := $srv}}
@@ -53,6 +53,63 @@ services = {
   {{- /* Empty template. Detects changes in Services */ -}}
   {{- end}}
 {{- end}}
+`,
+		},
+		{
+			"datacenters & peers & render var",
+			&ServicesRegexTemplate{
+				Regexp:      "^api$",
+				Datacenters: []string{"dc1", "dc2"},
+				Peers:       []string{"peer-a"},
+				RenderVar:   true,
+			},
+			`
+services = {
+{{- with $srv := servicesRegex "regexp=^api$" "dc=dc1" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+
+{{- with $srv := servicesRegex "regexp=^api$" "dc=dc2" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+
+{{- with $srv := servicesRegex "regexp=^api$" "peer=peer-a" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter "peer-a" }}" = {
+{{ HCLService $s "peer-a" | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+}
+`,
+		},
+		{
+			"peers & no var",
+			&ServicesRegexTemplate{
+				Regexp:    "^api$",
+				Peers:     []string{"peer-a"},
+				RenderVar: false,
+			},
+			`
+{{- with $srv := servicesRegex "regexp=^api$" }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
+
+{{- with $srv := servicesRegex "regexp=^api$" "peer=peer-a" }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
 `,
 		},
 		{
@@ -101,6 +158,13 @@ func TestServicesRegexTemplate_hcatQuery(t *testing.T) {
 			},
 			`"regexp="`,
 		},
+		{
+			"escaped regexp",
+			&ServicesRegexTemplate{
+				Regexp: `^(?:api\.v1|"web")$`,
+			},
+			`"regexp=^(?:api\\.v1|\"web\")$"`,
+		},
 		{
 			"all_parameters",
 			&ServicesRegexTemplate{
@@ -111,6 +175,14 @@ func TestServicesRegexTemplate_hcatQuery(t *testing.T) {
 			},
 			`"regexp=.*" "dc=datacenter" "ns=namespace" "filter"`,
 		},
+		{
+			"all statuses",
+			&ServicesRegexTemplate{
+				Regexp:      ".*",
+				AllStatuses: true,
+			},
+			`"regexp=.*" "status=any"`,
+		},
 	}
 
 	for _, tc := range testcase {
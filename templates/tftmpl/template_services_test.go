@@ -146,6 +146,60 @@ func TestServicesTemplate_concatServiceTemplates(t *testing.T) {
   {{- /* Empty template. Detects changes in Services */ -}}
   {{- end}}
 {{- end}}
+`,
+		},
+		{
+			"datacenters & peers & render var",
+			&ServicesTemplate{
+				Names:       []string{"api"},
+				Datacenters: []string{"dc1", "dc2"},
+				Peers:       []string{"peer-a"},
+				Filter:      "filter",
+				RenderVar:   true,
+			},
+			`
+{{- with $srv := service "api" "dc=dc1" "filter" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+{{- with $srv := service "api" "dc=dc2" "filter" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter }}" = {
+{{ HCLService $s | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+{{- with $srv := peerService "api" "peer=peer-a" "filter" }}
+  {{- range $s := $srv}}
+  "{{ joinStrings "." .ID .Node .Namespace .NodeDatacenter "peer-a" }}" = {
+{{ HCLService $s "peer-a" | indent 4 }}
+  },
+  {{- end}}
+{{- end}}
+`,
+		},
+		{
+			"peers & no var",
+			&ServicesTemplate{
+				Names:      []string{"api"},
+				Datacenter: "dc1",
+				Peers:      []string{"peer-a"},
+				RenderVar:  false,
+			},
+			`
+{{- with $srv := service "api" "dc=dc1" }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
+{{- with $srv := peerService "api" "peer=peer-a" "dc=dc1" }}
+  {{- range $s := $srv}}
+  {{- /* Empty template. Detects changes in Services */ -}}
+  {{- end}}
+{{- end}}
 `,
 		},
 	}
@@ -183,6 +237,14 @@ func TestServicesTemplate_concatServiceTemplates_error(t *testing.T) {
 				Services:  map[string]Service{},
 			},
 		},
+		{
+			"peers & services configured",
+			&ServicesTemplate{
+				Names:    []string{"api"},
+				Peers:    []string{"peer-a"},
+				Services: map[string]Service{},
+			},
+		},
 		{
 			"filter & services configured",
 			&ServicesTemplate{
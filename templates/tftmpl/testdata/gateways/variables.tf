@@ -0,0 +1,72 @@
+# This file is generated by Consul-Terraform-Sync.
+#
+# The HCL blocks, arguments, variables, and values are derived from the
+# operator configuration for Consul-Terraform-Sync. Any manual changes to
+# this file may not be preserved and could be overwritten by a subsequent
+# update.
+#
+# Task: test
+# Description: user description for task named 'test'
+
+# Service definition protocol v1
+variable "services" {
+  description = "Consul services monitored by Consul-Terraform-Sync"
+  type = map(
+    object({
+      id        = string
+      name      = string
+      kind      = string
+      address   = string
+      port      = number
+      meta      = map(string)
+      tags      = list(string)
+      namespace = string
+      peer      = string
+      status    = string
+
+      node                  = string
+      node_id               = string
+      node_address          = string
+      node_datacenter       = string
+      node_tagged_addresses = map(string)
+      node_meta             = map(string)
+
+      cts_user_defined_meta = map(string)
+    })
+  )
+}
+
+# Gateway definition protocol v0
+variable "gateways" {
+  description = "Consul service mesh gateways monitored by Consul-Terraform-Sync"
+  type = map(
+    object({
+      id        = string
+      name      = string
+      kind      = string
+      address   = string
+      port      = number
+      meta      = map(string)
+      tags      = list(string)
+      namespace = string
+      status    = string
+
+      node                  = string
+      node_id               = string
+      node_address          = string
+      node_datacenter       = string
+      node_tagged_addresses = map(string)
+      node_meta             = map(string)
+
+      services = list(
+        object({
+          name      = string
+          namespace = string
+          port      = number
+          protocol  = string
+          hosts     = list(string)
+        })
+      )
+    })
+  )
+}
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+// gatewaysWaitTime is the maximum time a fetch blocks waiting for changes to
+// the gateway instances. The services linked to a gateway are configured by
+// config entries, which do not affect the index of the gateway instances, so
+// the linked services are refreshed at least this often.
+const gatewaysWaitTime = 30 * time.Second
+
+var _ hcatQuery = (*gatewaysQuery)(nil)
+
+// Gateway is a healthy instance of a Consul service mesh gateway and the
+// services linked to the gateway.
+type Gateway struct {
+	*dep.HealthService
+
+	// Services are the services linked to an ingress or terminating gateway
+	// by its config entry. Mesh and API gateways have no linked services.
+	Services []*GatewayService
+}
+
+// GatewayService is a service linked to a gateway.
+type GatewayService struct {
+	Name      string
+	Namespace string
+	Port      int
+	Protocol  string
+	Hosts     []string
+}
+
+// gatewaysFunc returns the healthy instances of the service mesh gateway with
+// the given name, along with the services linked to the gateway. Instances
+// of the service that are not a gateway are ignored. It supports the query
+// parameters dc and ns.
+//
+// Endpoints: /v1/health/service/:name, /v1/catalog/gateway-services/:name
+// Template: {{ gateways name=<name> <options> ... }}
+func gatewaysFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*Gateway, error) {
+		result := []*Gateway{}
+
+		d, err := newGatewaysQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*Gateway), nil
+		}
+
+		return result, nil
+	}
+}
+
+// gatewaysQuery is the representation of a requested gateways query from
+// inside a template.
+type gatewaysQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	name     string
+	dc       string
+	ns       string
+	waitTime time.Duration
+	opts     hcat.QueryOptions
+
+	// healthIndex is the Consul index of the last gateway instances, which
+	// can lag behind the index returned to hcat
+	healthIndex uint64
+}
+
+// newGatewaysQuery processes options in the format of "key=value"
+// (e.g. "name=mesh-gateway"). The name option is required.
+func newGatewaysQuery(opts []string) (*gatewaysQuery, error) {
+	query := gatewaysQuery{
+		stopCh:   make(chan struct{}, 1),
+		waitTime: gatewaysWaitTime,
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		queryParam := strings.SplitN(opt, "=", 2)
+		if len(queryParam) != 2 {
+			return nil, fmt.Errorf(
+				"gateways: invalid query parameter format: %q", opt)
+		}
+		param := strings.TrimSpace(queryParam[0])
+		value := strings.TrimSpace(queryParam[1])
+		switch param {
+		case "name":
+			query.name = value
+		case "dc", "datacenter":
+			query.dc = value
+		case "ns", "namespace":
+			query.ns = value
+		default:
+			return nil, fmt.Errorf(
+				"gateways: invalid query parameter: %q", opt)
+		}
+	}
+
+	if query.name == "" {
+		return nil, fmt.Errorf("gateways: name option required")
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of Gateway objects. Fetches after the first block until the gateway
+// instances change or the wait time elapses, and the linked services are
+// refreshed on every fetch.
+func (d *gatewaysQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	lastIndex := d.opts.WaitIndex
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+	})
+	opts := hcatOpts.ToConsulOpts()
+	opts.WaitIndex = d.healthIndex
+	opts.WaitTime = 0
+	if d.healthIndex != 0 {
+		opts.WaitTime = d.waitTime
+	}
+
+	entries, qm, err := clients.Consul().Health().Service(d.name, "", true, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+	d.healthIndex = qm.LastIndex
+
+	var linked []*GatewayService
+	gateways := make([]*Gateway, 0, len(entries))
+	for _, entry := range entries {
+		switch entry.Service.Kind {
+		case consulapi.ServiceKindIngressGateway, consulapi.ServiceKindTerminatingGateway:
+			if linked == nil {
+				linked, err = d.fetchLinkedServices(clients, opts)
+				if err != nil {
+					return nil, nil, errors.Wrap(err, d.String())
+				}
+			}
+		case consulapi.ServiceKindMeshGateway, consulapi.ServiceKindAPIGateway:
+			// no linked services
+		default:
+			continue
+		}
+
+		services := []*GatewayService{}
+		if linked != nil {
+			services = linked
+		}
+		gateways = append(gateways, &Gateway{
+			HealthService: toHealthService(entry),
+			Services:      services,
+		})
+	}
+
+	// Changes to the linked services are not reflected by the index of the
+	// gateway instances. Always advance the index so that the results are
+	// compared with the previous results to determine if there is a change.
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+	if rm.LastIndex <= lastIndex {
+		rm.LastIndex = lastIndex + 1
+	}
+
+	sort.Stable(ByGatewayNodeThenID(gateways))
+	return gateways, rm, nil
+}
+
+// fetchLinkedServices returns the services linked to the gateway. The request
+// does not block so that the gateway instances remain the only blocking query.
+func (d *gatewaysQuery) fetchLinkedServices(clients dep.Clients, opts *consulapi.QueryOptions) ([]*GatewayService, error) {
+	o := *opts
+	o.WaitIndex = 0
+	o.WaitTime = 0
+
+	entries, _, err := clients.Consul().Catalog().GatewayServices(d.name, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*GatewayService, 0, len(entries))
+	for _, e := range entries {
+		hosts := make([]string, 0, len(e.Hosts))
+		hosts = append(hosts, e.Hosts...)
+		services = append(services, &GatewayService{
+			Name:      e.Service.Name,
+			Namespace: e.Service.Namespace,
+			Port:      e.Port,
+			Protocol:  e.Protocol,
+			Hosts:     hosts,
+		})
+	}
+
+	sort.SliceStable(services, func(i, j int) bool {
+		if services[i].Name == services[j].Name {
+			return services[i].Namespace < services[j].Namespace
+		}
+		return services[i].Name < services[j].Name
+	})
+	return services, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which provides
+// the index of the previous results.
+func (d *gatewaysQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *gatewaysQuery) ID() string {
+	opts := []string{fmt.Sprintf("name=%s", d.name)}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	sort.Strings(opts)
+	return fmt.Sprintf("gateways(%s)", strings.Join(opts, "&"))
+}
+
+// Stringer interface reuses ID
+func (d *gatewaysQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *gatewaysQuery) Stop() {
+	close(d.stopCh)
+}
+
+// ByGatewayNodeThenID is a sortable slice of Gateway structs
+type ByGatewayNodeThenID []*Gateway
+
+func (s ByGatewayNodeThenID) Len() int      { return len(s) }
+func (s ByGatewayNodeThenID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ByGatewayNodeThenID) Less(i, j int) bool {
+	if s[i].Node == s[j].Node {
+		return s[i].ID < s[j].ID
+	}
+	return s[i].Node < s[j].Node
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGatewaysQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *gatewaysQuery
+		err  bool
+	}{
+		{
+			"name",
+			[]string{"name=mesh-gateway"},
+			&gatewaysQuery{
+				name: "mesh-gateway",
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"name=ingress-gateway", "dc=dc1", "ns=ns1"},
+			&gatewaysQuery{
+				name: "ingress-gateway",
+				dc:   "dc1",
+				ns:   "ns1",
+			},
+			false,
+		},
+		{
+			"no opts",
+			[]string{},
+			nil,
+			true,
+		},
+		{
+			"missing name",
+			[]string{"dc=dc1"},
+			nil,
+			true,
+		},
+		{
+			"invalid format",
+			[]string{"name=mesh-gateway", "dc1"},
+			nil,
+			true,
+		},
+		{
+			"unsupported parameter",
+			[]string{"name=mesh-gateway", "near=_agent"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newGatewaysQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+				act.waitTime = 0
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestGatewaysQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"name",
+			[]string{"name=mesh-gateway"},
+			"gateways(name=mesh-gateway)",
+		},
+		{
+			"multiple",
+			[]string{"ns=ns1", "dc=dc1", "name=ingress-gateway"},
+			"gateways(dc=dc1&name=ingress-gateway&ns=ns1)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newGatewaysQuery(tc.i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestGatewaysQuery_Fetch(t *testing.T) {
+	t.Parallel()
+
+	// Consul stand-in with two instances of an ingress gateway linked to two
+	// services, and an instance of a typical service with the same name
+	var healthRequests, linkedRequests []*http.Request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/status/leader":
+			fmt.Fprint(w, `"127.0.0.1:8300"`)
+		case "/v1/health/service/ingress":
+			healthRequests = append(healthRequests, r)
+			w.Header().Set("X-Consul-Index", "10")
+			fmt.Fprint(w, `[
+				{
+					"Node": {"ID": "2", "Node": "node-b", "Address": "10.0.0.2", "Datacenter": "dc1"},
+					"Service": {"ID": "ingress-2", "Service": "ingress", "Kind": "ingress-gateway", "Port": 8443},
+					"Checks": [{"Status": "passing"}]
+				},
+				{
+					"Node": {"ID": "1", "Node": "node-a", "Address": "10.0.0.1", "Datacenter": "dc1"},
+					"Service": {"ID": "ingress-1", "Service": "ingress", "Kind": "ingress-gateway", "Port": 8443},
+					"Checks": [{"Status": "passing"}]
+				},
+				{
+					"Node": {"ID": "1", "Node": "node-a", "Address": "10.0.0.1", "Datacenter": "dc1"},
+					"Service": {"ID": "ingress-typical", "Service": "ingress", "Port": 80},
+					"Checks": [{"Status": "passing"}]
+				}
+			]`)
+		case "/v1/catalog/gateway-services/ingress":
+			linkedRequests = append(linkedRequests, r)
+			w.Header().Set("X-Consul-Index", "12")
+			fmt.Fprint(w, `[
+				{
+					"Gateway": {"Name": "ingress"},
+					"Service": {"Name": "web", "Namespace": "default"},
+					"GatewayKind": "ingress-gateway",
+					"Port": 8080,
+					"Protocol": "http",
+					"Hosts": ["web.example.com"]
+				},
+				{
+					"Gateway": {"Name": "ingress"},
+					"Service": {"Name": "api", "Namespace": "default"},
+					"GatewayKind": "ingress-gateway",
+					"Port": 9090,
+					"Protocol": "tcp"
+				}
+			]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	clients := hcat.NewClientSet()
+	require.NoError(t, clients.AddConsul(hcat.ConsulInput{Address: ts.URL}))
+	defer clients.Stop()
+
+	d, err := newGatewaysQuery([]string{"name=ingress", "dc=dc1"})
+	require.NoError(t, err)
+	d.waitTime = time.Millisecond
+
+	t.Run("first_fetch", func(t *testing.T) {
+		data, rm, err := d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), rm.LastIndex)
+
+		gateways, ok := data.([]*Gateway)
+		require.True(t, ok)
+		require.Len(t, gateways, 2, "typical service instance is ignored")
+
+		assert.Equal(t, "node-a", gateways[0].Node)
+		assert.Equal(t, "ingress-1", gateways[0].ID)
+		assert.Equal(t, "ingress-gateway", gateways[0].Kind)
+		assert.Equal(t, "node-b", gateways[1].Node)
+
+		expected := []*GatewayService{
+			{
+				Name:      "api",
+				Namespace: "default",
+				Port:      9090,
+				Protocol:  "tcp",
+				Hosts:     []string{},
+			},
+			{
+				Name:      "web",
+				Namespace: "default",
+				Port:      8080,
+				Protocol:  "http",
+				Hosts:     []string{"web.example.com"},
+			},
+		}
+		assert.Equal(t, expected, gateways[0].Services)
+		assert.Equal(t, expected, gateways[1].Services)
+
+		require.Len(t, healthRequests, 1)
+		assert.Equal(t, "dc1", healthRequests[0].URL.Query().Get("dc"))
+		assert.Equal(t, "1", healthRequests[0].URL.Query().Get("passing"))
+		assert.Empty(t, healthRequests[0].URL.Query().Get("index"))
+
+		require.Len(t, linkedRequests, 1, "linked services fetched once")
+		assert.Equal(t, "dc1", linkedRequests[0].URL.Query().Get("dc"))
+	})
+
+	t.Run("index_advances", func(t *testing.T) {
+		d.SetOptions(hcat.QueryOptions{WaitIndex: 10})
+		_, rm, err := d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), rm.LastIndex)
+
+		// blocks on the index of the gateway instances, not the advanced index
+		require.Len(t, healthRequests, 2)
+		assert.Equal(t, "10", healthRequests[1].URL.Query().Get("index"))
+		assert.Empty(t, linkedRequests[1].URL.Query().Get("index"))
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		d.Stop()
+		_, _, err := d.Fetch(clients)
+		assert.Equal(t, dep.ErrStopped, err)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// hclGatewayFunc is a wrapper of the template function to marshal Consul
+// gateway information into HCL.
+func hclGatewayFunc() func(g *Gateway) string {
+	return func(g *Gateway) string {
+		if g == nil || g.HealthService == nil {
+			return ""
+		}
+
+		// Convert the gateway to an HCL marshal-able object
+		gw := newGateway(g)
+
+		f := hclwrite.NewEmptyFile()
+		gohcl.EncodeIntoBody(gw, f.Body())
+		return strings.TrimSpace(string(f.Bytes()))
+	}
+}
+
+type gateway struct {
+	// Consul service information of the gateway instance
+	ID        string            `hcl:"id"`
+	Name      string            `hcl:"name"`
+	Kind      string            `hcl:"kind"`
+	Address   string            `hcl:"address"`
+	Port      int               `hcl:"port"`
+	Meta      map[string]string `hcl:"meta"`
+	Tags      []string          `hcl:"tags"`
+	Namespace string            `hcl:"namespace"`
+	Status    string            `hcl:"status"`
+
+	// Consul node information for the gateway instance
+	Node                string            `hcl:"node"`
+	NodeID              string            `hcl:"node_id"`
+	NodeAddress         string            `hcl:"node_address"`
+	NodeDatacenter      string            `hcl:"node_datacenter"`
+	NodeTaggedAddresses map[string]string `hcl:"node_tagged_addresses"`
+	NodeMeta            map[string]string `hcl:"node_meta"`
+
+	// Services linked to the gateway
+	Services []gatewayService `hcl:"services"`
+}
+
+type gatewayService struct {
+	Name      string   `cty:"name"`
+	Namespace string   `cty:"namespace"`
+	Port      int      `cty:"port"`
+	Protocol  string   `cty:"protocol"`
+	Hosts     []string `cty:"hosts"`
+}
+
+func newGateway(g *Gateway) gateway {
+	if g == nil || g.HealthService == nil {
+		return gateway{}
+	}
+
+	// Default to empty lists instead of null
+	tags := []string{}
+	if g.Tags != nil {
+		tags = g.Tags
+	}
+
+	services := make([]gatewayService, 0, len(g.Services))
+	for _, s := range g.Services {
+		hosts := []string{}
+		if s.Hosts != nil {
+			hosts = s.Hosts
+		}
+		services = append(services, gatewayService{
+			Name:      s.Name,
+			Namespace: s.Namespace,
+			Port:      s.Port,
+			Protocol:  s.Protocol,
+			Hosts:     hosts,
+		})
+	}
+
+	return gateway{
+		ID:        g.ID,
+		Name:      g.Name,
+		Kind:      g.Kind,
+		Address:   g.Address,
+		Port:      g.Port,
+		Meta:      nonNullMap(g.ServiceMeta),
+		Tags:      tags,
+		Namespace: g.Namespace,
+		Status:    g.Status,
+
+		Node:                g.Node,
+		NodeID:              g.NodeID,
+		NodeAddress:         g.NodeAddress,
+		NodeDatacenter:      g.NodeDatacenter,
+		NodeTaggedAddresses: nonNullMap(g.NodeTaggedAddresses),
+		NodeMeta:            nonNullMap(g.NodeMeta),
+
+		Services: services,
+	}
+}
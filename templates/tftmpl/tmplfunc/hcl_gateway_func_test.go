@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCLGatewayFunc(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  *Gateway
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"",
+		}, {
+			"empty",
+			&Gateway{HealthService: &dep.HealthService{}},
+			`id                    = ""
+name                  = ""
+kind                  = ""
+address               = ""
+port                  = 0
+meta                  = {}
+tags                  = []
+namespace             = ""
+status                = ""
+node                  = ""
+node_id               = ""
+node_address          = ""
+node_datacenter       = ""
+node_tagged_addresses = {}
+node_meta             = {}
+services              = []`,
+		}, {
+			"ingress",
+			&Gateway{
+				HealthService: &dep.HealthService{
+					ID:             "ingress-1",
+					Name:           "ingress",
+					Kind:           "ingress-gateway",
+					Address:        "10.0.0.1",
+					Port:           8443,
+					Namespace:      "default",
+					Status:         "passing",
+					Node:           "node-a",
+					NodeID:         "1",
+					NodeAddress:    "10.0.0.1",
+					NodeDatacenter: "dc1",
+				},
+				Services: []*GatewayService{
+					{
+						Name:      "web",
+						Namespace: "default",
+						Port:      8080,
+						Protocol:  "http",
+						Hosts:     []string{"web.example.com"},
+					},
+				},
+			},
+			`id                    = "ingress-1"
+name                  = "ingress"
+kind                  = "ingress-gateway"
+address               = "10.0.0.1"
+port                  = 8443
+meta                  = {}
+tags                  = []
+namespace             = "default"
+status                = "passing"
+node                  = "node-a"
+node_id               = "1"
+node_address          = "10.0.0.1"
+node_datacenter       = "dc1"
+node_tagged_addresses = {}
+node_meta             = {}
+services = [{
+  hosts     = ["web.example.com"]
+  name      = "web"
+  namespace = "default"
+  port      = 8080
+  protocol  = "http"
+}]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := hclGatewayFunc()(tc.content)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// hclIntentionFunc is a wrapper of the template function to marshal Consul
+// intention information into HCL.
+func hclIntentionFunc() func(ixn *consulapi.Intention) string {
+	return func(ixn *consulapi.Intention) string {
+		if ixn == nil {
+			return ""
+		}
+
+		// Convert the Consul type to an HCL marshal-able object
+		i := newIntention(ixn)
+
+		f := hclwrite.NewEmptyFile()
+		gohcl.EncodeIntoBody(i, f.Body())
+		return strings.TrimSpace(string(f.Bytes()))
+	}
+}
+
+type intention struct {
+	ID          string `hcl:"id"`
+	Description string `hcl:"description"`
+
+	SourceName      string `hcl:"source_name"`
+	SourceNamespace string `hcl:"source_namespace"`
+	SourcePartition string `hcl:"source_partition"`
+	SourcePeer      string `hcl:"source_peer"`
+	SourceType      string `hcl:"source_type"`
+
+	DestinationName      string `hcl:"destination_name"`
+	DestinationNamespace string `hcl:"destination_namespace"`
+	DestinationPartition string `hcl:"destination_partition"`
+
+	Action     string            `hcl:"action"`
+	Precedence int               `hcl:"precedence"`
+	Meta       map[string]string `hcl:"meta"`
+}
+
+func newIntention(ixn *consulapi.Intention) intention {
+	if ixn == nil {
+		return intention{}
+	}
+
+	// L7 intentions have permissions instead of an action. Only the action is
+	// represented, so L7 intentions default to an empty action.
+	return intention{
+		ID:          ixn.ID,
+		Description: ixn.Description,
+
+		SourceName:      ixn.SourceName,
+		SourceNamespace: ixn.SourceNS,
+		SourcePartition: ixn.SourcePartition,
+		SourcePeer:      ixn.SourcePeer,
+		SourceType:      string(ixn.SourceType),
+
+		DestinationName:      ixn.DestinationName,
+		DestinationNamespace: ixn.DestinationNS,
+		DestinationPartition: ixn.DestinationPartition,
+
+		Action:     string(ixn.Action),
+		Precedence: ixn.Precedence,
+		Meta:       nonNullMap(ixn.Meta),
+	}
+}
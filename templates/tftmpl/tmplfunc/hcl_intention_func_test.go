@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCLIntentionFunc(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  *consulapi.Intention
+		expected string
+	}{
+		{
+			"nil",
+			nil,
+			"",
+		}, {
+			"empty",
+			&consulapi.Intention{},
+			`id                    = ""
+description           = ""
+source_name           = ""
+source_namespace      = ""
+source_partition      = ""
+source_peer           = ""
+source_type           = ""
+destination_name      = ""
+destination_namespace = ""
+destination_partition = ""
+action                = ""
+precedence            = 0
+meta                  = {}`,
+		}, {
+			"basic",
+			&consulapi.Intention{
+				ID:              "c9b9e6b5-5c55-4cf6-8b0b-1b1b2bde0b1c",
+				Description:     "api to db",
+				SourceName:      "api",
+				SourceNS:        "default",
+				SourceType:      consulapi.IntentionSourceConsul,
+				DestinationName: "db",
+				DestinationNS:   "default",
+				Action:          consulapi.IntentionActionAllow,
+				Precedence:      9,
+				Meta:            map[string]string{"key": "value"},
+			},
+			`id                    = "c9b9e6b5-5c55-4cf6-8b0b-1b1b2bde0b1c"
+description           = "api to db"
+source_name           = "api"
+source_namespace      = "default"
+source_partition      = ""
+source_peer           = ""
+source_type           = "consul"
+destination_name      = "db"
+destination_namespace = "default"
+destination_partition = ""
+action                = "allow"
+precedence            = 9
+meta = {
+  key = "value"
+}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := hclIntentionFunc()(tc.content)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -13,9 +13,10 @@ import (
 
 // hclServiceFunc is a wrapper of the template function to marshal Consul
 // service information into HCL. The function accepts a map representing
-// metadata for services in scope of a task.
-func hclServiceFunc(meta *ServicesMeta) func(sDep *dep.HealthService) string {
-	return func(sDep *dep.HealthService) string {
+// metadata for services in scope of a task. The template function optionally
+// accepts the name of the cluster peer that the service is imported from.
+func hclServiceFunc(meta *ServicesMeta) func(sDep *dep.HealthService, peer ...string) string {
+	return func(sDep *dep.HealthService, peer ...string) string {
 		if sDep == nil {
 			return ""
 		}
@@ -28,6 +29,9 @@ func hclServiceFunc(meta *ServicesMeta) func(sDep *dep.HealthService) string {
 
 		// Convert the hcat type to an HCL marshal-able object
 		s := newHealthService(sDep, serviceMeta)
+		if len(peer) > 0 {
+			s.Peer = peer[0]
+		}
 
 		f := hclwrite.NewEmptyFile()
 		gohcl.EncodeIntoBody(s, f.Body())
@@ -45,6 +49,7 @@ type healthService struct {
 	Meta      map[string]string `hcl:"meta"`
 	Tags      []string          `hcl:"tags"`
 	Namespace string            `hcl:"namespace"`
+	Peer      string            `hcl:"peer"`
 	Status    string            `hcl:"status"`
 
 	// Consul node information for a service
@@ -31,6 +31,7 @@ port                  = 0
 meta                  = {}
 tags                  = []
 namespace             = ""
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -73,6 +74,7 @@ meta = {
 }
 tags            = ["tag"]
 namespace       = ""
+peer            = ""
 status          = "passing"
 node            = "worker-01"
 node_id         = "39e5a7f5-2834-e16d-6925-78167c9f50d8"
@@ -102,6 +104,7 @@ port                  = 0
 meta                  = {}
 tags                  = []
 namespace             = "namespace"
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -141,6 +144,7 @@ port                  = 8080
 meta                  = {}
 tags                  = []
 namespace             = ""
+peer                  = ""
 status                = ""
 node                  = ""
 node_id               = ""
@@ -181,3 +185,31 @@ cts_user_defined_meta = {
 		})
 	}
 }
+
+func TestHCLServiceFunc_peer(t *testing.T) {
+	content := &dep.HealthService{
+		ID:             "api",
+		Name:           "api",
+		NodeDatacenter: "dc-east",
+	}
+	expected := `id                    = "api"
+name                  = "api"
+kind                  = ""
+address               = ""
+port                  = 0
+meta                  = {}
+tags                  = []
+namespace             = ""
+peer                  = "peer-a"
+status                = ""
+node                  = ""
+node_id               = ""
+node_address          = ""
+node_datacenter       = "dc-east"
+node_tagged_addresses = {}
+node_meta             = {}
+cts_user_defined_meta = {}`
+
+	actual := hclServiceFunc(nil)(content, "peer-a")
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*intentionsQuery)(nil)
+
+// intentionWildcard is the service name of intentions that apply to all
+// services
+const intentionWildcard = "*"
+
+// intentionsFunc returns information on the Consul intentions of the given
+// source and destination services. It queries the Connect Intentions API and
+// supports the query parameters dc and ns. It also adds an additional layer of
+// custom functionality on the API response:
+//   - Adds filtering on the source service name e.g. "source=api"
+//   - Adds filtering on the destination service name e.g. "destination=db"
+//
+// Intentions with a wildcard source or destination apply to all services and
+// are always included. When both sources and destinations are configured,
+// intentions must match both.
+//
+// Endpoint: /v1/connect/intentions
+// Template: {{ intentions <filter options> ... }}
+func intentionsFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*consulapi.Intention, error) {
+		result := []*consulapi.Intention{}
+
+		d, err := newIntentionsQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*consulapi.Intention), nil
+		}
+
+		return result, nil
+	}
+}
+
+// intentionsQuery is the representation of a requested intentions query from
+// inside a template.
+type intentionsQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	sources      []string // custom
+	destinations []string // custom
+	dc           string
+	ns           string
+	opts         hcat.QueryOptions
+}
+
+// newIntentionsQuery processes options in the format of "key=value"
+// e.g. "source=api"
+func newIntentionsQuery(opts []string) (*intentionsQuery, error) {
+	query := intentionsQuery{
+		stopCh: make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		param, value, err := stringsSplit2(opt, "=")
+		if err != nil {
+			return nil, fmt.Errorf("connect.intentions: invalid "+
+				"query parameter format: %q", opt)
+		}
+		switch param {
+		case "source":
+			query.sources = append(query.sources, value)
+		case "destination":
+			query.destinations = append(query.destinations, value)
+		case "dc", "datacenter":
+			query.dc = value
+		case "ns", "namespace":
+			query.ns = value
+		default:
+			return nil, fmt.Errorf(
+				"connect.intentions: invalid query parameter: %q", opt)
+		}
+	}
+
+	sort.Strings(query.sources)
+	sort.Strings(query.destinations)
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of Intention objects.
+func (d *intentionsQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+	})
+	opts := hcatOpts.ToConsulOpts()
+
+	entries, qm, err := clients.Consul().Connect().Intentions(opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	return d.filter(entries), rm, nil
+}
+
+// filter returns the intentions that match the query's sources and
+// destinations, sorted by precedence
+func (d *intentionsQuery) filter(entries []*consulapi.Intention) []*consulapi.Intention {
+	intentions := []*consulapi.Intention{}
+	for _, ixn := range entries {
+		if !matchServiceName(ixn.SourceName, d.sources) ||
+			!matchServiceName(ixn.DestinationName, d.destinations) {
+			continue
+		}
+		intentions = append(intentions, ixn)
+	}
+
+	sort.Stable(ByPrecedence(intentions))
+	return intentions
+}
+
+// matchServiceName returns whether the service name of an intention matches one
+// of the names. All service names match when there are no names.
+func matchServiceName(name string, names []string) bool {
+	if len(names) == 0 || name == intentionWildcard {
+		return true
+	}
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *intentionsQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *intentionsQuery) ID() string {
+	var opts []string
+	for _, s := range d.sources {
+		opts = append(opts, fmt.Sprintf("source=%s", s))
+	}
+	for _, s := range d.destinations {
+		opts = append(opts, fmt.Sprintf("destination=%s", s))
+	}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	if len(opts) > 0 {
+		sort.Strings(opts)
+		return fmt.Sprintf("connect.intentions(%s)",
+			strings.Join(opts, "&"))
+	}
+	return "connect.intentions"
+}
+
+// Stringer interface reuses ID
+func (d *intentionsQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *intentionsQuery) Stop() {
+	close(d.stopCh)
+}
+
+// ByPrecedence is a sortable slice of Intention structs. Intentions are sorted
+// by descending precedence, the order in which Consul applies them, and then
+// by source and destination.
+type ByPrecedence []*consulapi.Intention
+
+func (s ByPrecedence) Len() int      { return len(s) }
+func (s ByPrecedence) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ByPrecedence) Less(i, j int) bool {
+	if s[i].Precedence != s[j].Precedence {
+		return s[i].Precedence > s[j].Precedence
+	}
+	if s[i].SourceString() != s[j].SourceString() {
+		return s[i].SourceString() < s[j].SourceString()
+	}
+	return s[i].DestinationString() < s[j].DestinationString()
+}
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIntentionsQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *intentionsQuery
+		err  bool
+	}{
+		{
+			"no opts",
+			[]string{},
+			&intentionsQuery{},
+			false,
+		},
+		{
+			"source",
+			[]string{"source=web", "source=api"},
+			&intentionsQuery{
+				sources: []string{"api", "web"},
+			},
+			false,
+		},
+		{
+			"destination",
+			[]string{"destination=db"},
+			&intentionsQuery{
+				destinations: []string{"db"},
+			},
+			false,
+		},
+		{
+			"dc",
+			[]string{"dc=dc1"},
+			&intentionsQuery{
+				dc: "dc1",
+			},
+			false,
+		},
+		{
+			"ns",
+			[]string{"ns=namespace"},
+			&intentionsQuery{
+				ns: "namespace",
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"source=api", "destination=db", "ns=namespace", "dc=dc1"},
+			&intentionsQuery{
+				sources:      []string{"api"},
+				destinations: []string{"db"},
+				dc:           "dc1",
+				ns:           "namespace",
+			},
+			false,
+		},
+		{
+			"invalid query",
+			[]string{"invalid=true"},
+			nil,
+			true,
+		},
+		{
+			"invalid query format",
+			[]string{"api"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newIntentionsQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestIntentionsQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"empty",
+			[]string{},
+			"connect.intentions",
+		},
+		{
+			"source",
+			[]string{"source=web", "source=api"},
+			"connect.intentions(source=api&source=web)",
+		},
+		{
+			"destination",
+			[]string{"destination=db"},
+			"connect.intentions(destination=db)",
+		},
+		{
+			"multiple",
+			[]string{"source=api", "destination=db", "ns=namespace", "dc=dc1"},
+			"connect.intentions(dc=dc1&destination=db&ns=namespace&source=api)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newIntentionsQuery(tc.i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestIntentionsQuery_filter(t *testing.T) {
+	t.Parallel()
+
+	apiToDB := &consulapi.Intention{
+		SourceName: "api", DestinationName: "db", Precedence: 9}
+	webToDB := &consulapi.Intention{
+		SourceName: "web", DestinationName: "db", Precedence: 9}
+	apiToCache := &consulapi.Intention{
+		SourceName: "api", DestinationName: "cache", Precedence: 9}
+	anyToDB := &consulapi.Intention{
+		SourceName: "*", DestinationName: "db", Precedence: 8}
+	entries := []*consulapi.Intention{anyToDB, webToDB, apiToCache, apiToDB}
+
+	cases := []struct {
+		name     string
+		opts     []string
+		expected []*consulapi.Intention
+	}{
+		{
+			"no filter",
+			[]string{},
+			[]*consulapi.Intention{apiToCache, apiToDB, webToDB, anyToDB},
+		},
+		{
+			"source",
+			[]string{"source=api"},
+			[]*consulapi.Intention{apiToCache, apiToDB, anyToDB},
+		},
+		{
+			"destination",
+			[]string{"destination=db"},
+			[]*consulapi.Intention{apiToDB, webToDB, anyToDB},
+		},
+		{
+			"source and destination",
+			[]string{"source=web", "destination=db"},
+			[]*consulapi.Intention{webToDB, anyToDB},
+		},
+		{
+			"no match",
+			[]string{"source=foo", "destination=cache"},
+			[]*consulapi.Intention{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newIntentionsQuery(tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.expected, d.filter(entries))
+		})
+	}
+}
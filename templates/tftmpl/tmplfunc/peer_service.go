@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+var _ hcatQuery = (*peerServiceQuery)(nil)
+
+// peerServiceFunc returns the healthy instances of a service that is imported
+// from a Consul cluster peer. It queries the Health Service API and supports
+// the query parameters peer, dc, ns, and filter. The peer parameter is
+// required.
+//
+// Endpoint: /v1/health/service/:service
+// Template: {{ peerService <name> peer=<peer> <options> ... }}
+func peerServiceFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*dep.HealthService, error) {
+		result := []*dep.HealthService{}
+
+		d, err := newPeerServiceQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*dep.HealthService), nil
+		}
+
+		return result, nil
+	}
+}
+
+// peerServiceQuery is the representation of a requested peer service query
+// from inside a template.
+type peerServiceQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	name   string
+	peer   string
+	dc     string
+	ns     string
+	filter string
+	opts   hcat.QueryOptions
+}
+
+// newPeerServiceQuery processes the service name as the first option and the
+// remaining options in the format of "key=value" (e.g. "peer=peer-a") with the
+// exception of filters. Any option that is not a key/value pair is assumed to
+// be a filter.
+func newPeerServiceQuery(opts []string) (*peerServiceQuery, error) {
+	if len(opts) == 0 || strings.TrimSpace(opts[0]) == "" {
+		return nil, fmt.Errorf("health.service.peer: service name required")
+	}
+
+	query := peerServiceQuery{
+		stopCh: make(chan struct{}, 1),
+		name:   strings.TrimSpace(opts[0]),
+	}
+
+	var filters []string
+	for _, opt := range opts[1:] {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		// Parse query parameters, excluding the filter which is not set as a
+		// parameter
+		if queryParamOptRe.MatchString(opt) {
+			queryParam := strings.SplitN(opt, "=", 2)
+			param := strings.TrimSpace(queryParam[0])
+			value := strings.TrimSpace(queryParam[1])
+			switch param {
+			case "peer":
+				query.peer = value
+				continue
+			case "dc", "datacenter":
+				query.dc = value
+				continue
+			case "ns", "namespace":
+				query.ns = value
+				continue
+			}
+		}
+
+		// Any option that was not already parsed is assumed to be a filter.
+		// Evaluate the grammar of the filter before attempting to query Consul.
+		_, err := bexpr.CreateFilter(opt)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"health.service.peer: invalid filter: %q: %s", opt, err)
+		}
+		filters = append(filters, opt)
+	}
+
+	if len(filters) > 0 {
+		query.filter = strings.Join(filters, " and ")
+	}
+
+	if query.peer == "" {
+		return nil, fmt.Errorf("health.service.peer: peer option required")
+	}
+
+	return &query, nil
+}
+
+// Fetch queries the Consul API defined by the given client and returns a slice
+// of HealthService objects for the healthy instances of the service imported
+// from the peer.
+func (d *peerServiceQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+		Namespace:  d.ns,
+		Filter:     d.filter,
+	})
+	opts := hcatOpts.ToConsulOpts()
+	opts.Peer = d.peer
+
+	entries, qm, err := clients.Consul().Health().Service(d.name, "", true, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+
+	services := make([]*dep.HealthService, 0, len(entries))
+	for _, entry := range entries {
+		services = append(services, toHealthService(entry))
+	}
+
+	sort.Stable(ByNodeThenID(services))
+	return services, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
+// blocking queries.
+func (d *peerServiceQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *peerServiceQuery) ID() string {
+	opts := []string{fmt.Sprintf("peer=%s", d.peer)}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.ns != "" {
+		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
+	}
+	if d.filter != "" {
+		opts = append(opts, fmt.Sprintf("filter=%s", d.filter))
+	}
+	sort.Strings(opts)
+	return fmt.Sprintf("health.service.peer(%s|%s)", d.name,
+		strings.Join(opts, "&"))
+}
+
+// Stringer interface reuses ID
+func (d *peerServiceQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *peerServiceQuery) Stop() {
+	close(d.stopCh)
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPeerServiceQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *peerServiceQuery
+		err  bool
+	}{
+		{
+			"peer",
+			[]string{"api", "peer=peer-a"},
+			&peerServiceQuery{
+				name: "api",
+				peer: "peer-a",
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"api", "peer=peer-a", "dc=dc1", "ns=namespace", "\"my-tag\" in Service.Tags"},
+			&peerServiceQuery{
+				name:   "api",
+				peer:   "peer-a",
+				dc:     "dc1",
+				ns:     "namespace",
+				filter: "\"my-tag\" in Service.Tags",
+			},
+			false,
+		},
+		{
+			"no opts",
+			[]string{},
+			nil,
+			true,
+		},
+		{
+			"missing peer",
+			[]string{"api", "ns=namespace"},
+			nil,
+			true,
+		},
+		{
+			"invalid filter",
+			[]string{"api", "peer=peer-a", "invalid"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newPeerServiceQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestPeerServiceQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"peer",
+			[]string{"api", "peer=peer-a"},
+			"health.service.peer(api|peer=peer-a)",
+		},
+		{
+			"multiple",
+			[]string{"api", "ns=namespace", "peer=peer-a", "dc=dc1", "Service.Port == 80"},
+			"health.service.peer(api|dc=dc1&filter=Service.Port == 80&ns=namespace&peer=peer-a)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newPeerServiceQuery(tc.i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestPeerServiceQuery_Fetch(t *testing.T) {
+	t.Parallel()
+
+	// Consul stand-in that returns the instances of a service imported from a
+	// cluster peer
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/status/leader":
+			fmt.Fprint(w, `"127.0.0.1:8300"`)
+			return
+		case "/v1/health/service/api":
+			query = r.URL.Query()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Consul-Index", "7")
+		fmt.Fprint(w, `[
+			{
+				"Node": {"ID": "2", "Node": "node-b", "Address": "10.0.0.2", "Datacenter": "dc-east", "PeerName": "peer-a"},
+				"Service": {"ID": "api-2", "Service": "api", "Port": 8080, "PeerName": "peer-a"},
+				"Checks": [{"Status": "passing"}]
+			},
+			{
+				"Node": {"ID": "1", "Node": "node-a", "Address": "10.0.0.1", "Datacenter": "dc-east", "PeerName": "peer-a"},
+				"Service": {"ID": "api-1", "Service": "api", "Port": 8080, "PeerName": "peer-a"},
+				"Checks": [{"Status": "passing"}]
+			}
+		]`)
+	}))
+	defer ts.Close()
+
+	clients := hcat.NewClientSet()
+	require.NoError(t, clients.AddConsul(hcat.ConsulInput{Address: ts.URL}))
+	defer clients.Stop()
+
+	d, err := newPeerServiceQuery([]string{"api", "peer=peer-a", "Service.Port == 8080"})
+	require.NoError(t, err)
+	d.SetOptions(hcat.QueryOptions{WaitIndex: 5})
+
+	data, rm, err := d.Fetch(clients)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), rm.LastIndex)
+
+	services, ok := data.([]*dep.HealthService)
+	require.True(t, ok)
+	require.Len(t, services, 2)
+	assert.Equal(t, "api-1", services[0].ID)
+	assert.Equal(t, "dc-east", services[0].NodeDatacenter)
+	assert.Equal(t, "10.0.0.1", services[0].Address)
+	assert.Equal(t, "api-2", services[1].ID)
+
+	assert.Equal(t, "peer-a", query.Get("peer"))
+	assert.Equal(t, "Service.Port == 8080", query.Get("filter"))
+	assert.Equal(t, "5", query.Get("index"))
+	_, passing := query["passing"]
+	assert.True(t, passing)
+
+	d.Stop()
+	_, _, err = d.Fetch(clients)
+	assert.Equal(t, dep.ErrStopped, err)
+}
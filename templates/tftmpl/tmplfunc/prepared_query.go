@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/pkg/errors"
+)
+
+// preparedQueryPollInterval is the time to wait between executions of a
+// prepared query. The prepared query execute API does not support blocking
+// queries, so changes to the results are detected by polling.
+const preparedQueryPollInterval = 30 * time.Second
+
+var _ hcatQuery = (*preparedQueryQuery)(nil)
+
+// preparedQueryFunc returns the service instances that are the results of
+// executing a Consul prepared query. The results include the service instances
+// of a failover datacenter when the prepared query fails over. It supports the
+// query parameters dc and near.
+//
+// Endpoint: /v1/query/:name/execute
+// Template: {{ preparedQuery name=<name> <options> ... }}
+func preparedQueryFunc(recall hcat.Recaller) interface{} {
+	return func(opts ...string) ([]*dep.HealthService, error) {
+		result := []*dep.HealthService{}
+
+		d, err := newPreparedQueryQuery(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, ok := recall(d); ok {
+			return value.([]*dep.HealthService), nil
+		}
+
+		return result, nil
+	}
+}
+
+// preparedQueryQuery is the representation of a requested prepared query
+// execution from inside a template.
+type preparedQueryQuery struct {
+	isConsul
+	stopCh chan struct{}
+
+	name         string
+	dc           string
+	near         string
+	pollInterval time.Duration
+	opts         hcat.QueryOptions
+}
+
+// newPreparedQueryQuery processes options in the format of "key=value"
+// (e.g. "name=api-failover"). The name option is required.
+func newPreparedQueryQuery(opts []string) (*preparedQueryQuery, error) {
+	query := preparedQueryQuery{
+		stopCh:       make(chan struct{}, 1),
+		pollInterval: preparedQueryPollInterval,
+	}
+
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == "" {
+			continue
+		}
+
+		queryParam := strings.SplitN(opt, "=", 2)
+		if len(queryParam) != 2 {
+			return nil, fmt.Errorf(
+				"prepared_query: invalid query parameter format: %q", opt)
+		}
+		param := strings.TrimSpace(queryParam[0])
+		value := strings.TrimSpace(queryParam[1])
+		switch param {
+		case "name":
+			query.name = value
+		case "dc", "datacenter":
+			query.dc = value
+		case "near":
+			query.near = value
+		default:
+			return nil, fmt.Errorf(
+				"prepared_query: invalid query parameter: %q", opt)
+		}
+	}
+
+	if query.name == "" {
+		return nil, fmt.Errorf("prepared_query: name option required")
+	}
+
+	return &query, nil
+}
+
+// Fetch executes the prepared query with the Consul API defined by the given
+// client and returns a slice of HealthService objects of the results. Fetches
+// after the first wait for the poll interval before executing the query.
+func (d *preparedQueryQuery) Fetch(clients dep.Clients) (interface{}, *dep.ResponseMetadata, error) {
+	select {
+	case <-d.stopCh:
+		return nil, nil, dep.ErrStopped
+	default:
+	}
+
+	lastIndex := d.opts.WaitIndex
+	if lastIndex != 0 {
+		select {
+		case <-d.stopCh:
+			return nil, nil, dep.ErrStopped
+		case <-time.After(d.pollInterval):
+		}
+	}
+
+	// The execute API does not block, so only the options unrelated to
+	// blocking queries are used
+	hcatOpts := d.opts.Merge(&hcat.QueryOptions{
+		Datacenter: d.dc,
+	})
+	opts := hcatOpts.ToConsulOpts()
+	opts.WaitIndex = 0
+	opts.WaitTime = 0
+	opts.Near = d.near
+
+	resp, qm, err := clients.Consul().PreparedQuery().Execute(d.name, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, d.String())
+	}
+
+	// The index of the results can decrease, e.g. when the query fails over to
+	// another datacenter. Always advance the index so that the results are
+	// compared with the previous results to determine if there is a change.
+	rm := &dep.ResponseMetadata{
+		LastIndex:   qm.LastIndex,
+		LastContact: qm.LastContact,
+	}
+	if rm.LastIndex <= lastIndex {
+		rm.LastIndex = lastIndex + 1
+	}
+
+	services := make([]*dep.HealthService, 0, len(resp.Nodes))
+	for ix := range resp.Nodes {
+		services = append(services, toHealthService(&resp.Nodes[ix]))
+	}
+
+	sort.Stable(ByNodeThenID(services))
+	return services, rm, nil
+}
+
+// SetOptions satisfies the hcat.QueryOptionsSetter interface which provides
+// the index of the previous results.
+func (d *preparedQueryQuery) SetOptions(opts hcat.QueryOptions) {
+	d.opts = opts
+}
+
+// ID returns the human-friendly version of this query.
+func (d *preparedQueryQuery) ID() string {
+	opts := []string{fmt.Sprintf("name=%s", d.name)}
+	if d.dc != "" {
+		opts = append(opts, fmt.Sprintf("dc=%s", d.dc))
+	}
+	if d.near != "" {
+		opts = append(opts, fmt.Sprintf("near=%s", d.near))
+	}
+	sort.Strings(opts)
+	return fmt.Sprintf("prepared_query(%s)", strings.Join(opts, "&"))
+}
+
+// Stringer interface reuses ID
+func (d *preparedQueryQuery) String() string {
+	return d.ID()
+}
+
+// Stop halts the query's fetch function.
+func (d *preparedQueryQuery) Stop() {
+	close(d.stopCh)
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfunc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcat"
+	"github.com/hashicorp/hcat/dep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPreparedQueryQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		opts []string
+		exp  *preparedQueryQuery
+		err  bool
+	}{
+		{
+			"name",
+			[]string{"name=api-failover"},
+			&preparedQueryQuery{
+				name: "api-failover",
+			},
+			false,
+		},
+		{
+			"multiple",
+			[]string{"name=api-failover", "dc=dc1", "near=_agent"},
+			&preparedQueryQuery{
+				name: "api-failover",
+				dc:   "dc1",
+				near: "_agent",
+			},
+			false,
+		},
+		{
+			"no opts",
+			[]string{},
+			nil,
+			true,
+		},
+		{
+			"missing name",
+			[]string{"dc=dc1"},
+			nil,
+			true,
+		},
+		{
+			"invalid format",
+			[]string{"name=api-failover", "dc1"},
+			nil,
+			true,
+		},
+		{
+			"unsupported parameter",
+			[]string{"name=api-failover", "ns=default"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := newPreparedQueryQuery(tc.opts)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			if act != nil {
+				act.stopCh = nil
+				act.pollInterval = 0
+			}
+
+			assert.NoError(t, err, err)
+			assert.Equal(t, tc.exp, act)
+		})
+	}
+}
+
+func TestPreparedQueryQuery_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		i    []string
+		exp  string
+	}{
+		{
+			"name",
+			[]string{"name=api-failover"},
+			"prepared_query(name=api-failover)",
+		},
+		{
+			"multiple",
+			[]string{"near=_agent", "dc=dc1", "name=api-failover"},
+			"prepared_query(dc=dc1&name=api-failover&near=_agent)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newPreparedQueryQuery(tc.i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.exp, d.String())
+		})
+	}
+}
+
+func TestPreparedQueryQuery_Fetch(t *testing.T) {
+	t.Parallel()
+
+	// Consul stand-in that returns the results of a prepared query that failed
+	// over to dc2
+	var requests []*http.Request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/status/leader":
+			fmt.Fprint(w, `"127.0.0.1:8300"`)
+			return
+		case "/v1/query/api-failover/execute":
+			requests = append(requests, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Consul-Index", "10")
+		fmt.Fprint(w, `{
+			"Service": "api",
+			"Datacenter": "dc2",
+			"Failovers": 1,
+			"Nodes": [
+				{
+					"Node": {"ID": "2", "Node": "node-b", "Address": "10.0.0.2", "Datacenter": "dc2"},
+					"Service": {"ID": "api-2", "Service": "api", "Tags": ["b", "a"], "Port": 8080},
+					"Checks": [{"Status": "passing"}]
+				},
+				{
+					"Node": {"ID": "1", "Node": "node-a", "Address": "10.0.0.1", "Datacenter": "dc2"},
+					"Service": {"ID": "api-1", "Service": "api", "Address": "10.1.0.1", "Port": 8080},
+					"Checks": [{"Status": "passing"}]
+				}
+			]
+		}`)
+	}))
+	defer ts.Close()
+
+	clients := hcat.NewClientSet()
+	require.NoError(t, clients.AddConsul(hcat.ConsulInput{Address: ts.URL}))
+	defer clients.Stop()
+
+	d, err := newPreparedQueryQuery([]string{"name=api-failover", "dc=dc1", "near=_agent"})
+	require.NoError(t, err)
+	d.pollInterval = time.Millisecond
+
+	t.Run("first_fetch", func(t *testing.T) {
+		data, rm, err := d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), rm.LastIndex)
+
+		services, ok := data.([]*dep.HealthService)
+		require.True(t, ok)
+		require.Len(t, services, 2)
+
+		assert.Equal(t, "node-a", services[0].Node)
+		assert.Equal(t, "api-1", services[0].ID)
+		assert.Equal(t, "10.1.0.1", services[0].Address)
+		assert.Equal(t, "dc2", services[0].NodeDatacenter)
+		assert.Equal(t, "passing", services[0].Status)
+
+		assert.Equal(t, "node-b", services[1].Node)
+		assert.Equal(t, "10.0.0.2", services[1].Address, "defaults to node address")
+		assert.Equal(t, dep.ServiceTags{"a", "b"}, services[1].Tags)
+
+		require.Len(t, requests, 1)
+		assert.Equal(t, "dc1", requests[0].URL.Query().Get("dc"))
+		assert.Equal(t, "_agent", requests[0].URL.Query().Get("near"))
+		assert.Empty(t, requests[0].URL.Query().Get("index"))
+	})
+
+	t.Run("index_advances", func(t *testing.T) {
+		d.SetOptions(hcat.QueryOptions{WaitIndex: 10})
+		_, rm, err := d.Fetch(clients)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), rm.LastIndex)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		d.Stop()
+		_, _, err := d.Fetch(clients)
+		assert.Equal(t, dep.ErrStopped, err)
+	})
+}
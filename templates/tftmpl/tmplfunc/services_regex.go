@@ -31,7 +31,9 @@ var (
 // the Catalog List Services API initially to get all the services
 // and then queries the Health API for each matching service.
 // It supports parameters filter, dc, ns, and node-meta on the
-// Health API query only.
+// Health API query only. The peer parameter queries the services
+// imported from a cluster peer on both APIs. Only passing service
+// instances are returned unless the status parameter is set to "any".
 //
 // Endpoints:
 //
@@ -67,8 +69,13 @@ type servicesRegexQuery struct {
 	filter   string
 	dc       string
 	ns       string
+	peer     string
 	nodeMeta map[string]string
 	opts     hcat.QueryOptions
+
+	// anyStatus queries the service instances of any health status instead
+	// of only the passing instances
+	anyStatus bool
 }
 
 // newServicesRegexQuery processes options in the format of
@@ -103,6 +110,21 @@ func newServicesRegexQuery(opts []string) (*servicesRegexQuery, error) {
 			case "ns", "namespace":
 				servicesRegexQuery.ns = value
 				continue
+			case "peer":
+				servicesRegexQuery.peer = value
+				continue
+			case "status":
+				switch value {
+				case "any":
+					servicesRegexQuery.anyStatus = true
+				case "passing":
+					servicesRegexQuery.anyStatus = false
+				default:
+					return nil, fmt.Errorf(
+						"service.regex: invalid value for query parameter "+
+							"%q: %s, expected \"any\" or \"passing\"", query, value)
+				}
+				continue
 			case "node-meta":
 				if servicesRegexQuery.nodeMeta == nil {
 					servicesRegexQuery.nodeMeta = make(map[string]string)
@@ -155,6 +177,7 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 		Namespace:  d.ns,
 	})
 	opts := hcatOpts.ToConsulOpts()
+	opts.Peer = d.peer
 	if len(d.nodeMeta) != 0 {
 		opts.NodeMeta = d.nodeMeta
 	}
@@ -187,6 +210,7 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 		Filter:     d.filter,
 	}
 	opts = hcatOpts.ToConsulOpts()
+	opts.Peer = d.peer
 	if len(d.nodeMeta) != 0 {
 		opts.NodeMeta = d.nodeMeta
 	}
@@ -208,35 +232,12 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 	var services []*dep.HealthService
 	for _, s := range matchServices {
 		var entries []*consulapi.ServiceEntry
-		entries, _, err = clients.Consul().Health().Service(s, "", true, opts)
+		entries, _, err = clients.Consul().Health().Service(s, "", !d.anyStatus, opts)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, d.String())
 		}
 		for _, entry := range entries {
-			address := entry.Service.Address
-			if address == "" {
-				address = entry.Node.Address
-			}
-			services = append(services, &dep.HealthService{
-				Node:                entry.Node.Node,
-				NodeID:              entry.Node.ID,
-				Kind:                string(entry.Service.Kind),
-				NodeAddress:         entry.Node.Address,
-				NodeDatacenter:      entry.Node.Datacenter,
-				NodeTaggedAddresses: entry.Node.TaggedAddresses,
-				NodeMeta:            entry.Node.Meta,
-				ServiceMeta:         entry.Service.Meta,
-				Address:             address,
-				ID:                  entry.Service.ID,
-				Name:                entry.Service.Service,
-				Tags: dep.ServiceTags(
-					deepCopyAndSortTags(entry.Service.Tags)),
-				Status:    entry.Checks.AggregatedStatus(),
-				Checks:    entry.Checks,
-				Port:      entry.Service.Port,
-				Weights:   entry.Service.Weights,
-				Namespace: entry.Service.Namespace,
-			})
+			services = append(services, toHealthService(entry))
 		}
 	}
 
@@ -244,6 +245,35 @@ func (d *servicesRegexQuery) Fetch(clients dep.Clients) (interface{}, *dep.Respo
 	return services, rm, nil
 }
 
+// toHealthService converts a Consul service entry into the HealthService
+// object that is used by the templates.
+func toHealthService(entry *consulapi.ServiceEntry) *dep.HealthService {
+	address := entry.Service.Address
+	if address == "" {
+		address = entry.Node.Address
+	}
+	return &dep.HealthService{
+		Node:                entry.Node.Node,
+		NodeID:              entry.Node.ID,
+		Kind:                string(entry.Service.Kind),
+		NodeAddress:         entry.Node.Address,
+		NodeDatacenter:      entry.Node.Datacenter,
+		NodeTaggedAddresses: entry.Node.TaggedAddresses,
+		NodeMeta:            entry.Node.Meta,
+		ServiceMeta:         entry.Service.Meta,
+		Address:             address,
+		ID:                  entry.Service.ID,
+		Name:                entry.Service.Service,
+		Tags: dep.ServiceTags(
+			deepCopyAndSortTags(entry.Service.Tags)),
+		Status:    entry.Checks.AggregatedStatus(),
+		Checks:    entry.Checks,
+		Port:      entry.Service.Port,
+		Weights:   entry.Service.Weights,
+		Namespace: entry.Service.Namespace,
+	}
+}
+
 // SetOptions satisfies the hcat.QueryOptionsSetter interface which enables
 // blocking queries.
 func (d *servicesRegexQuery) SetOptions(opts hcat.QueryOptions) {
@@ -261,12 +291,18 @@ func (d *servicesRegexQuery) ID() string {
 	if d.ns != "" {
 		opts = append(opts, fmt.Sprintf("ns=%s", d.ns))
 	}
+	if d.peer != "" {
+		opts = append(opts, fmt.Sprintf("peer=%s", d.peer))
+	}
 	for k, v := range d.nodeMeta {
 		opts = append(opts, fmt.Sprintf("node-meta=%s:%s", k, v))
 	}
 	if d.filter != "" {
 		opts = append(opts, fmt.Sprintf("filter=%s", d.filter))
 	}
+	if d.anyStatus {
+		opts = append(opts, "status=any")
+	}
 
 	sort.Strings(opts)
 	return fmt.Sprintf("service.regex(%s)",
@@ -62,6 +62,38 @@ func TestNewServicesRegexQuery(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"peer",
+			[]string{"regexp=.*", "peer=peer-a"},
+			&servicesRegexQuery{
+				regexp: regexp.MustCompile(".*"),
+				peer:   "peer-a",
+			},
+			false,
+		},
+		{
+			"status any",
+			[]string{"regexp=.*", "status=any"},
+			&servicesRegexQuery{
+				regexp:    regexp.MustCompile(".*"),
+				anyStatus: true,
+			},
+			false,
+		},
+		{
+			"status passing",
+			[]string{"regexp=.*", "status=passing"},
+			&servicesRegexQuery{
+				regexp: regexp.MustCompile(".*"),
+			},
+			false,
+		},
+		{
+			"invalid status",
+			[]string{"regexp=.*", "status=critical"},
+			nil,
+			true,
+		},
 		{
 			"invalid query",
 			[]string{"regexp=.*", "invalid=true"},
@@ -112,6 +144,16 @@ func TestServicesRegexQuery_String(t *testing.T) {
 			[]string{"node-meta=k:v", "dc=dc1", "ns=namespace", "regexp=web", "\"my-tag\" in Service.Tags"},
 			`service.regex(dc=dc1&filter="my-tag" in Service.Tags&node-meta=k:v&ns=namespace&regexp=web)`,
 		},
+		{
+			"peer",
+			[]string{"regexp=web", "peer=peer-a"},
+			"service.regex(peer=peer-a&regexp=web)",
+		},
+		{
+			"status any",
+			[]string{"regexp=web", "status=any"},
+			"service.regex(regexp=web&status=any)",
+		},
 	}
 
 	for _, tc := range cases {
@@ -202,6 +244,10 @@ func TestServicesRegexQuery_Fetch(t *testing.T) {
 	service = testutil.TestService{ID: apiWebSrv.ID, Name: apiWebSrv.Name}
 	testutils.RegisterConsulServiceHealth(t, srv2, service, 8*time.Second, testutil.HealthPassing)
 
+	apiCriticalSrv := &dep.HealthService{ID: "api-critical-1", Name: "api-critical", Node: consulSrv1.Node, NodeID: consulSrv1.NodeID}
+	service = testutil.TestService{ID: apiCriticalSrv.ID, Name: apiCriticalSrv.Name}
+	testutils.RegisterConsulServiceHealth(t, srv1, service, 8*time.Second, testutil.HealthCritical)
+
 	webSrv := &dep.HealthService{ID: "web-1", Name: "web", Node: consulSrv2.Node, NodeID: consulSrv2.NodeID}
 	service = testutil.TestService{ID: webSrv.ID, Name: webSrv.Name}
 	testutils.RegisterConsulServiceHealth(t, srv2, service, 8*time.Second, testutil.HealthPassing)
@@ -229,6 +275,15 @@ func TestServicesRegexQuery_Fetch(t *testing.T) {
 				apiWebSrv,
 			},
 		},
+		{
+			"status any",
+			[]string{"regexp=api.*", "status=any"},
+			[]*dep.HealthService{
+				apiSrv,
+				apiCriticalSrv,
+				apiWebSrv,
+			},
+		},
 		{
 			"node-meta",
 			[]string{"regexp=web", "node-meta=k:v"},
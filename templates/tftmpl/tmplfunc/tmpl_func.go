@@ -19,11 +19,19 @@ func HCLMap(meta *ServicesMeta) template.FuncMap {
 	tmplFuncs := tfunc.FuncMapConsulV1()
 	tmplFuncs["catalogServicesRegistration"] = catalogServicesRegistrationFunc
 	tmplFuncs["servicesRegex"] = servicesRegexFunc
+	tmplFuncs["intentions"] = intentionsFunc
+	tmplFuncs["catalogNodes"] = catalogNodesFunc
+	tmplFuncs["preparedQuery"] = preparedQueryFunc
+	tmplFuncs["gateways"] = gatewaysFunc
+	tmplFuncs["peerService"] = peerServiceFunc
 	tmplFuncs["indent"] = tfunc.Helpers()["indent"]
 	tmplFuncs["subtract"] = tfunc.Math()["subtract"]
 	tmplFuncs["joinStrings"] = joinStringsFunc
 	tmplFuncs["HCLService"] = hclServiceFunc(meta)
 	tmplFuncs["HCLServiceTags"] = hclServiceTagsFunc()
+	tmplFuncs["HCLIntention"] = hclIntentionFunc()
+	tmplFuncs["HCLNode"] = hclNodeFunc()
+	tmplFuncs["HCLGateway"] = hclGatewayFunc()
 	return tmplFuncs
 }
 
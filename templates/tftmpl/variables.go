@@ -21,9 +21,11 @@ import (
 var tfVersionSensitive = goVersion.Must(goVersion.NewSemver("0.14.0"))
 
 // VariableServices is versioned to track compatibility with the generated
-// root module with modules.
+// root module with modules. Version 1 adds the peer attribute. Modules that
+// declare the version 0 object remain compatible because Terraform drops
+// attributes that are not declared by the module's variable type.
 var VariableServices = []byte(`
-# Service definition protocol v0
+# Service definition protocol v1
 variable "services" {
   description = "Consul services monitored by Consul-Terraform-Sync"
   type = map(
@@ -36,6 +38,7 @@ variable "services" {
       meta      = map(string)
       tags      = list(string)
       namespace = string
+      peer      = string
       status    = string
 
       node                  = string
@@ -51,6 +54,66 @@ variable "services" {
 }
 `)
 
+// variableNodes is required for modules that include Consul node information.
+// It is versioned to track compatibility between the generated root module and
+// modules that include nodes.
+var variableNodes = []byte(`
+# Node definition protocol v0
+variable "nodes" {
+  description = "Consul nodes monitored by Consul-Terraform-Sync"
+  type = map(
+    object({
+      id               = string
+      node             = string
+      address          = string
+      datacenter       = string
+      tagged_addresses = map(string)
+      meta             = map(string)
+    })
+  )
+}
+`)
+
+// variableGateways is required for modules that include Consul service mesh
+// gateway information. It is versioned to track compatibility between the
+// generated root module and modules that include gateways.
+var variableGateways = []byte(`
+# Gateway definition protocol v0
+variable "gateways" {
+  description = "Consul service mesh gateways monitored by Consul-Terraform-Sync"
+  type = map(
+    object({
+      id        = string
+      name      = string
+      kind      = string
+      address   = string
+      port      = number
+      meta      = map(string)
+      tags      = list(string)
+      namespace = string
+      status    = string
+
+      node                  = string
+      node_id               = string
+      node_address          = string
+      node_datacenter       = string
+      node_tagged_addresses = map(string)
+      node_meta             = map(string)
+
+      services = list(
+        object({
+          name      = string
+          namespace = string
+          port      = number
+          protocol  = string
+          hosts     = list(string)
+        })
+      )
+    })
+  )
+}
+`)
+
 // newVariablesTF writes variable definitions to a file. This includes the
 // required services variable and generated provider variables based on CTS
 // user configuration for the task.
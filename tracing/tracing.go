@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing traces the stages of task executions with OpenTelemetry and
+// exports the spans to a collector over OTLP/HTTP.
+package tracing
+
+import (
+	"context"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/hashicorp/consul-terraform-sync/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "consul-terraform-sync"
+	tracerName  = "github.com/hashicorp/consul-terraform-sync"
+
+	// Attribute keys of the spans
+	AttrTaskName  = attribute.Key("cts.task_name")
+	AttrRequestID = attribute.Key("cts.request_id")
+)
+
+type requestIDKeyType struct{}
+
+var requestIDKey = requestIDKeyType{}
+
+// Init configures the global tracer provider to export spans to the collector
+// of the configuration. When tracing is disabled, spans are not recorded.
+// Returns a function that flushes the remaining spans and stops exporting.
+func Init(ctx context.Context, conf *config.TracingConfig) (func(context.Context) error, error) {
+	if conf == nil || !config.BoolVal(conf.Enabled) {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(config.StringVal(conf.Endpoint)),
+	}
+	if config.BoolVal(conf.Insecure) {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if len(conf.Headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(conf.Headers))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.GetHumanVersion()),
+	)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp.Shutdown, nil
+}
+
+// Start starts a span of a stage of a task execution. The span is a child of
+// the span in the context, if any, and carries the request ID of the API
+// request that triggered the execution.
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		attrs = append(attrs, AttrRequestID.String(requestID))
+	}
+	return otel.Tracer(tracerName).Start(ctx, spanName,
+		trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, on the span and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TaskName returns the span attribute of a task name
+func TaskName(taskName string) attribute.KeyValue {
+	return AttrTaskName.String(taskName)
+}
+
+// WithRequestID inserts the ID of an API request into the context so that it
+// is carried by the spans started with the context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the ID of the API request in the context, or an
+// empty string if there is none
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2020, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/consul-terraform-sync/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// testCollector is a stand-in for an OTLP/HTTP collector that stores the
+// spans it receives
+type testCollector struct {
+	mu      sync.Mutex
+	spans   map[string]*tracepb.Span
+	headers http.Header
+}
+
+func newTestCollector(t *testing.T) (*testCollector, *httptest.Server) {
+	c := &testCollector{spans: make(map[string]*tracepb.Span)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req coltracepb.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.headers = r.Header.Clone()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					c.spans[s.Name] = s
+				}
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return c, srv
+}
+
+func attrValue(s *tracepb.Span, key string) string {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value.GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestInit(t *testing.T) {
+	// Not parallel since the global tracer provider is configured
+
+	t.Run("disabled", func(t *testing.T) {
+		shutdown, err := Init(context.Background(), &config.TracingConfig{
+			Enabled: config.Bool(false),
+		})
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+
+		_, span := Start(context.Background(), "span")
+		assert.False(t, span.SpanContext().IsValid())
+		span.End()
+	})
+
+	t.Run("export", func(t *testing.T) {
+		collector, srv := newTestCollector(t)
+		defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+		ctx := context.Background()
+		shutdown, err := Init(ctx, &config.TracingConfig{
+			Enabled:  config.Bool(true),
+			Endpoint: config.String(strings.TrimPrefix(srv.URL, "http://")),
+			Insecure: config.Bool(true),
+			Headers:  map[string]string{"X-Api-Key": "abcd"},
+		})
+		require.NoError(t, err)
+
+		ctx = WithRequestID(ctx, "f4a6ad81-cc2b-4f3f-9b4a-b8a3fa8b4b1c")
+		ctx, parent := Start(ctx, "task.run", TaskName("task_a"))
+		_, child := Start(ctx, "terraform.apply")
+		End(child, errors.New("apply error"))
+		End(parent, nil)
+
+		require.NoError(t, shutdown(ctx))
+
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+		assert.Equal(t, "abcd", collector.headers.Get("X-Api-Key"))
+
+		p, ok := collector.spans["task.run"]
+		require.True(t, ok, "missing parent span")
+		assert.Equal(t, "task_a", attrValue(p, string(AttrTaskName)))
+		assert.Equal(t, "f4a6ad81-cc2b-4f3f-9b4a-b8a3fa8b4b1c",
+			attrValue(p, string(AttrRequestID)))
+		assert.NotEqual(t, tracepb.Status_STATUS_CODE_ERROR, p.Status.GetCode())
+
+		c, ok := collector.spans["terraform.apply"]
+		require.True(t, ok, "missing child span")
+		assert.Equal(t, p.TraceId, c.TraceId)
+		assert.Equal(t, p.SpanId, c.ParentSpanId)
+		assert.Equal(t, "f4a6ad81-cc2b-4f3f-9b4a-b8a3fa8b4b1c",
+			attrValue(c, string(AttrRequestID)))
+		assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, c.Status.GetCode())
+		assert.Equal(t, "apply error", c.Status.GetMessage())
+	})
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, RequestIDFromContext(context.Background()))
+
+	ctx := WithRequestID(context.Background(), "id")
+	assert.Equal(t, "id", RequestIDFromContext(ctx))
+}
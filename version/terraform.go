@@ -18,10 +18,19 @@ import (
 // and enhancements between versions.
 const CompatibleTerraformVersionConstraint = ">= 0.13.0, <= 1.14.3"
 
+// CompatibleOpenTofuVersionConstraint is the version constraint imposed for
+// running OpenTofu in automation with CTS. OpenTofu is forked from Terraform
+// 1.6 and is upward bounded for the same reasons as Terraform.
+const CompatibleOpenTofuVersionConstraint = ">= 1.6.0, <= 1.10.6"
+
 // TerraformConstraint is the go-version constraint variable for
 // CompatibleTerraformVersionConstraint
 var TerraformConstraint version.Constraints
 
+// OpenTofuConstraint is the go-version constraint variable for
+// CompatibleOpenTofuVersionConstraint
+var OpenTofuConstraint version.Constraints
+
 func init() {
 	var err error
 	TerraformConstraint, err = version.NewConstraint(CompatibleTerraformVersionConstraint)
@@ -29,4 +38,10 @@ func init() {
 		log.Panicf("error setting up Terraform version constraint %q: %s",
 			CompatibleTerraformVersionConstraint, err)
 	}
+
+	OpenTofuConstraint, err = version.NewConstraint(CompatibleOpenTofuVersionConstraint)
+	if err != nil {
+		log.Panicf("error setting up OpenTofu version constraint %q: %s",
+			CompatibleOpenTofuVersionConstraint, err)
+	}
 }
@@ -63,3 +63,45 @@ func TestTerraformConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenTofuConstraint(t *testing.T) {
+	testCases := []struct {
+		name      string
+		version   string
+		supported bool
+	}{
+		{
+			"valid 1.6",
+			"1.6.0",
+			true,
+		}, {
+			"valid 1.8",
+			"1.8.8",
+			true,
+		}, {
+			"valid 1.10",
+			"1.10.6",
+			true,
+		}, {
+			"invalid lower bound",
+			"1.5.7",
+			false,
+		}, {
+			"invalid upper bound",
+			"1.11.0",
+			false,
+		}, {
+			"unsupported beta release",
+			"1.9.0-beta1",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := version.Must(version.NewSemver(tc.version))
+			supported := OpenTofuConstraint.Check(v)
+			assert.Equal(t, tc.supported, supported, tc.version)
+		})
+	}
+}